@@ -110,7 +110,7 @@ func TestPipeline(t *testing.T) {
 			wantOutput: []*cbpb.BeamResult{},
 			wantError: []*cbpb.BeamError{
 				&cbpb.BeamError{
-					ErrorMessage: proto.String("failed during CQL evaluation: EvalTest 1.0, could not find ValueSet{urn:example:nosuchvalueset, } resource not loaded"),
+					ErrorMessage: proto.String("failed during CQL evaluation: EvalTest 1.0, define \"HasDiabetes\" at 4:6-4:58: could not find ValueSet{urn:example:nosuchvalueset, } resource not loaded"),
 					SourceUri:    proto.String("bundle:bundle1"),
 				},
 			},
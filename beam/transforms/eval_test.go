@@ -160,7 +160,7 @@ func TestCQLEvalFn(t *testing.T) {
 			}`).GetBundle(),
 			wantError: []*cbpb.BeamError{
 				&cbpb.BeamError{
-					ErrorMessage: proto.String("failed during CQL evaluation: EvalTest 1.0, could not find ValueSet{urn:example:nosuchvalueset, } resource not loaded"),
+					ErrorMessage: proto.String("failed during CQL evaluation: EvalTest 1.0, define \"HasDiabetes\" at 4:6-4:58: could not find ValueSet{urn:example:nosuchvalueset, } resource not loaded"),
 					SourceUri:    proto.String("bundle:bundle1"),
 				},
 			},
@@ -18,6 +18,7 @@ package cql
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/cql/internal/embeddata"
@@ -78,6 +79,12 @@ type EvalConfig struct {
 	// one of the included terminology providers. See the terminology package for more details.
 	Terminology terminology.Provider
 
+	// ValueSetExpansions are pre-expanded ValueSets, a map from ValueSet to the codes it contains.
+	// ValueSet membership checks such as `code in ValueSet` consult these expansions first and only
+	// fall back to Terminology for ValueSets that are not registered, so CQL can be evaluated offline
+	// without a terminology server. See terminology.NewExpansionProvider.
+	ValueSetExpansions map[terminology.ValueSetKey][]terminology.Code
+
 	// EvaluationTimestamp is the time at which the eval request will be executed. The timestamp is
 	// used by CQL system operators like Today() and Now(). If not provided EvaluationTimestamp will
 	// default to time.Now() called at the start of the eval request.
@@ -86,6 +93,30 @@ type EvalConfig struct {
 	// ReturnPrivateDefs if true will return all private definitions in result.Libraries. By default
 	// only public definitions are returned.
 	ReturnPrivateDefs bool
+
+	// MessageHandler receives the messages emitted by the CQL Message() operator, for example to
+	// forward them to a logger. If not provided messages are printed to stdout.
+	MessageHandler interpreter.MessageHandler
+
+	// StrictCodeSystemVersions if true requires the code system version of a code to match the
+	// version in the ValueSet expansion or CodeSystem for terminology membership checks such as
+	// `code in ValueSet`. By default versions are ignored and codes are matched on system and code.
+	StrictCodeSystemVersions bool
+
+	// DecimalPrecision is the number of digits after the decimal point that the Decimal results of
+	// Divide, Modulo, Avg, GeometricMean, Median and the variance and standard deviation aggregates
+	// are rounded to. If nil the CQL default of 8 digits is used. The results of Add, Subtract and
+	// Multiply are not rounded.
+	DecimalPrecision *int
+
+	// DecimalRounding is the rounding mode used for those results and by the Round() operator. By
+	// default midpoint values are rounded towards positive infinity.
+	DecimalRounding interpreter.RoundingMode
+
+	// DisableDefinitionCache if true evaluates an expression definition again each time it is
+	// referenced. By default each definition is evaluated once and every reference reuses the
+	// result.
+	DisableDefinitionCache bool
 }
 
 // Eval executes the parsed CQL against the retriever. The retriever is the interface through which
@@ -94,25 +125,79 @@ type EvalConfig struct {
 // retrieve data for that patient. To connect to a particular data source you will need to implement
 // the retriever.Retriever interface, or use one of the included retrievers. See the retriever
 // package for more details. The retriever can be nil if the CQL does not fetch external data. Eval
-// should not be called from multiple goroutines on a single *ELM.
+// can be called from multiple goroutines on a single *ELM, see EvalBatch. If ctx is canceled
+// evaluation stops and the returned error wraps the context error.
 // Errors returned by Eval will always be a result.EngineError.
 func (e *ELM) Eval(ctx context.Context, retriever retriever.Retriever, config EvalConfig) (result.Libraries, error) {
 	evalTS := config.EvaluationTimestamp
 	if config.EvaluationTimestamp.IsZero() {
 		evalTS = time.Now()
 	}
+	terminologyProvider := config.Terminology
+	if len(config.ValueSetExpansions) > 0 {
+		terminologyProvider = terminology.NewExpansionProvider(config.ValueSetExpansions, config.Terminology)
+	}
 	c := interpreter.Config{
-		DataModels:          e.dataModels,
-		Parameters:          e.parsedParams,
-		Retriever:           retriever,
-		Terminology:         config.Terminology,
-		EvaluationTimestamp: evalTS,
-		ReturnPrivateDefs:   config.ReturnPrivateDefs,
+		DataModels:               e.dataModels,
+		Parameters:               e.parsedParams,
+		Retriever:                retriever,
+		Terminology:              terminologyProvider,
+		EvaluationTimestamp:      evalTS,
+		ReturnPrivateDefs:        config.ReturnPrivateDefs,
+		MessageHandler:           config.MessageHandler,
+		StrictCodeSystemVersions: config.StrictCodeSystemVersions,
+		DecimalPrecision:         config.DecimalPrecision,
+		DecimalRounding:          config.DecimalRounding,
+		DisableDefinitionCache:   config.DisableDefinitionCache,
 	}
 
 	return interpreter.Eval(ctx, e.parsedLibs, c)
 }
 
+// BatchResult is the result of one evaluation in EvalBatch. Either Results or Err is set.
+type BatchResult struct {
+	Results result.Libraries
+	Err     error
+}
+
+// EvalBatch executes the parsed CQL once for each retriever, for example one retriever per patient,
+// running at most concurrency evaluations in parallel. A concurrency less than 1 is treated as 1.
+// The returned BatchResults are in the same order as the retrievers. An error evaluating one
+// retriever is returned in its BatchResult and does not stop the evaluation of the others, but if
+// ctx is canceled the remaining evaluations return the context error. The config is shared by all
+// evaluations, so the terminology provider and message handler must be safe for concurrent use. If
+// config.EvaluationTimestamp is not set it defaults to time.Now() at the start of the batch, so
+// every evaluation uses the same timestamp.
+// Errors in the BatchResults will always be a result.EngineError.
+func (e *ELM) EvalBatch(ctx context.Context, retrievers []retriever.Retriever, concurrency int, config EvalConfig) []BatchResult {
+	if config.EvaluationTimestamp.IsZero() {
+		config.EvaluationTimestamp = time.Now()
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]BatchResult, len(retrievers))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				res, err := e.Eval(ctx, retrievers[idx], config)
+				results[idx] = BatchResult{Results: res, Err: err}
+			}
+		}()
+	}
+	for idx := range retrievers {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
 // ELM is the parsed CQL, ready to be evaluated.
 type ELM struct {
 	dataModels   *modelinfo.ModelInfos
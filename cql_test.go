@@ -17,6 +17,7 @@ package cql_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -25,8 +26,11 @@ import (
 	"github.com/google/cql/parser"
 	"github.com/google/cql/result"
 	"github.com/google/cql/retriever"
+	"github.com/google/cql/retriever/local"
+	"github.com/google/cql/terminology"
 	"github.com/google/cql/tests/enginetests"
 	"github.com/google/cql/types"
+	r4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/lithammer/dedent"
@@ -89,6 +93,34 @@ func TestCQL(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name: "Code in registered ValueSet expansion",
+			cql: []string{dedent.Dedent(`
+			library TESTLIB version '1.0.0'
+			valueset "Diabetes": 'https://example.com/ValueSet/diabetes'
+			define TESTRESULT: Code { code: '44054006', system: 'http://snomed.info/sct' } in "Diabetes"`),
+			},
+			evalConfig: cql.EvalConfig{
+				ValueSetExpansions: map[terminology.ValueSetKey][]terminology.Code{
+					{URL: "https://example.com/ValueSet/diabetes"}: {{Code: "44054006", System: "http://snomed.info/sct"}},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "Code not in registered ValueSet expansion",
+			cql: []string{dedent.Dedent(`
+			library TESTLIB version '1.0.0'
+			valueset "Diabetes": 'https://example.com/ValueSet/diabetes'
+			define TESTRESULT: Code { code: '38341003', system: 'http://snomed.info/sct' } in "Diabetes"`),
+			},
+			evalConfig: cql.EvalConfig{
+				ValueSetExpansions: map[terminology.ValueSetKey][]terminology.Code{
+					{URL: "https://example.com/ValueSet/diabetes"}: {{Code: "44054006", System: "http://snomed.info/sct"}},
+				},
+			},
+			wantResult: newOrFatal(t, false),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -251,6 +283,19 @@ func TestCQL_EvalErrors(t *testing.T) {
 				DataModels: [][]byte{fhirDataModel(t)},
 			},
 		},
+		{
+			name: "ValueSet without a registered expansion",
+			cql: []string{dedent.Dedent(`
+			library TESTLIB version '1.0.0'
+			valueset "Asthma": 'https://example.com/ValueSet/asthma'
+			define TESTRESULT: Code { code: '195967001', system: 'http://snomed.info/sct' } in "Asthma"`),
+			},
+			evalConfig: cql.EvalConfig{
+				ValueSetExpansions: map[terminology.ValueSetKey][]terminology.Code{
+					{URL: "https://example.com/ValueSet/diabetes"}: {{Code: "44054006", System: "http://snomed.info/sct"}},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -402,3 +447,91 @@ func newOrFatal(t testing.TB, a any) result.Value {
 	}
 	return o
 }
+
+func TestCQL_EvalBatch(t *testing.T) {
+	cqlLibs := []string{dedent.Dedent(`
+		library TESTLIB version '1.0.0'
+		using FHIR version '4.0.1'
+		include FHIRHelpers version '4.0.1'
+		context Patient
+		define TESTRESULT: Patient.id.value`),
+		fhirHelpers(t),
+	}
+	elm, err := cql.Parse(context.Background(), cqlLibs, cql.ParseConfig{DataModels: [][]byte{fhirDataModel(t)}})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+
+	const numPatients = 50
+	var retrievers []retriever.Retriever
+	for i := 0; i < numPatients; i++ {
+		bundle := fmt.Sprintf(`{"resourceType": "Bundle", "type": "collection", "entry": [{"resource": {"resourceType": "Patient", "id": "patient-%d"}}]}`, i)
+		r, err := local.NewRetrieverFromR4Bundle([]byte(bundle))
+		if err != nil {
+			t.Fatalf("NewRetrieverFromR4Bundle returned unexpected error: %v", err)
+		}
+		retrievers = append(retrievers, r)
+	}
+
+	for _, concurrency := range []int{0, 1, 8} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			got := elm.EvalBatch(context.Background(), retrievers, concurrency, cql.EvalConfig{})
+			if len(got) != numPatients {
+				t.Fatalf("EvalBatch returned %d results, want %d", len(got), numPatients)
+			}
+			for i, res := range got {
+				if res.Err != nil {
+					t.Fatalf("EvalBatch result %d returned unexpected error: %v", i, res.Err)
+				}
+				if diff := cmp.Diff(newOrFatal(t, fmt.Sprintf("patient-%d", i)), getTESTRESULTWithSources(t, res.Results)); diff != "" {
+					t.Errorf("EvalBatch result %d diff (-want +got)\n%v", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCQL_EvalBatchErrorIsolation(t *testing.T) {
+	cqlLibs := []string{dedent.Dedent(`
+		library TESTLIB version '1.0.0'
+		using FHIR version '4.0.1'
+		include FHIRHelpers version '4.0.1'
+		context Patient
+		define TESTRESULT: Count([Encounter])`),
+		fhirHelpers(t),
+	}
+	elm, err := cql.Parse(context.Background(), cqlLibs, cql.ParseConfig{DataModels: [][]byte{fhirDataModel(t)}})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+
+	retrievers := []retriever.Retriever{
+		enginetests.BuildRetriever(t),
+		errorRetriever{},
+		enginetests.BuildRetriever(t),
+	}
+	got := elm.EvalBatch(context.Background(), retrievers, 2, cql.EvalConfig{})
+
+	for _, i := range []int{0, 2} {
+		if got[i].Err != nil {
+			t.Fatalf("EvalBatch result %d returned unexpected error: %v", i, got[i].Err)
+		}
+		if diff := cmp.Diff(newOrFatal(t, 1), getTESTRESULTWithSources(t, got[i].Results)); diff != "" {
+			t.Errorf("EvalBatch result %d diff (-want +got)\n%v", i, diff)
+		}
+	}
+	if !errors.Is(got[1].Err, errRetrieve) {
+		t.Errorf("EvalBatch result 1 returned error %v, want %v", got[1].Err, errRetrieve)
+	}
+	if got[1].Results != nil {
+		t.Errorf("EvalBatch result 1 returned results %v, want nil", got[1].Results)
+	}
+}
+
+var errRetrieve = errors.New("retrieve failed")
+
+type errorRetriever struct{}
+
+func (errorRetriever) Retrieve(context.Context, string) ([]*r4pb.ContainedResource, error) {
+	return nil, errRetrieve
+}
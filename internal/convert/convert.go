@@ -393,14 +393,44 @@ func OperandImplicitConverter(invokedType types.IType, declaredType types.IType,
 			Return: &model.ReturnClause{
 				Expression: r.WrappedOperand,
 				Distinct:   false,
-				Element:    &model.Element{ResultType: d.ElementType}},
-			Expression: model.ResultType(declaredType),
+				Element:    &model.Element{ResultType: r.WrappedOperand.GetResultType()}},
+			Expression: model.ResultType(&types.List{ElementType: r.WrappedOperand.GetResultType()}),
 		}
 		if 5 < minConverted.Score {
 			minConverted = ConvertedOperand{Matched: true, Score: 5, WrappedOperand: wrapped}
 		}
 	}
 
+	// LIST PROMOTION
+	// Ex Integer --> List<Decimal>   ToList(ToDecimal(operand))
+	// Intervals are not promoted, since operators over intervals have dedicated Interval overloads
+	// rather than the semantics of a list of one interval.
+	if d, ok := declaredType.(*types.List); ok {
+		_, invokedIsList := invokedType.(*types.List)
+		_, invokedIsInterval := invokedType.(*types.Interval)
+		if !invokedIsList && !invokedIsInterval {
+			r, err := OperandImplicitConverter(invokedType, d.ElementType, opToWrap, mi)
+			if err != nil {
+				return ConvertedOperand{}, err
+			}
+			if r.Matched {
+				// The declared type may be generic, so the result type is taken from the converted operand.
+				wrapped := &model.ToList{
+					UnaryExpression: &model.UnaryExpression{
+						Operand:    r.WrappedOperand,
+						Expression: model.ResultType(&types.List{ElementType: r.WrappedOperand.GetResultType()}),
+					},
+				}
+				// List promotion has the lowest precedence of all conversions, after interval promotion,
+				// list demotion and interval demotion.
+				score := r.Score + 9
+				if score < minConverted.Score {
+					minConverted = ConvertedOperand{Matched: true, Score: score, WrappedOperand: wrapped}
+				}
+			}
+		}
+	}
+
 	if minConverted.Matched {
 		return minConverted, nil
 	}
@@ -785,6 +785,42 @@ func TestOperandImplicitConverter(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "List Promotion",
+			invokedType:  types.Integer,
+			declaredType: &types.List{ElementType: types.Integer},
+			want: ConvertedOperand{
+				Matched: true,
+				Score:   9,
+				WrappedOperand: &model.ToList{
+					UnaryExpression: &model.UnaryExpression{
+						Operand: model.NewLiteral("operand", types.String),
+						// The element type is the result type of the wrapped operand.
+						Expression: model.ResultType(&types.List{ElementType: types.String}),
+					},
+				},
+			},
+		},
+		{
+			name:         "List Promotion with Implicit Conversion",
+			invokedType:  types.Integer,
+			declaredType: &types.List{ElementType: types.Decimal},
+			want: ConvertedOperand{
+				Matched: true,
+				Score:   13,
+				WrappedOperand: &model.ToList{
+					UnaryExpression: &model.UnaryExpression{
+						Operand: &model.ToDecimal{
+							UnaryExpression: &model.UnaryExpression{
+								Operand:    model.NewLiteral("operand", types.String),
+								Expression: model.ResultType(types.Decimal),
+							},
+						},
+						Expression: model.ResultType(&types.List{ElementType: types.Decimal}),
+					},
+				},
+			},
+		},
 		{
 			name:         "Invalid Simple Conversion",
 			invokedType:  types.Integer,
@@ -831,6 +867,12 @@ func TestOperandImplicitConverter(t *testing.T) {
 			declaredType: &types.Interval{PointType: types.Integer},
 			want:         ConvertedOperand{Matched: false},
 		},
+		{
+			name:         "Invalid List Promotion of Interval",
+			invokedType:  &types.Interval{PointType: types.Integer},
+			declaredType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			want:         ConvertedOperand{Matched: false},
+		},
 		{
 			name:         "Invalid List Conversion",
 			invokedType:  &types.List{ElementType: &types.Interval{PointType: types.String}},
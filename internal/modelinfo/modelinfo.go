@@ -470,6 +470,16 @@ func (m *ModelInfos) SetUsing(key Key) error {
 	return nil
 }
 
+// Clone returns a copy that shares the loaded model infos, which are never modified after New, but
+// tracks its own using declaration. This allows concurrent evaluations to share the model infos.
+func (m *ModelInfos) Clone() *ModelInfos {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
 // ResetUsing resets the using declaration to the system model info key.
 func (m *ModelInfos) ResetUsing() {
 	m.using = nil
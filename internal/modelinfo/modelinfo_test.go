@@ -809,6 +809,21 @@ func TestSetUsing_Error(t *testing.T) {
 	})
 }
 
+func TestClone(t *testing.T) {
+	modelinfo := newFHIRModelInfo(t)
+	clone := modelinfo.Clone()
+	if err := clone.SetUsing(Key{Name: "FHIR", Version: "4.0.1"}); err != nil {
+		t.Fatalf("SetUsing() failed unexpectedly: %v", err)
+	}
+	if _, err := clone.DefaultContext(); err != nil {
+		t.Errorf("DefaultContext() on clone failed unexpectedly: %v", err)
+	}
+	// The using declaration of the clone should not affect the original.
+	if _, err := modelinfo.DefaultContext(); !errors.Is(err, errUsingNotSet) {
+		t.Errorf("DefaultContext() on original returned error %v, want %v", err, errUsingNotSet)
+	}
+}
+
 func newFHIRModelInfo(t *testing.T) *ModelInfos {
 	t.Helper()
 	fhirMIBytes, err := embeddata.ModelInfos.ReadFile("third_party/cqframework/fhir-modelinfo-4.0.1.xml")
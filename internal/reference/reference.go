@@ -173,6 +173,19 @@ func (r *Resolver[T, F]) ResolveInclude(name string) *model.LibraryIdentifier {
 	return nil
 }
 
+// EnterIncludedLibrary makes the library included under the local name libName the current
+// library, so that references in its definitions can be resolved. The returned function restores
+// the previous current library.
+func (r *Resolver[T, F]) EnterIncludedLibrary(libName string) (func(), error) {
+	qKey, ok := r.includedLibs[includeKey{localID: libName, includedBy: r.currLib}]
+	if !ok {
+		return nil, fmt.Errorf("could not resolve the library name %s", libName)
+	}
+	prev := r.currLib
+	r.currLib = namedLibKey{qualified: qKey.Qualified, version: qKey.Version}
+	return func() { r.currLib = prev }, nil
+}
+
 // Def holds the information needed to define a definition.
 type Def[T any] struct {
 	Name     string
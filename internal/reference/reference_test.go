@@ -578,6 +578,58 @@ func TestResolveIncludedLibrary(t *testing.T) {
 
 }
 
+func TestEnterIncludedLibrary(t *testing.T) {
+	// library example.helpers version '1.0'
+	// define private Inner: 1
+	r := NewResolver[model.IExpression, model.IExpression]()
+	if err := r.SetCurrentLibrary(&model.LibraryIdentifier{Qualified: "example.helpers", Version: "1.0"}); err != nil {
+		t.Fatalf("r.SetCurrentLibrary() unexpected err: %v", err)
+	}
+	inner := model.NewLiteral("1", types.Integer)
+	if err := r.Define(&Def[model.IExpression]{Name: "Inner", Result: inner}); err != nil {
+		t.Fatalf("r.Define() unexpected err: %v", err)
+	}
+
+	// library example.measure version '1.0'
+	// include example.helpers version '1.0' called helpers
+	// define Inner: 2
+	if err := r.SetCurrentLibrary(&model.LibraryIdentifier{Qualified: "example.measure", Version: "1.0"}); err != nil {
+		t.Fatalf("r.SetCurrentLibrary() unexpected err: %v", err)
+	}
+	if err := r.IncludeLibrary(&model.LibraryIdentifier{Local: "helpers", Qualified: "example.helpers", Version: "1.0"}, true); err != nil {
+		t.Fatalf("r.IncludeLibrary() unexpected err: %v", err)
+	}
+	measureInner := model.NewLiteral("2", types.Integer)
+	if err := r.Define(&Def[model.IExpression]{Name: "Inner", Result: measureInner}); err != nil {
+		t.Fatalf("r.Define() unexpected err: %v", err)
+	}
+
+	exitLibrary, err := r.EnterIncludedLibrary("helpers")
+	if err != nil {
+		t.Fatalf("r.EnterIncludedLibrary(helpers) unexpected err: %v", err)
+	}
+	got, err := r.ResolveLocal("Inner")
+	if err != nil {
+		t.Fatalf("r.ResolveLocal(Inner) unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(inner, got); diff != "" {
+		t.Errorf("r.ResolveLocal(Inner) in the included library diff (-want +got):\n%s", diff)
+	}
+
+	exitLibrary()
+	got, err = r.ResolveLocal("Inner")
+	if err != nil {
+		t.Fatalf("r.ResolveLocal(Inner) unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(measureInner, got); diff != "" {
+		t.Errorf("r.ResolveLocal(Inner) after exiting the included library diff (-want +got):\n%s", diff)
+	}
+
+	if _, err := r.EnterIncludedLibrary("missing"); err == nil {
+		t.Errorf("r.EnterIncludedLibrary(missing) succeeded, want error")
+	}
+}
+
 func TestResolverErrors(t *testing.T) {
 	tests := []struct {
 		name          string
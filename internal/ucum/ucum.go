@@ -0,0 +1,265 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ucum provides conversion between a commonly used subset of UCUM units
+// (https://ucum.org/ucum) and the CQL temporal keyword units.
+package ucum
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrUnsupportedUnit is returned when a unit is not in the supported subset of UCUM.
+var ErrUnsupportedUnit = errors.New("unsupported unit")
+
+// ErrInvalidUnit is returned when a unit is not syntactically valid UCUM.
+var ErrInvalidUnit = errors.New("invalid unit")
+
+// ErrIncompatibleUnits is returned when two units do not measure the same dimension.
+var ErrIncompatibleUnits = errors.New("incompatible units")
+
+// dimension is the kind of property a unit measures. Only units of the same dimension can be
+// converted between one another.
+type dimension string
+
+const (
+	dimensionless dimension = "dimensionless"
+	mass          dimension = "mass"
+	length        dimension = "length"
+	volume        dimension = "volume"
+	amount        dimension = "amount"
+	pressure      dimension = "pressure"
+	duration      dimension = "duration"
+	// calendarDuration holds the CQL year and month keywords. Per the CQL spec these are calendar
+	// durations and are not comparable to the definite duration UCUM units.
+	calendarDuration dimension = "calendarDuration"
+)
+
+// unitDef defines a unit as a multiple of the base unit for its dimension.
+type unitDef struct {
+	dimension dimension
+	factor    float64
+}
+
+// units is the supported subset of UCUM units along with the CQL temporal keywords. The base unit
+// of each dimension has a factor of 1.
+var units = map[string]unitDef{
+	// Dimensionless, in CQL both the empty unit and '1' represent a unitless quantity.
+	"":  {dimensionless, 1},
+	"1": {dimensionless, 1},
+	"%": {dimensionless, 0.01},
+
+	// Mass, base unit gram.
+	"kg":      {mass, 1e3},
+	"g":       {mass, 1},
+	"mg":      {mass, 1e-3},
+	"ug":      {mass, 1e-6},
+	"ng":      {mass, 1e-9},
+	"pg":      {mass, 1e-12},
+	"[lb_av]": {mass, 453.59237},
+	"[oz_av]": {mass, 28.349523125},
+
+	// Length, base unit meter.
+	"km":     {length, 1e3},
+	"m":      {length, 1},
+	"cm":     {length, 1e-2},
+	"mm":     {length, 1e-3},
+	"um":     {length, 1e-6},
+	"nm":     {length, 1e-9},
+	"[in_i]": {length, 0.0254},
+	"[ft_i]": {length, 0.3048},
+
+	// Volume, base unit liter.
+	"L":  {volume, 1},
+	"l":  {volume, 1},
+	"dL": {volume, 1e-1},
+	"cL": {volume, 1e-2},
+	"mL": {volume, 1e-3},
+	"ml": {volume, 1e-3},
+	"uL": {volume, 1e-6},
+
+	// Amount of substance, base unit mole.
+	"mol":  {amount, 1},
+	"mmol": {amount, 1e-3},
+	"umol": {amount, 1e-6},
+	"nmol": {amount, 1e-9},
+
+	// Pressure, base unit pascal.
+	"Pa":      {pressure, 1},
+	"kPa":     {pressure, 1e3},
+	"mm[Hg]":  {pressure, 133.322387415},
+	"cm[H2O]": {pressure, 98.0665},
+
+	// Definite durations, base unit second.
+	"wk":          {duration, 604800},
+	"d":           {duration, 86400},
+	"h":           {duration, 3600},
+	"min":         {duration, 60},
+	"s":           {duration, 1},
+	"ms":          {duration, 1e-3},
+	"week":        {duration, 604800},
+	"day":         {duration, 86400},
+	"hour":        {duration, 3600},
+	"minute":      {duration, 60},
+	"second":      {duration, 1},
+	"millisecond": {duration, 1e-3},
+
+	// Calendar durations, base unit month.
+	"year":  {calendarDuration, 12},
+	"month": {calendarDuration, 1},
+}
+
+// ConvertUnit converts value from the fromUnit to the toUnit. An error wrapping
+// ErrUnsupportedUnit is returned if either unit is unknown, and an error wrapping
+// ErrIncompatibleUnits is returned if the units measure different dimensions (ex grams and
+// meters).
+func ConvertUnit(value float64, fromUnit, toUnit string) (float64, error) {
+	if fromUnit == toUnit {
+		return value, nil
+	}
+	from, ok := units[fromUnit]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnsupportedUnit, fromUnit)
+	}
+	to, ok := units[toUnit]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnsupportedUnit, toUnit)
+	}
+	if from.dimension != to.dimension {
+		return 0, fmt.Errorf("%w, cannot convert %q to %q", ErrIncompatibleUnits, fromUnit, toUnit)
+	}
+	return value * from.factor / to.factor, nil
+}
+
+// CanConvert returns whether a value in the fromUnit can be converted to the toUnit.
+func CanConvert(fromUnit, toUnit string) bool {
+	if fromUnit == toUnit {
+		return true
+	}
+	from, ok := units[fromUnit]
+	if !ok {
+		return false
+	}
+	to, ok := units[toUnit]
+	if !ok {
+		return false
+	}
+	return from.dimension == to.dimension
+}
+
+// exponentRegex matches a unit atom with an optional integer exponent, for example m2 or s-1.
+var exponentRegex = regexp.MustCompile(`^(.*?[^+\-\d])([+\-]?\d+)?$`)
+
+// annotationRegex matches UCUM annotations such as {beats}, which do not affect the unit.
+var annotationRegex = regexp.MustCompile(`\{[^}]*\}`)
+
+// ValidateUnit returns an error wrapping ErrInvalidUnit if unit is not syntactically valid UCUM.
+// Units may be combined with the UCUM multiplication (.) and division (/) operators, may be grouped
+// in parentheses, may have integer exponents and may have annotations, for example mg/dL, m2,
+// 10*3/uL or {beats}/min. Only the syntax is checked, so well formed units outside of the supported
+// subset such as U/L or [degF] are valid even though they can not be converted.
+func ValidateUnit(unit string) error {
+	if _, ok := units[unit]; ok {
+		return nil
+	}
+	if !validUnit(unit) {
+		return fmt.Errorf("%w %q", ErrInvalidUnit, unit)
+	}
+	return nil
+}
+
+// validUnit returns true if unit is a syntactically valid UCUM term.
+func validUnit(unit string) bool {
+	terms := splitTerms(unit)
+	for i, term := range terms {
+		// A leading division such as /min has an empty first term.
+		if term == "" && i == 0 && len(terms) > 1 {
+			continue
+		}
+		if !validTerm(term) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitTerms splits a unit on the UCUM multiplication and division operators, ignoring any
+// operators within annotations, square brackets or parentheses.
+func splitTerms(unit string) []string {
+	var terms []string
+	depth := 0
+	start := 0
+	for i, r := range unit {
+		switch r {
+		case '{', '[', '(':
+			depth++
+		case '}', ']', ')':
+			depth--
+		case '.', '/':
+			if depth == 0 {
+				terms = append(terms, unit[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, unit[start:])
+}
+
+// validTerm returns true if term is a unit atom with an optional prefix, exponent and annotations,
+// an integer factor, or a parenthesized unit.
+func validTerm(term string) bool {
+	stripped := annotationRegex.ReplaceAllString(term, "")
+	if stripped == "" {
+		// A term made up solely of annotations, such as {beats}, is unitless.
+		return term != ""
+	}
+	if strings.Trim(stripped, "0123456789") == "" {
+		// Integer factors such as the 1 in 1/min are valid.
+		return true
+	}
+	if strings.HasPrefix(stripped, "(") && strings.HasSuffix(stripped, ")") {
+		return validUnit(stripped[1 : len(stripped)-1])
+	}
+	m := exponentRegex.FindStringSubmatch(stripped)
+	if m == nil {
+		return false
+	}
+	return validAtom(m[1])
+}
+
+// validAtom returns true if atom is made up of the characters UCUM allows in unit symbols, with
+// any square brackets balanced and not nested, for example mg, 10* or mm[Hg].
+func validAtom(atom string) bool {
+	inBrackets := false
+	for _, r := range atom {
+		switch {
+		case r == '[':
+			if inBrackets {
+				return false
+			}
+			inBrackets = true
+		case r == ']':
+			if !inBrackets {
+				return false
+			}
+			inBrackets = false
+		case r <= ' ' || r > '~' || strings.ContainsRune(`(){}"`, r):
+			return false
+		}
+	}
+	return !inBrackets
+}
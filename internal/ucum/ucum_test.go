@@ -0,0 +1,160 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ucum
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConvertUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		fromUnit string
+		toUnit   string
+		want     float64
+	}{
+		{
+			name:     "same unit",
+			value:    3,
+			fromUnit: "cm",
+			toUnit:   "cm",
+			want:     3,
+		},
+		{
+			name:     "milligrams to grams",
+			value:    500,
+			fromUnit: "mg",
+			toUnit:   "g",
+			want:     0.5,
+		},
+		{
+			name:     "grams to milligrams",
+			value:    2,
+			fromUnit: "g",
+			toUnit:   "mg",
+			want:     2000,
+		},
+		{
+			name:     "minutes to seconds",
+			value:    2,
+			fromUnit: "min",
+			toUnit:   "s",
+			want:     120,
+		},
+		{
+			name:     "UCUM hours to CQL minute keyword",
+			value:    1,
+			fromUnit: "h",
+			toUnit:   "minute",
+			want:     60,
+		},
+		{
+			name:     "years to months",
+			value:    2,
+			fromUnit: "year",
+			toUnit:   "month",
+			want:     24,
+		},
+		{
+			name:     "empty unit to 1",
+			value:    4,
+			fromUnit: "",
+			toUnit:   "1",
+			want:     4,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertUnit(tc.value, tc.fromUnit, tc.toUnit)
+			if err != nil {
+				t.Fatalf("ConvertUnit(%v, %q, %q) returned unexpected error: %v", tc.value, tc.fromUnit, tc.toUnit, err)
+			}
+			if got != tc.want {
+				t.Errorf("ConvertUnit(%v, %q, %q) = %v, want %v", tc.value, tc.fromUnit, tc.toUnit, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertUnit_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		fromUnit string
+		toUnit   string
+		wantErr  error
+	}{
+		{
+			name:     "incompatible dimensions",
+			fromUnit: "g",
+			toUnit:   "m",
+			wantErr:  ErrIncompatibleUnits,
+		},
+		{
+			name:     "calendar and definite durations",
+			fromUnit: "month",
+			toUnit:   "d",
+			wantErr:  ErrIncompatibleUnits,
+		},
+		{
+			name:     "unsupported from unit",
+			fromUnit: "furlong",
+			toUnit:   "m",
+			wantErr:  ErrUnsupportedUnit,
+		},
+		{
+			name:     "unsupported to unit",
+			fromUnit: "m",
+			toUnit:   "furlong",
+			wantErr:  ErrUnsupportedUnit,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ConvertUnit(1, tc.fromUnit, tc.toUnit)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("ConvertUnit(1, %q, %q) returned error %v, want %v", tc.fromUnit, tc.toUnit, err, tc.wantErr)
+			}
+			if CanConvert(tc.fromUnit, tc.toUnit) {
+				t.Errorf("CanConvert(%q, %q) = true, want false", tc.fromUnit, tc.toUnit)
+			}
+		})
+	}
+}
+
+func TestValidateUnit(t *testing.T) {
+	tests := []string{"mg", "1", "", "mm[Hg]", "mg/dL", "kg.m/s2", "/min", "{beats}/min", "mg{total}", "m2", "1/d", "day",
+		// Well formed units outside of the supported subset.
+		"U/L", "10*3/uL", "[iU]", "Cel", "[degF]", "a", "mo", "furlong", "mg/(kg.d)", "%"}
+	for _, unit := range tests {
+		t.Run(unit, func(t *testing.T) {
+			if err := ValidateUnit(unit); err != nil {
+				t.Errorf("ValidateUnit(%q) returned unexpected error: %v", unit, err)
+			}
+		})
+	}
+}
+
+func TestValidateUnit_Error(t *testing.T) {
+	tests := []string{"mg//dL", "m.", "mg/", "[mm", "mm]Hg", "m g", "mg/(kg", "{beats"}
+	for _, unit := range tests {
+		t.Run(unit, func(t *testing.T) {
+			if err := ValidateUnit(unit); !errors.Is(err, ErrInvalidUnit) {
+				t.Errorf("ValidateUnit(%q) returned error %v, want %v", unit, err, ErrInvalidUnit)
+			}
+		})
+	}
+}
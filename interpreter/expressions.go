@@ -15,7 +15,6 @@
 package interpreter
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -23,6 +22,7 @@ import (
 
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
+	"github.com/google/cql/retriever"
 	"github.com/google/cql/terminology"
 	"github.com/google/cql/types"
 	dtpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/datatypes_go_proto"
@@ -32,6 +32,9 @@ import (
 )
 
 func (i *interpreter) evalExpression(elem model.IExpression) (result.Value, error) {
+	if err := i.ctx.Err(); err != nil {
+		return result.Value{}, err
+	}
 	switch elem := elem.(type) {
 	case *model.Literal:
 		return i.evalLiteral(elem)
@@ -43,6 +46,16 @@ func (i *interpreter) evalExpression(elem model.IExpression) (result.Value, erro
 		return i.evalList(elem)
 	case *model.Code:
 		return i.evalCode(elem)
+	case *model.Coalesce:
+		return i.evalCoalesce(elem)
+	case *model.Equivalent:
+		return i.evalEquivalent(elem)
+	case *model.And:
+		return i.evalShortCircuitLogic(elem)
+	case *model.Or:
+		return i.evalShortCircuitLogic(elem)
+	case *model.Implies:
+		return i.evalShortCircuitLogic(elem)
 	case model.IUnaryExpression:
 		return i.evalUnaryExpression(elem)
 	case model.IBinaryExpression:
@@ -55,6 +68,8 @@ func (i *interpreter) evalExpression(elem model.IExpression) (result.Value, erro
 		return i.evalProperty(elem)
 	case *model.Query:
 		return i.evalQuery(elem)
+	case *model.Repeat:
+		return i.evalRepeat(elem)
 	case *model.QueryLetRef:
 		return i.evalQueryLetRef(elem)
 	case *model.AliasRef:
@@ -97,7 +112,8 @@ func (i *interpreter) evalExpression(elem model.IExpression) (result.Value, erro
 	}
 }
 
-// TODO b/324637028 - Implement all message types
+// Message(source T, condition Boolean, code String, severity String, message String) T
+// https://cql.hl7.org/09-b-cqlreference.html#message
 func (i *interpreter) evalMessage(m *model.Message) (result.Value, error) {
 	source, err := i.evalExpression(m.Source)
 	if err != nil {
@@ -109,7 +125,10 @@ func (i *interpreter) evalMessage(m *model.Message) (result.Value, error) {
 		return result.Value{}, err
 	}
 
-	// Whether or not to emit the message value
+	// Whether or not to emit the message value, a null condition is treated as false.
+	if result.IsNull(cond) {
+		return source, nil
+	}
 	condVal, err := result.ToBool(cond)
 	if err != nil {
 		return result.Value{}, err
@@ -150,12 +169,9 @@ func (i *interpreter) evalMessage(m *model.Message) (result.Value, error) {
 		return result.Value{}, err
 	}
 
-	// TODO b/301606416: Add support for model.TRACE.
-
-	outString := fmt.Sprintf("%s %s: %s", severity, codeVal, messageVal)
-	fmt.Println(outString)
+	i.messageHandler(severity, codeVal, messageVal, source)
 	if severity == model.ERROR {
-		errMsg := fmt.Sprintf("log error - Message with severity of type `Error` was called with message: %s", outString)
+		errMsg := fmt.Sprintf("log error - Message with severity of type `Error` was called with message: %s %s: %s", severity, codeVal, messageVal)
 		return source, errors.New(errMsg)
 	}
 	return source, nil
@@ -173,9 +189,29 @@ func (i *interpreter) evalRetrieve(expr *model.Retrieve) (result.Value, error) {
 	if len(name) != 2 {
 		return result.Value{}, fmt.Errorf("Resource datatype (%s) did not contain the library uri (%s)", expr.DataType, url)
 	}
-	got, err := i.retriever.Retrieve(context.Background(), name[1])
-	if err != nil {
-		return result.Value{}, err
+
+	var got []*r4pb.ContainedResource
+	// filteredBySource is true if the retriever already filtered the resources on the codes.
+	filteredBySource := false
+	var filter retriever.Filter
+	fr, isFiltered := i.retriever.(retriever.FilteredRetriever)
+	if isFiltered {
+		filter, err = i.retrieveFilter(expr)
+		if err != nil {
+			return result.Value{}, err
+		}
+	}
+	if isFiltered && (filter.Codes != nil || filter.Dates != nil) {
+		got, err = fr.RetrieveFiltered(i.ctx, name[1], filter)
+		if err != nil {
+			return result.Value{}, err
+		}
+		filteredBySource = filter.Codes != nil
+	} else {
+		got, err = i.retriever.Retrieve(i.ctx, name[1])
+		if err != nil {
+			return result.Value{}, err
+		}
 	}
 
 	// Assume the retrieve result type should be a list:
@@ -199,7 +235,7 @@ func (i *interpreter) evalRetrieve(expr *model.Retrieve) (result.Value, error) {
 			return result.Value{}, err
 		}
 
-		if expr.Codes != nil {
+		if expr.Codes != nil && !filteredBySource {
 			// We must try to filter on the codes provided.
 			if expr.CodeProperty == "" {
 				return result.Value{}, fmt.Errorf("code property must be populated when filtering on codes")
@@ -231,6 +267,45 @@ func (i *interpreter) evalRetrieve(expr *model.Retrieve) (result.Value, error) {
 	return result.NewWithSources(result.List{Value: l, StaticType: listResultType}, expr, l...)
 }
 
+// retrieveFilter returns the Filter passed to a retriever.FilteredRetriever for a retrieve. The
+// date filter is omitted if the DateRange is null.
+func (i *interpreter) retrieveFilter(expr *model.Retrieve) (retriever.Filter, error) {
+	var filter retriever.Filter
+	if expr.Codes != nil {
+		if expr.CodeProperty == "" {
+			return retriever.Filter{}, fmt.Errorf("code property must be populated when filtering on codes")
+		}
+		vr, ok := expr.Codes.(*model.ValuesetRef)
+		if !ok {
+			return retriever.Filter{}, fmt.Errorf("only ValueSet references are currently supported for valueset filtering")
+		}
+		vs, err := i.evalValuesetRef(vr)
+		if err != nil {
+			return retriever.Filter{}, err
+		}
+		vsv, err := result.ToValueSet(vs)
+		if err != nil {
+			return retriever.Filter{}, err
+		}
+		filter.Codes = &retriever.CodeFilter{CodeProperty: expr.CodeProperty, ValueSetURL: vsv.ID, ValueSetVersion: vsv.Version}
+	}
+
+	if expr.DateRange != nil {
+		dr, err := i.evalExpression(expr.DateRange)
+		if err != nil {
+			return retriever.Filter{}, err
+		}
+		if !result.IsNull(dr) {
+			interval, err := result.ToInterval(dr)
+			if err != nil {
+				return retriever.Filter{}, err
+			}
+			filter.Dates = &retriever.DateFilter{DateProperty: expr.DateProperty, DateRange: interval}
+		}
+	}
+	return filter, nil
+}
+
 func (i *interpreter) inValueSet(codeableConcept result.Value, codes model.IExpression) (bool, error) {
 	if result.IsNull(codeableConcept) {
 		return false, nil
@@ -262,7 +337,7 @@ func (i *interpreter) inValueSet(codeableConcept result.Value, codes model.IExpr
 
 	for _, coding := range ccPB.GetCoding() {
 		// TODO: b/331447080 - Convert to using system operators for evaluating valueset membership.
-		in, err := i.terminologyProvider.AnyInValueSet([]terminology.Code{{System: coding.GetSystem().Value, Code: coding.GetCode().Value}}, vsv.ID, vsv.Version)
+		in, err := i.terminologyProvider.AnyInValueSet(i.versionedCodes([]terminology.Code{{System: coding.GetSystem().GetValue(), Code: coding.GetCode().GetValue(), Version: coding.GetVersion().GetValue()}}), vsv.ID, vsv.Version)
 		if err != nil {
 			return false, err
 		}
@@ -345,10 +420,44 @@ func (i *interpreter) evalConceptRef(expr *model.ConceptRef) (result.Value, erro
 }
 
 func (i *interpreter) evalExpressionRef(expr *model.ExpressionRef) (result.Value, error) {
+	var res result.Value
+	var err error
 	if expr.LibraryName != "" {
-		return i.refs.ResolveGlobal(expr.LibraryName, expr.Name)
+		res, err = i.refs.ResolveGlobal(expr.LibraryName, expr.Name)
+	} else {
+		res, err = i.refs.ResolveLocal(expr.Name)
 	}
-	return i.refs.ResolveLocal(expr.Name)
+	if err != nil || !i.disableDefCache {
+		return res, err
+	}
+	return i.reevalExpressionDef(expr)
+}
+
+// reevalExpressionDef evaluates the expression definition referenced by expr again, in the context
+// of the library the definition belongs to.
+func (i *interpreter) reevalExpressionDef(expr *model.ExpressionRef) (result.Value, error) {
+	if expr.LibraryName == "" {
+		def, ok := i.exprDefs[result.DefKey{Name: expr.Name, Library: i.currLib}]
+		if !ok {
+			return result.Value{}, fmt.Errorf("internal error - could not find the definition of %s", expr.Name)
+		}
+		return i.evalExpression(def.GetExpression())
+	}
+
+	libKey := result.LibKeyFromModel(i.refs.ResolveInclude(expr.LibraryName))
+	def, ok := i.exprDefs[result.DefKey{Name: expr.Name, Library: libKey}]
+	if !ok {
+		return result.Value{}, fmt.Errorf("internal error - could not find the definition of %s.%s", expr.LibraryName, expr.Name)
+	}
+	exitLibrary, err := i.refs.EnterIncludedLibrary(expr.LibraryName)
+	if err != nil {
+		return result.Value{}, err
+	}
+	defer exitLibrary()
+	prevLib := i.currLib
+	i.currLib = libKey
+	defer func() { i.currLib = prevLib }()
+	return i.evalExpression(def.GetExpression())
 }
 
 // applyToValues is a convenience wrapper that invokes fn on both Values. If an error is returned
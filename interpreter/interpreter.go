@@ -32,22 +32,92 @@ import (
 
 // Config configures the evaluation of the CQL.
 type Config struct {
-	DataModels          *modelinfo.ModelInfos
-	Parameters          map[result.DefKey]model.IExpression
-	Retriever           retriever.Retriever
-	Terminology         terminology.Provider
+	DataModels  *modelinfo.ModelInfos
+	Parameters  map[result.DefKey]model.IExpression
+	Retriever   retriever.Retriever
+	Terminology terminology.Provider
+	// EvaluationTimestamp is the instant that Now(), Today() and TimeOfDay() are derived from, so
+	// they are consistent for the whole evaluation. If zero it defaults to time.Now().
 	EvaluationTimestamp time.Time
 	ReturnPrivateDefs   bool
+	// MessageHandler receives the messages emitted by the CQL Message() operator. If nil messages
+	// are printed to stdout.
+	MessageHandler MessageHandler
+	// StrictCodeSystemVersions if true passes the code system version of each code to the
+	// terminology Provider, so a code only matches a ValueSet or CodeSystem of the same version. By
+	// default codes are matched on system and code alone.
+	StrictCodeSystemVersions bool
+	// DecimalPrecision is the number of digits after the decimal point that the Decimal results of
+	// Divide, Modulo, Avg, GeometricMean, Median and the variance and standard deviation aggregates
+	// are rounded to. If nil the CQL default of 8 is used.
+	DecimalPrecision *int
+	// DecimalRounding is the rounding mode used for those results and by the Round() operator.
+	DecimalRounding RoundingMode
+	// DisableDefinitionCache if true evaluates an expression definition again each time it is
+	// referenced. By default each definition is evaluated once and every reference reuses the result.
+	DisableDefinitionCache bool
 }
 
-// Eval evaluates the intermediate ELM like data structure from our parser.
+// DefaultDecimalPrecision is the number of digits after the decimal point Decimal results are
+// rounded to if Config.DecimalPrecision is not set.
+// https://cql.hl7.org/09-b-cqlreference.html#decimal
+const DefaultDecimalPrecision = 8
+
+// RoundingMode determines how Decimal values are rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds midpoint values towards positive infinity, so 0.5 rounds to 1 and -0.5
+	// rounds to 0.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds midpoint values to the nearest even digit, so 0.5 rounds to 0 and 1.5
+	// rounds to 2.
+	RoundHalfEven
+	// RoundDown truncates towards zero.
+	RoundDown
+)
+
+// MessageHandler is called each time the CQL Message() operator is evaluated with a true
+// condition. The source is the value Message() returns unchanged. Messages with an Error severity
+// are passed to the handler before evaluation halts.
+type MessageHandler func(severity model.MessageSeverity, code, message string, source result.Value)
+
+func printMessage(severity model.MessageSeverity, code, message string, _ result.Value) {
+	fmt.Printf("%s %s: %s\n", severity, code, message)
+}
+
+// Eval evaluates the intermediate ELM like data structure from our parser. Evaluation stops and
+// returns the context error if ctx is canceled or its deadline is exceeded.
 func Eval(ctx context.Context, libs []*model.Library, config Config) (result.Libraries, error) {
+	decimalPrecision := DefaultDecimalPrecision
+	if config.DecimalPrecision != nil {
+		if *config.DecimalPrecision < 0 {
+			return nil, fmt.Errorf("DecimalPrecision must not be negative, got %v", *config.DecimalPrecision)
+		}
+		decimalPrecision = *config.DecimalPrecision
+	}
+	evalTS := config.EvaluationTimestamp
+	if evalTS.IsZero() {
+		evalTS = time.Now()
+	}
 	i := &interpreter{
+		ctx:                 ctx,
 		refs:                reference.NewResolver[result.Value, *model.FunctionDef](),
 		terminologyProvider: config.Terminology,
 		retriever:           config.Retriever,
-		modelInfo:           config.DataModels,
-		evaluationTimestamp: config.EvaluationTimestamp,
+		// Each evaluation sets its own using declarations, so concurrent evaluations must not share
+		// the ModelInfos.
+		modelInfo:           config.DataModels.Clone(),
+		evaluationTimestamp: evalTS,
+		messageHandler:      config.MessageHandler,
+		strictCodeVersions:  config.StrictCodeSystemVersions,
+		decimalPrecision:    decimalPrecision,
+		decimalRounding:     config.DecimalRounding,
+		disableDefCache:     config.DisableDefinitionCache,
+		exprDefs:            make(map[result.DefKey]*model.ExpressionDef),
+	}
+	if i.messageHandler == nil {
+		i.messageHandler = printMessage
 	}
 
 	for _, lib := range libs {
@@ -64,11 +134,22 @@ func Eval(ctx context.Context, libs []*model.Library, config Config) (result.Lib
 
 // interpreter takes the intermediate ELM like data structure from the parser and executes it.
 type interpreter struct {
+	ctx                 context.Context
 	refs                *reference.Resolver[result.Value, *model.FunctionDef]
 	retriever           retriever.Retriever
 	terminologyProvider terminology.Provider
 	modelInfo           *modelinfo.ModelInfos
 	evaluationTimestamp time.Time
+	messageHandler      MessageHandler
+	strictCodeVersions  bool
+	decimalPrecision    int
+	decimalRounding     RoundingMode
+	// disableDefCache if true expression definitions are evaluated again on each reference, using
+	// exprDefs to find the definition.
+	disableDefCache bool
+	exprDefs        map[result.DefKey]*model.ExpressionDef
+	// currLib is the library whose expressions are being evaluated.
+	currLib result.LibKey
 }
 
 // evalLibrary takes a library and evaluates all the expressions that it contains.
@@ -83,6 +164,7 @@ func (i *interpreter) evalLibrary(lib *model.Library, passedParams map[result.De
 		passedParams = make(map[result.DefKey]model.IExpression)
 	}
 
+	i.currLib = result.LibKeyFromModel(lib.Identifier)
 	if lib.Identifier != nil {
 		i.refs.SetCurrentLibrary(lib.Identifier)
 	} else {
@@ -220,7 +302,7 @@ func (i *interpreter) evalLibrary(lib *model.Library, passedParams map[result.De
 			case *model.ExpressionDef:
 				res, err := i.evalExpression(s.GetExpression())
 				if err != nil {
-					return err
+					return defError(t, err)
 				}
 				d := &reference.Def[result.Value]{
 					Name:             s.GetName(),
@@ -231,6 +313,9 @@ func (i *interpreter) evalLibrary(lib *model.Library, passedParams map[result.De
 				if err = i.refs.Define(d); err != nil {
 					return err
 				}
+				if i.disableDefCache {
+					i.exprDefs[result.DefKey{Name: t.GetName(), Library: i.currLib}] = t
+				}
 			case *model.FunctionDef:
 				opTypes := []types.IType{}
 				for _, op := range t.Operands {
@@ -256,6 +341,15 @@ func (i *interpreter) evalLibrary(lib *model.Library, passedParams map[result.De
 	return nil
 }
 
+// defError wraps an error returned while evaluating an ExpressionDef with the name and source
+// position of the definition.
+func defError(d *model.ExpressionDef, err error) error {
+	if d.Element == nil || d.Locator == nil {
+		return fmt.Errorf("define %q: %w", d.Name, err)
+	}
+	return fmt.Errorf("define %q at %v: %w", d.Name, d.Locator, err)
+}
+
 func (i *interpreter) evalParameters(paramDefs []*model.ParameterDef, id *model.LibraryIdentifier, passedParams map[result.DefKey]model.IExpression) error {
 	if id == nil && len(paramDefs) > 0 {
 		return fmt.Errorf("unnamed libraries cannot have parameters, got %v", paramDefs[0].Name)
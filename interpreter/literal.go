@@ -225,6 +225,29 @@ func (i *interpreter) evalInstance(in *model.Instance) (result.Value, error) {
 		}
 
 		return result.New(qv)
+	case types.Ratio:
+		rv := result.Ratio{}
+		// Numerator
+		numeratorObj, ok := elems["numerator"]
+		if ok && !result.IsNull(numeratorObj) {
+			numerator, err := result.ToQuantity(numeratorObj)
+			if err != nil {
+				return result.Value{}, err
+			}
+			rv.Numerator = numerator
+		}
+
+		// Denominator
+		denominatorObj, ok := elems["denominator"]
+		if ok && !result.IsNull(denominatorObj) {
+			denominator, err := result.ToQuantity(denominatorObj)
+			if err != nil {
+				return result.Value{}, err
+			}
+			rv.Denominator = denominator
+		}
+
+		return result.New(rv)
 	case types.Code:
 		cv := result.Code{}
 		// Code
@@ -15,7 +15,10 @@
 package interpreter
 
 import (
+	"cmp"
 	"fmt"
+	"math"
+	"slices"
 	"sort"
 
 	"github.com/google/cql/model"
@@ -28,13 +31,13 @@ import (
 // AllTrue(argument List<Boolean>) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#alltrue
 func (i *interpreter) evalAllTrue(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
-	if result.IsNull(operand) {
-		return result.New(true)
-	}
-	l, err := result.ToSlice(operand)
+	l, isNull, err := result.ToNullableSlice(operand)
 	if err != nil {
 		return result.Value{}, err
 	}
+	if isNull {
+		return result.New(true)
+	}
 	for _, elem := range l {
 		if result.IsNull(elem) {
 			continue
@@ -78,6 +81,7 @@ func (i *interpreter) evalAnyTrue(m model.IUnaryExpression, operand result.Value
 // Avg(argument List<Decimal>) Decimal
 // Avg(argument List<Quantity>) Quantity
 // https://cql.hl7.org/09-b-cqlreference.html#avg
+// Avg over List<Integer> and List<Long> is computed as a Decimal to avoid truncating the result.
 func (i *interpreter) evalAvg(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
 	if result.IsNull(operand) {
 		return result.New(nil)
@@ -88,19 +92,19 @@ func (i *interpreter) evalAvg(m model.IUnaryExpression, operand result.Value) (r
 	}
 	lType, ok := operand.RuntimeType().(*types.List)
 	if !ok {
-		return result.Value{}, fmt.Errorf("Avg(%v) operand is not a list", m.GetName())
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
 	}
 	switch lType.ElementType {
 	case types.Any:
 		// Special case for handling lists that contain only null runtime values.
 		return result.New(nil)
-	case types.Decimal:
+	case types.Decimal, types.Integer, types.Long:
 		var sum, count float64
 		for _, elem := range l {
 			if result.IsNull(elem) {
 				continue
 			}
-			v, err := result.ToFloat64(elem)
+			v, err := numericToFloat64(elem)
 			if err != nil {
 				return result.Value{}, err
 			}
@@ -110,7 +114,7 @@ func (i *interpreter) evalAvg(m model.IUnaryExpression, operand result.Value) (r
 		if count == 0 {
 			return result.New(nil)
 		}
-		return result.New(sum / count)
+		return result.New(i.roundToDecimalPrecision(sum / count))
 	case types.Quantity:
 		// Keep a running sum of found quantity values and then divide by the count at the end.
 		var resultQuantity *result.Quantity
@@ -126,97 +130,125 @@ func (i *interpreter) evalAvg(m model.IUnaryExpression, operand result.Value) (r
 			if resultQuantity == nil {
 				resultQuantity = &result.Quantity{Value: 0, Unit: v.Unit}
 			}
-			if resultQuantity.Unit != v.Unit {
-				return result.Value{}, fmt.Errorf("Avg(%v) Quantity operand has different units which is not supported, got %v and %v", m.GetName(), resultQuantity.Unit, v.Unit)
+			// All elements are converted to the unit of the first non-null element.
+			cv, err := convertQuantity(v, resultQuantity.Unit)
+			if err != nil {
+				return result.Value{}, result.IncompatibleUnitError{Operator: m.GetName(), Unit: resultQuantity.Unit, OtherUnit: v.Unit, Err: err}
 			}
 			count++
-			resultQuantity.Value += v.Value
+			resultQuantity.Value += cv.Value
 		}
 		if resultQuantity == nil {
 			return result.New(nil)
 		}
-		resultQuantity.Value /= count
+		resultQuantity.Value = i.roundToDecimalPrecision(resultQuantity.Value / count)
 		return result.New(*resultQuantity)
 	default:
-		return result.Value{}, fmt.Errorf("Avg(%v) operand is not a list of Decimal or Quantity", m.GetName())
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
 	}
 }
 
 // Count(argument List<T>) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#count
+// For Count(distinct X) only the unique non-null elements of X are counted.
 func (i *interpreter) evalCount(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
-	if result.IsNull(operand) {
-		return result.New(0)
-	}
-	l, err := result.ToSlice(operand)
+	l, isNull, err := result.ToNullableSlice(operand)
 	if err != nil {
 		return result.Value{}, err
 	}
+	if isNull {
+		return result.New(0)
+	}
+	distinct := false
+	if c, ok := m.(*model.Count); ok {
+		distinct = c.Distinct
+	}
+	var unique []result.Value
 	count := 0
 	for _, elem := range l {
-		if !result.IsNull(elem) {
-			count++
+		if result.IsNull(elem) {
+			continue
 		}
+		if distinct {
+			unique, err = i.appendIfDistinct(unique, elem)
+			if err != nil {
+				return result.Value{}, err
+			}
+			continue
+		}
+		count++
+	}
+	if distinct {
+		return result.New(len(unique))
 	}
 	return result.New(count)
 }
 
-// Max(argument List<Date>) Date
-// Max(argument List<DateTime>) DateTime
-// https://cql.hl7.org/09-b-cqlreference.html#max
-func (i *interpreter) evalMaxDateTime(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
-	if result.IsNull(operand) {
-		return result.New(nil)
-	}
-	l, err := result.ToSlice(operand)
+// GeometricMean(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#geometricmean
+// GeometricMean over List<Integer> is computed as a Decimal. The geometric mean is undefined for
+// negative values, so a list containing a negative element returns an error. The result is rounded
+// to the configured Decimal precision.
+func (i *interpreter) evalGeometricMean(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	values, err := nonNullFloat64s(operand)
 	if err != nil {
 		return result.Value{}, err
 	}
-	if len(l) == 0 {
-		return result.New(nil)
-	}
-	lType, ok := operand.RuntimeType().(*types.List)
-	if !ok {
-		return result.Value{}, fmt.Errorf("Max(%v) operand is not a list", m.GetName())
-	}
-	// Special case for handling lists that contain only null runtime values.
-	if lType.ElementType == types.Any {
+	if len(values) == 0 {
 		return result.New(nil)
 	}
-	minDtVal, err := minValue(lType.ElementType, &i.evaluationTimestamp)
-	if err != nil {
-		return result.Value{}, err
-	}
-	dt, err := result.ToDateTime(minDtVal)
-	if err != nil {
-		return result.Value{}, err
-	}
-	for _, elem := range l {
-		if result.IsNull(elem) {
-			continue
+	// The nth root of the product is computed as the exponential of the mean of the logarithms so
+	// that large lists do not overflow.
+	var logSum float64
+	hasZero := false
+	for _, v := range values {
+		if v < 0 {
+			return result.Value{}, result.DomainError{Operator: m.GetName(), Type: operand.RuntimeType(), Reason: "negative values", Value: v}
 		}
-		v, err := result.ToDateTime(elem)
-		if err != nil {
-			return result.Value{}, err
-		}
-		compareResult, err := compareDateTime(dt, v)
-		if err != nil {
-			return result.Value{}, err
-		}
-		if compareResult == leftBeforeRight {
-			dt = v
+		if v == 0 {
+			hasZero = true
+			continue
 		}
+		logSum += math.Log(v)
 	}
-	if m.GetResultType() == types.Date {
-		return result.New(result.Date(dt))
+	if hasZero {
+		return result.New(0.0)
 	}
-	return result.New(dt)
+	return result.New(i.roundToDecimalPrecision(math.Exp(logSum / float64(len(values)))))
+}
+
+// Max(argument List<Integer>) Integer
+// Max(argument List<Long>) Long
+// Max(argument List<Decimal>) Decimal
+// Max(argument List<Quantity>) Quantity
+// Max(argument List<Date>) Date
+// Max(argument List<DateTime>) DateTime
+// Max(argument List<Time>) Time
+// Max(argument List<String>) String
+// https://cql.hl7.org/09-b-cqlreference.html#max
+func evalMax(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	return extremeValue(operand, leftBeforeRight)
 }
 
+// Min(argument List<Integer>) Integer
+// Min(argument List<Long>) Long
+// Min(argument List<Decimal>) Decimal
+// Min(argument List<Quantity>) Quantity
 // Min(argument List<Date>) Date
 // Min(argument List<DateTime>) DateTime
+// Min(argument List<Time>) Time
+// Min(argument List<String>) String
 // https://cql.hl7.org/09-b-cqlreference.html#min
-func (i *interpreter) evalMinDateTime(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
+func evalMin(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	return extremeValue(operand, leftAfterRight)
+}
+
+// extremeValue returns the largest or smallest non-null element of the list operand. The current
+// extreme is replaced by each element it compares as replace to, which is leftBeforeRight to find
+// the largest element and leftAfterRight to find the smallest. Returns null for a null, empty or all
+// null list, and when an element cannot be ordered against the current extreme because their
+// precisions differ, since the comparison and so the result is uncertain.
+func extremeValue(operand result.Value, replace comparison) (result.Value, error) {
 	if result.IsNull(operand) {
 		return result.New(nil)
 	}
@@ -224,81 +256,85 @@ func (i *interpreter) evalMinDateTime(m model.IUnaryExpression, operand result.V
 	if err != nil {
 		return result.Value{}, err
 	}
-	if len(l) == 0 {
-		return result.New(nil)
-	}
-	lType, ok := operand.RuntimeType().(*types.List)
-	if !ok {
-		return result.Value{}, fmt.Errorf("Min(%v) operand is not a list", m.GetName())
-	}
-	// Special case for handling lists that contain only null runtime values.
-	if lType.ElementType == types.Any {
-		return result.New(nil)
-	}
-	maxDtVal, err := maxValue(lType.ElementType, &i.evaluationTimestamp)
-	if err != nil {
-		return result.Value{}, err
-	}
-	dt, err := result.ToDateTime(maxDtVal)
-	if err != nil {
-		return result.Value{}, err
+	if slices.ContainsFunc(l, isUncertain) {
+		return extremeUncertainty(l, replace)
 	}
+	var extreme *result.Value
 	for _, elem := range l {
 		if result.IsNull(elem) {
 			continue
 		}
-		v, err := result.ToDateTime(elem)
-		if err != nil {
-			return result.Value{}, err
+		if extreme == nil {
+			extreme = &elem
+			continue
 		}
-		compareResult, err := compareDateTime(dt, v)
+		c, err := compareAggregateValues(*extreme, elem)
 		if err != nil {
 			return result.Value{}, err
 		}
-		if compareResult == leftAfterRight {
-			dt = v
+		if c == insufficientPrecision {
+			return result.New(nil)
+		}
+		if c == replace {
+			extreme = &elem
 		}
 	}
-	if m.GetResultType() == types.Date {
-		return result.New(result.Date(dt))
-	}
-	return result.New(dt)
-}
-
-// Median(argument List<Decimal>) Decimal
-// https://cql.hl7.org/09-b-cqlreference.html#median
-func (i *interpreter) evalMedianDecimal(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
-	if result.IsNull(operand) {
+	if extreme == nil {
 		return result.New(nil)
 	}
+	return *extreme, nil
+}
 
-	l, err := result.ToSlice(operand)
-	if err != nil {
-		return result.Value{}, err
-	}
-
-	values := make([]float64, 0, len(l))
+// extremeUncertainty returns the largest or smallest element of a list of Integers, some of which
+// are uncertain. Each bound of the result is the largest or smallest of that bound of the elements.
+func extremeUncertainty(l []result.Value, replace comparison) (result.Value, error) {
+	var extreme result.Uncertainty
+	var foundValue bool
 	for _, elem := range l {
 		if result.IsNull(elem) {
 			continue
 		}
-		v, err := result.ToFloat64(elem)
+		u, err := toUncertainty(elem)
 		if err != nil {
 			return result.Value{}, err
 		}
-		values = append(values, v)
+		if !foundValue {
+			extreme = u
+			foundValue = true
+			continue
+		}
+		if compareOrdered(extreme.Low, u.Low) == replace {
+			extreme.Low = u.Low
+		}
+		if compareOrdered(extreme.High, u.High) == replace {
+			extreme.High = u.High
+		}
+	}
+	return newUncertainty(extreme.Low, extreme.High)
+}
+
+// Median(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#median
+// Median over List<Integer> and List<Long> is computed as a Decimal since the median of an even
+// length list may fall between two elements. The result is rounded to the configured Decimal
+// precision.
+func (i *interpreter) evalMedianDecimal(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	values, err := nonNullFloat64s(operand)
+	if err != nil {
+		return result.Value{}, err
 	}
 	if len(values) == 0 {
 		return result.New(nil)
 	}
 
 	median := calculateMedianFloat64(values)
-	return result.New(median)
+	return result.New(i.roundToDecimalPrecision(median))
 }
 
 // Median(argument List<Quantity>) Quantity
 // https://cql.hl7.org/09-b-cqlreference.html#median
-func (i *interpreter) evalMedianQuantity(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+// The value of the result is rounded to the configured Decimal precision.
+func (i *interpreter) evalMedianQuantity(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
 	if result.IsNull(operand) {
 		return result.New(nil)
 	}
@@ -310,7 +346,7 @@ func (i *interpreter) evalMedianQuantity(_ model.IUnaryExpression, operand resul
 
 	values := make([]float64, 0, len(l))
 	var unit model.Unit
-	for idx, elem := range l {
+	for _, elem := range l {
 		if result.IsNull(elem) {
 			continue
 		}
@@ -318,23 +354,61 @@ func (i *interpreter) evalMedianQuantity(_ model.IUnaryExpression, operand resul
 		if err != nil {
 			return result.Value{}, err
 		}
-		// We only support List<Quantity> where all the elements have the exact same unit, since we
-		// do not support mixed unit Quantity math in our engine yet.
-		if idx == 0 {
+		// All elements are converted to the unit of the first non-null element.
+		if len(values) == 0 {
 			unit = v.Unit
 		}
-		if unit != v.Unit {
-			// TODO: b/342061715 - technically we should treat '' unit and '1' unit as the same, but
-			// for now we don't (and we should apply this globally).
-			return result.Value{}, fmt.Errorf("Median(List<Quantity>) operand has different units which is not supported, got %v and %v", unit, v.Unit)
+		cv, err := convertQuantity(v, unit)
+		if err != nil {
+			return result.Value{}, result.IncompatibleUnitError{Operator: m.GetName(), Unit: unit, OtherUnit: v.Unit, Err: err}
 		}
-		values = append(values, v.Value)
+		values = append(values, cv.Value)
 	}
 	if len(values) == 0 {
 		return result.New(nil)
 	}
 	median := calculateMedianFloat64(values)
-	return result.New(result.Quantity{Value: median, Unit: unit})
+	return result.New(result.Quantity{Value: i.roundToDecimalPrecision(median), Unit: unit})
+}
+
+// numericToFloat64 returns the value of a CQL Integer, Long or Decimal as a float64.
+func numericToFloat64(v result.Value) (float64, error) {
+	switch n := v.GolangValue().(type) {
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case result.Uncertainty:
+		return result.ToFloat64(v)
+	case float64:
+		return n, nil
+	default:
+		return 0, fmt.Errorf("internal error - expected an Integer, Long or Decimal, got %v", v.RuntimeType())
+	}
+}
+
+// nonNullFloat64s returns the non-null elements of a list of Integer, Long or Decimal values as
+// float64s. A null list returns an empty slice.
+func nonNullFloat64s(operand result.Value) ([]float64, error) {
+	if result.IsNull(operand) {
+		return nil, nil
+	}
+	l, err := result.ToSlice(operand)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float64, 0, len(l))
+	for _, elem := range l {
+		if result.IsNull(elem) {
+			continue
+		}
+		v, err := numericToFloat64(elem)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
 }
 
 // calculateMedianFloat64 calculates the median of a slice of float64 values.
@@ -348,22 +422,143 @@ func calculateMedianFloat64(values []float64) float64 {
 	return values[mid]
 }
 
+// Mode(argument List<T>) T
+// https://cql.hl7.org/09-b-cqlreference.html#mode
+// If multiple values are tied for the most frequent, the smallest of the tied values is returned.
+func evalMode(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	if result.IsNull(operand) {
+		return result.New(nil)
+	}
+	l, err := result.ToSlice(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	// Group the non-null elements by equality. values[idx] holds the first occurrence of each
+	// distinct value and counts[idx] the number of times it occurs.
+	var values []result.Value
+	var counts []int
+	for _, elem := range l {
+		if result.IsNull(elem) {
+			continue
+		}
+		found := false
+		for idx, v := range values {
+			c, err := compareAggregateValues(v, elem)
+			if err != nil {
+				return result.Value{}, err
+			}
+			if c == leftEqualRight {
+				counts[idx]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, elem)
+			counts = append(counts, 1)
+		}
+	}
+	if len(values) == 0 {
+		return result.New(nil)
+	}
+
+	mode, modeCount := values[0], counts[0]
+	for idx := 1; idx < len(values); idx++ {
+		if counts[idx] < modeCount {
+			continue
+		}
+		if counts[idx] == modeCount {
+			c, err := compareAggregateValues(values[idx], mode)
+			if err != nil {
+				return result.Value{}, err
+			}
+			if c != leftBeforeRight {
+				continue
+			}
+		}
+		mode, modeCount = values[idx], counts[idx]
+	}
+	return mode, nil
+}
+
+// compareAggregateValues compares two non-null values of the same Integer, Long, Decimal, Quantity,
+// String, Date, DateTime or Time type. Quantities are compared after converting the right
+// quantity to the unit of the left quantity.
+func compareAggregateValues(l, r result.Value) (comparison, error) {
+	switch lv := l.GolangValue().(type) {
+	case int32:
+		rv, err := result.ToInt32(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareOrdered(lv, rv), nil
+	case int64:
+		rv, err := result.ToInt64(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareOrdered(lv, rv), nil
+	case float64:
+		rv, err := result.ToFloat64(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareOrdered(lv, rv), nil
+	case string:
+		rv, err := result.ToString(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareOrdered(lv, rv), nil
+	case result.Quantity:
+		_, rv, err := quantitiesInSameUnit(l, r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareOrdered(roundDecimal(lv.Value), roundDecimal(rv.Value)), nil
+	case result.Date, result.DateTime, result.Time:
+		ldt, rdt, err := applyToValues(l, r, result.ToDateTime)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareDateTime(ldt, rdt)
+	case result.Uncertainty:
+		// Uncertain Integers have no single order, so return the ErrUncertain error of ToInt32.
+		_, err := result.ToInt32(l)
+		return unsetComparison, err
+	default:
+		return unsetComparison, fmt.Errorf("internal error - unsupported type %v for aggregate comparison", l.RuntimeType())
+	}
+}
+
+// compareOrdered returns the comparison of two ordered golang values.
+func compareOrdered[n cmp.Ordered](l, r n) comparison {
+	switch cmp.Compare(l, r) {
+	case -1:
+		return leftBeforeRight
+	case 1:
+		return leftAfterRight
+	default:
+		return leftEqualRight
+	}
+}
+
 // Sum(argument List<Decimal>) Decimal
 // Sum(argument List<Integer>) Integer
 // Sum(argument List<Long>) Long
 // Sum(argument List<Quantity>) Quantity
 // https://cql.hl7.org/09-b-cqlreference.html#sum
 func (i *interpreter) evalSum(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
-	if result.IsNull(operand) {
-		return result.New(nil)
-	}
-	l, err := result.ToSlice(operand)
+	l, isNull, err := result.ToNullableSlice(operand)
 	if err != nil {
 		return result.Value{}, err
 	}
+	if isNull {
+		return result.New(nil)
+	}
 	lType, ok := operand.RuntimeType().(*types.List)
 	if !ok {
-		return result.Value{}, fmt.Errorf("Sum(%v) operand is not a list", m.GetName())
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
 	}
 	switch lType.ElementType {
 	case types.Any:
@@ -388,23 +583,29 @@ func (i *interpreter) evalSum(m model.IUnaryExpression, operand result.Value) (r
 		}
 		return result.New(sum)
 	case types.Integer:
-		var sum int32
+		// Elements may be uncertain Integers, in which case the sum is also uncertain.
+		var sum result.Uncertainty
 		var foundValue bool
 		for _, elem := range l {
 			if result.IsNull(elem) {
 				continue
 			}
 			foundValue = true
-			v, err := result.ToInt32(elem)
+			v, err := toUncertainty(elem)
 			if err != nil {
 				return result.Value{}, err
 			}
-			sum += v
+			low, lowOk := addInt32(sum.Low, v.Low)
+			high, highOk := addInt32(sum.High, v.High)
+			if !lowOk || !highOk {
+				return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+			}
+			sum = result.Uncertainty{Low: low, High: high}
 		}
 		if !foundValue {
 			return result.New(nil)
 		}
-		return result.New(sum)
+		return newUncertainty(sum.Low, sum.High)
 	case types.Long:
 		var sum int64
 		var foundValue bool
@@ -417,7 +618,11 @@ func (i *interpreter) evalSum(m model.IUnaryExpression, operand result.Value) (r
 			if err != nil {
 				return result.Value{}, err
 			}
-			sum += v
+			s, ok := addInt64(sum, v)
+			if !ok {
+				return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Long}
+			}
+			sum = s
 		}
 		if !foundValue {
 			return result.New(nil)
@@ -438,16 +643,229 @@ func (i *interpreter) evalSum(m model.IUnaryExpression, operand result.Value) (r
 				foundValue = true
 				sum = result.Quantity{Value: 0, Unit: v.Unit}
 			}
-			if sum.Unit != v.Unit {
-				return result.Value{}, fmt.Errorf("Sum(%v) got List of Quantity values with different units which is not supported, got %v and %v", m.GetName(), sum.Unit, v.Unit)
+			// All elements are converted to the unit of the first non-null element.
+			cv, err := convertQuantity(v, sum.Unit)
+			if err != nil {
+				return result.Value{}, result.IncompatibleUnitError{Operator: m.GetName(), Unit: sum.Unit, OtherUnit: v.Unit, Err: err}
 			}
-			sum.Value += v.Value
+			sum.Value += cv.Value
 		}
 		if !foundValue {
 			return result.New(nil)
 		}
 		return result.New(sum)
 	default:
-		return result.Value{}, fmt.Errorf("Sum(%v) operand is not a list of Decimal, Integer, Long, or Quantity", m.GetName())
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
+	}
+}
+
+// Product(argument List<Decimal>) Decimal
+// Product(argument List<Integer>) Integer
+// Product(argument List<Long>) Long
+// https://cql.hl7.org/09-b-cqlreference.html#product
+// Returns an error if the product of an Integer or Long list overflows.
+func evalProduct(m model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	if result.IsNull(operand) {
+		return result.New(nil)
+	}
+	l, err := result.ToSlice(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	lType, ok := operand.RuntimeType().(*types.List)
+	if !ok {
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
+	}
+	switch lType.ElementType {
+	case types.Any:
+		// Special case for handling lists that contain only null runtime values.
+		return result.New(nil)
+	case types.Decimal:
+		product := 1.0
+		var foundValue bool
+		for _, elem := range l {
+			if result.IsNull(elem) {
+				continue
+			}
+			foundValue = true
+			v, err := result.ToFloat64(elem)
+			if err != nil {
+				return result.Value{}, err
+			}
+			product *= v
+		}
+		if !foundValue {
+			return result.New(nil)
+		}
+		return result.New(product)
+	case types.Integer:
+		var product int32 = 1
+		var foundValue bool
+		for _, elem := range l {
+			if result.IsNull(elem) {
+				continue
+			}
+			foundValue = true
+			v, err := result.ToInt32(elem)
+			if err != nil {
+				return result.Value{}, err
+			}
+			p := int64(product) * int64(v)
+			if p < math.MinInt32 || p > math.MaxInt32 {
+				return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+			}
+			product = int32(p)
+		}
+		if !foundValue {
+			return result.New(nil)
+		}
+		return result.New(product)
+	case types.Long:
+		var product int64 = 1
+		var foundValue bool
+		for _, elem := range l {
+			if result.IsNull(elem) {
+				continue
+			}
+			foundValue = true
+			v, err := result.ToInt64(elem)
+			if err != nil {
+				return result.Value{}, err
+			}
+			p, ok := multiplyInt64(product, v)
+			if !ok {
+				return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Long}
+			}
+			product = p
+		}
+		if !foundValue {
+			return result.New(nil)
+		}
+		return result.New(product)
+	default:
+		return result.Value{}, result.UnsupportedTypeError{Operator: m.GetName(), Type: operand.RuntimeType()}
+	}
+}
+
+// addInt32 returns l + r and whether the addition completed without overflowing.
+func addInt32(l, r int32) (int32, bool) {
+	s := int64(l) + int64(r)
+	if s < math.MinInt32 || s > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(s), true
+}
+
+// addInt64 returns l + r and whether the addition completed without overflowing.
+func addInt64(l, r int64) (int64, bool) {
+	s := l + r
+	if (r > 0 && s < l) || (r < 0 && s > l) {
+		return 0, false
+	}
+	return s, true
+}
+
+// multiplyInt64 returns l * r and whether the multiplication completed without overflowing.
+func multiplyInt64(l, r int64) (int64, bool) {
+	if l == 0 || r == 0 {
+		return 0, true
+	}
+	p := l * r
+	if p/r != l || (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) {
+		return 0, false
 	}
+	return p, true
+}
+
+// PopulationStdDev(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#populationstddev
+// PopulationStdDev is the square root of the PopulationVariance.
+func (i *interpreter) evalPopulationStdDev(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	v, ok, err := populationVariance(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(i.roundToDecimalPrecision(math.Sqrt(v)))
+}
+
+// PopulationVariance(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#populationvariance
+// PopulationVariance over List<Integer> is computed as a Decimal.
+func (i *interpreter) evalPopulationVariance(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	v, ok, err := populationVariance(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(i.roundToDecimalPrecision(v))
+}
+
+// populationVariance returns the population variance of the non-null values in operand, and false
+// if there are no non-null values.
+func populationVariance(operand result.Value) (float64, bool, error) {
+	values, err := nonNullFloat64s(operand)
+	if err != nil || len(values) == 0 {
+		return 0, false, err
+	}
+	return sumOfSquaredDeviations(values) / float64(len(values)), true, nil
+}
+
+// StdDev(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#stddev
+// StdDev is the square root of the sample Variance, so a list with a single non-null element
+// returns null.
+func (i *interpreter) evalStdDev(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	v, ok, err := sampleVariance(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(i.roundToDecimalPrecision(math.Sqrt(v)))
+}
+
+// Variance(argument List<Decimal>) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#variance
+// Variance is the sample variance, so a list with a single non-null element returns null. Variance
+// over List<Integer> is computed as a Decimal.
+func (i *interpreter) evalVariance(_ model.IUnaryExpression, operand result.Value) (result.Value, error) {
+	v, ok, err := sampleVariance(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(i.roundToDecimalPrecision(v))
+}
+
+// sampleVariance returns the sample variance of the non-null values in operand, and false if there
+// are fewer than two non-null values.
+func sampleVariance(operand result.Value) (float64, bool, error) {
+	values, err := nonNullFloat64s(operand)
+	if err != nil || len(values) < 2 {
+		return 0, false, err
+	}
+	return sumOfSquaredDeviations(values) / float64(len(values)-1), true, nil
+}
+
+// sumOfSquaredDeviations returns the sum of the squared differences between each value and the
+// mean of the values.
+func sumOfSquaredDeviations(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+	return sq
 }
@@ -0,0 +1,72 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interpreter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/cql/model"
+	"github.com/google/cql/result"
+	"github.com/google/cql/types"
+)
+
+// The parser only resolves aggregate overloads for supported operand types, so the unsupported type
+// errors are tested by calling the operators directly.
+func TestAggregate_UnsupportedTypeError(t *testing.T) {
+	i := &interpreter{}
+	stringList := newOrFatal(t, result.List{
+		Value:      []result.Value{newOrFatal(t, "a")},
+		StaticType: &types.List{ElementType: types.String},
+	})
+	tests := []struct {
+		name    string
+		eval    func(model.IUnaryExpression, result.Value) (result.Value, error)
+		m       model.IUnaryExpression
+		operand result.Value
+	}{
+		{
+			name:    "Avg",
+			eval:    i.evalAvg,
+			m:       &model.Avg{UnaryExpression: &model.UnaryExpression{}},
+			operand: stringList,
+		},
+		{
+			name:    "Sum",
+			eval:    i.evalSum,
+			m:       &model.Sum{UnaryExpression: &model.UnaryExpression{}},
+			operand: stringList,
+		},
+		{
+			name:    "Product",
+			eval:    evalProduct,
+			m:       &model.Product{UnaryExpression: &model.UnaryExpression{}},
+			operand: stringList,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.eval(tc.m, tc.operand)
+			var unsupportedErr result.UnsupportedTypeError
+			if !errors.As(err, &unsupportedErr) {
+				t.Fatalf("%v() returned error %v, want a result.UnsupportedTypeError", tc.name, err)
+			}
+			if unsupportedErr.Operator != tc.name {
+				t.Errorf("%v() returned UnsupportedTypeError.Operator %v, want %v", tc.name, unsupportedErr.Operator, tc.name)
+			}
+		})
+	}
+}
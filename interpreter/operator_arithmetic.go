@@ -19,8 +19,13 @@ import (
 	"math"
 	"math/big"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/google/cql/internal/ucum"
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
@@ -35,6 +40,30 @@ const (
 	minDecimal = float64(-99999999999999999999.99999999)
 )
 
+// decimalResult returns f as a Decimal. Every float64 result of a Decimal math operator is passed
+// through decimalResult so NaN and infinite values never surface as a Decimal. NaN is only
+// produced for arguments outside of the domain of the operator, so null is returned. Infinite
+// values return an OverflowError.
+func decimalResult(operator string, f float64) (result.Value, error) {
+	if math.IsNaN(f) {
+		return result.New(nil)
+	}
+	if math.IsInf(f, 0) {
+		return result.Value{}, result.OverflowError{Operator: operator, Type: types.Decimal}
+	}
+	return result.New(f)
+}
+
+// decimalRangeResult is like decimalResult, but also returns an OverflowError if f is outside of
+// the Decimal range. It is used by operators such as Exp and Power that can grow a Decimal in range
+// to one that is out of range.
+func decimalRangeResult(operator string, f float64) (result.Value, error) {
+	if f < minDecimal || f > maxDecimal {
+		return result.Value{}, result.OverflowError{Operator: operator, Type: types.Decimal}
+	}
+	return decimalResult(operator, f)
+}
+
 // Abs(argument Decimal) Decimal
 // https://cql.hl7.org/09-b-cqlreference.html#abs
 func evalAbsDecimal(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
@@ -50,16 +79,30 @@ func evalAbsDecimal(_ model.IUnaryExpression, obj result.Value) (result.Value, e
 
 // Abs(argument Integer) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#abs
-func evalAbsInteger(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+// The Abs of an uncertain Integer is the uncertainty over the Abs of each of its values.
+func evalAbsInteger(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
+	if u, ok := obj.GolangValue().(result.Uncertainty); ok {
+		if u.Low == math.MinInt32 {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+		}
+		switch {
+		case u.Low >= 0:
+			return newUncertainty(u.Low, u.High)
+		case u.High <= 0:
+			return newUncertainty(-u.High, -u.Low)
+		default:
+			return newUncertainty(0, max(-u.Low, u.High))
+		}
+	}
 	val, err := result.ToInt32(obj)
 	if err != nil {
 		return result.Value{}, err
 	}
 	if val == math.MinInt32 {
-		return result.New(nil)
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
 	}
 	if val < 0 {
 		return result.New(-val)
@@ -69,7 +112,7 @@ func evalAbsInteger(_ model.IUnaryExpression, obj result.Value) (result.Value, e
 
 // Abs(argument Long) Long
 // https://cql.hl7.org/09-b-cqlreference.html#abs
-func evalAbsLong(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+func evalAbsLong(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
@@ -78,7 +121,7 @@ func evalAbsLong(_ model.IUnaryExpression, obj result.Value) (result.Value, erro
 		return result.Value{}, err
 	}
 	if val == math.MinInt64 {
-		return result.New(nil)
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Long}
 	}
 	if val < 0 {
 		return result.New(-val)
@@ -102,7 +145,7 @@ func evalAbsQuantity(_ model.IUnaryExpression, obj result.Value) (result.Value,
 
 // Ceiling(argument Decimal) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#ceiling
-func evalCeiling(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+func evalCeiling(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
@@ -111,7 +154,7 @@ func evalCeiling(_ model.IUnaryExpression, obj result.Value) (result.Value, erro
 		return result.Value{}, err
 	}
 	if val <= math.MinInt32-1 || val > math.MaxInt32 {
-		return result.New(nil)
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
 	}
 	return result.New(int32(math.Ceil(val)))
 }
@@ -119,7 +162,7 @@ func evalCeiling(_ model.IUnaryExpression, obj result.Value) (result.Value, erro
 // Exp(argument Decimal) Decimal
 // https://cql.hl7.org/09-b-cqlreference.html#exp
 // Integer and long overloads are implicitly converted to decimal.
-func evalExpDecimal(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+func evalExpDecimal(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
@@ -127,12 +170,12 @@ func evalExpDecimal(_ model.IUnaryExpression, obj result.Value) (result.Value, e
 	if err != nil {
 		return result.Value{}, err
 	}
-	return result.New(math.Exp(val))
+	return decimalRangeResult(m.GetName(), math.Exp(val))
 }
 
 // Floor(argument Decimal) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#floor
-func evalFloor(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+func evalFloor(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
@@ -141,14 +184,14 @@ func evalFloor(_ model.IUnaryExpression, obj result.Value) (result.Value, error)
 		return result.Value{}, err
 	}
 	if val < math.MinInt32 || val >= math.MaxInt32+1 {
-		return result.New(nil)
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
 	}
 	return result.New(int32(math.Floor(val)))
 }
 
 // Ln(argument Decimal) Decimal
 // https://cql.hl7.org/09-b-cqlreference.html#ln
-func evalLn(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+func evalLn(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
@@ -159,7 +202,132 @@ func evalLn(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if val <= 0 {
 		return result.New(nil)
 	}
-	return result.New(math.Log(val))
+	return decimalResult(m.GetName(), math.Log(val))
+}
+
+// Log(argument Decimal, base Decimal) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#log
+// Returns null if the argument is not positive, or if the base is not positive or is 1.
+func evalLog(m model.IBinaryExpression, argObj, baseObj result.Value) (result.Value, error) {
+	if result.IsNull(argObj) || result.IsNull(baseObj) {
+		return result.New(nil)
+	}
+	arg, base, err := applyToValues(argObj, baseObj, result.ToFloat64)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if arg <= 0 || base <= 0 || base == 1 {
+		return result.New(nil)
+	}
+	return decimalResult(m.GetName(), math.Log(arg)/math.Log(base))
+}
+
+// Round(argument Decimal) Decimal
+// Round(argument Decimal, precision Integer) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#round
+// Midpoint values are rounded using the configured rounding mode, by default towards positive
+// infinity so Round(-0.5) is 0.0 and Round(0.5) is 1.0. A null precision is treated as 0.
+func (i *interpreter) evalRound(m model.INaryExpression, operands []result.Value) (result.Value, error) {
+	if len(operands) == 0 {
+		// Dispatcher and Parser should prevent this from happening.
+		return result.Value{}, fmt.Errorf("internal error - Round must have at least one operand")
+	}
+	if result.IsNull(operands[0]) {
+		return result.New(nil)
+	}
+	val, err := result.ToFloat64(operands[0])
+	if err != nil {
+		return result.Value{}, err
+	}
+	var precision int32
+	if len(operands) == 2 && !result.IsNull(operands[1]) {
+		precision, err = result.ToInt32(operands[1])
+		if err != nil {
+			return result.Value{}, err
+		}
+	}
+	if precision < 0 {
+		return result.Value{}, fmt.Errorf("%v precision must not be negative, got %v", m.GetName(), precision)
+	}
+	return result.New(roundToPrecision(val, int(precision), i.decimalRounding))
+}
+
+// maxRoundingPrecision is the number of digits after the decimal point beyond which rounding a
+// float64 has no effect, since its shortest decimal representation never has more.
+const maxRoundingPrecision = 400
+
+// roundToPrecision rounds f to precision digits after the decimal point using the rounding mode.
+// Rounding is done on the shortest decimal representation of f rather than its binary value, so
+// 2.675 rounds to 2.68 even though the closest float64 is slightly less than 2.675.
+func roundToPrecision(f float64, precision int, mode RoundingMode) float64 {
+	if math.IsInf(f, 0) || math.IsNaN(f) || precision >= maxRoundingPrecision {
+		return f
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return f
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+	// q is r truncated towards zero and rem has the sign of r.
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if mode != RoundDown && rem.Sign() != 0 {
+		// half compares the fractional part of r to one half.
+		half := new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(r.Denom())
+		var awayFromZero bool
+		switch mode {
+		case RoundHalfEven:
+			awayFromZero = half > 0 || (half == 0 && q.Bit(0) == 1)
+		default:
+			// Midpoints are rounded towards positive infinity.
+			awayFromZero = half > 0 || (half == 0 && rem.Sign() > 0)
+		}
+		if awayFromZero {
+			q.Add(q, big.NewInt(int64(rem.Sign())))
+		}
+	}
+	res, _ := new(big.Rat).SetFrac(q, scale).Float64()
+	return res
+}
+
+// roundToDecimalPrecision rounds f to the configured Decimal precision.
+func (i *interpreter) roundToDecimalPrecision(f float64) float64 {
+	return roundToPrecision(f, i.decimalPrecision, i.decimalRounding)
+}
+
+// /(left Decimal, right Decimal) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#divide
+// mod(left Decimal, right Decimal) Decimal
+// https://cql.hl7.org/09-b-cqlreference.html#modulo
+// The result is rounded to the configured Decimal precision.
+func (i *interpreter) evalRoundedArithmeticDecimal(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	res, err := evalArithmeticDecimal(m, lObj, rObj)
+	if err != nil || result.IsNull(res) {
+		return res, err
+	}
+	f, err := result.ToFloat64(res)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(i.roundToDecimalPrecision(f))
+}
+
+// /(left Quantity, right Quantity) Quantity
+// https://cql.hl7.org/09-b-cqlreference.html#divide
+// mod(left Quantity, right Quantity) Quantity
+// https://cql.hl7.org/09-b-cqlreference.html#modulo
+// The value of the result is rounded to the configured Decimal precision.
+func (i *interpreter) evalRoundedArithmeticQuantity(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	res, err := evalArithmeticQuantity(m, lObj, rObj)
+	if err != nil || result.IsNull(res) {
+		return res, err
+	}
+	q, err := result.ToQuantity(res)
+	if err != nil {
+		return result.Value{}, err
+	}
+	q.Value = i.roundToDecimalPrecision(q.Value)
+	return result.New(q)
 }
 
 // op(left Integer, right Integer) Integer
@@ -168,10 +336,19 @@ func evalLn(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
 // https://cql.hl7.org/09-b-cqlreference.html#multiply
 // https://cql.hl7.org/09-b-cqlreference.html#truncated-divide
 // https://cql.hl7.org/09-b-cqlreference.html#modulo
+//
+// Either operand may be an uncertain Integer, see arithmeticUncertainty.
 func evalArithmeticInteger(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
 	}
+	if isUncertain(lObj) || isUncertain(rObj) {
+		l, r, err := applyToValues(lObj, rObj, toUncertainty)
+		if err != nil {
+			return result.Value{}, err
+		}
+		return arithmeticUncertainty(m, l, r)
+	}
 	l, r, err := applyToValues(lObj, rObj, result.ToInt32)
 	if err != nil {
 		return result.Value{}, err
@@ -210,7 +387,15 @@ func evalArithmeticDecimal(m model.IBinaryExpression, lObj result.Value, rObj re
 	if err != nil {
 		return result.Value{}, err
 	}
-	return arithmetic(m, l, r)
+	res, err := arithmetic(m, l, r)
+	if err != nil || result.IsNull(res) {
+		return res, err
+	}
+	f, err := result.ToFloat64(res)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return decimalResult(m.GetName(), f)
 }
 
 // op(left Quantity, right Quantity) Quantity
@@ -289,20 +474,60 @@ func evalArithmeticDateTime(m model.IBinaryExpression, lObj, rObj result.Value)
 	return result.New(dtv)
 }
 
+// op(left Time, right Quantity) Time
+// https://cql.hl7.org/09-b-cqlreference.html#add-1
+// https://cql.hl7.org/09-b-cqlreference.html#subtract-1
+// The result wraps around midnight, so @T23:00 + 2 hours is @T01:00.
+func evalArithmeticTime(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	r, err := result.ToQuantity(rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	// Only time valued quantities can be added to or subtracted from a Time.
+	if err := validatePrecision(model.DateTimePrecision(r.Unit), []model.DateTimePrecision{model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+		return result.Value{}, err
+	}
+
+	d, err := result.ToDateTime(lObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if err := validatePrecision(d.Precision, []model.DateTimePrecision{model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+		return result.Value{}, err
+	}
+	dtv, err := arithmeticDateTime(m, d, r)
+	if err != nil {
+		return result.Value{}, err
+	}
+	t := time.Date(0, time.January, 1, dtv.Date.Hour(), dtv.Date.Minute(), dtv.Date.Second(), dtv.Date.Nanosecond(), dtv.Date.Location())
+	return result.New(result.Time{Date: t, Precision: dtv.Precision})
+}
+
 func arithmetic[t float64 | int64 | int32](m model.IBinaryExpression, l, r t) (result.Value, error) {
 	switch m.(type) {
-	case *model.Add:
-		return result.New(l + r)
-	case *model.Subtract:
-		return result.New(l - r)
-	case *model.Multiply:
-		return result.New(l * r)
+	case *model.Add, *model.Subtract, *model.Multiply:
+		res, ok := checkedArithmetic(m, l, r)
+		if !ok {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: m.GetResultType()}
+		}
+		return result.New(res)
 	case *model.TruncatedDivide:
 		if r == 0 {
 			return result.New(nil)
 		}
-		// The first int64() truncates any decimal, then t() converts it back to the original type.
-		return result.New(t(int64(l / r)))
+		// Integer division wraps around when dividing the minimum value by -1, which is the only
+		// non zero value that is its own negation.
+		if r == -1 && l != 0 && -l == l {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: m.GetResultType()}
+		}
+		// Go integer division already truncates towards zero, decimals need to be truncated.
+		if f, ok := any(l / r).(float64); ok {
+			return result.New(math.Trunc(f))
+		}
+		return result.New(l / r)
 	case *model.Divide:
 		if r == 0 {
 			return result.New(nil)
@@ -314,6 +539,56 @@ func arithmetic[t float64 | int64 | int32](m model.IBinaryExpression, l, r t) (r
 	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Arithmetic Expression %v", m)
 }
 
+// checkedArithmetic returns the Add, Subtract or Multiply of l and r, and false if an Integer or
+// Long result wrapped around the range of its type. Decimal results are checked by the caller.
+func checkedArithmetic[t float64 | int64 | int32](m model.IBinaryExpression, l, r t) (t, bool) {
+	_, isDecimal := any(l).(float64)
+	switch m.(type) {
+	case *model.Add:
+		res := l + r
+		return res, isDecimal || (r > 0) == (res > l) || r == 0
+	case *model.Subtract:
+		res := l - r
+		return res, isDecimal || (r > 0) == (res < l) || r == 0
+	default:
+		res := l * r
+		if isDecimal || l == 0 || r == 0 {
+			return res, true
+		}
+		// The minimum value is the only non zero value that is its own negation, and multiplying it
+		// by -1 wraps around to itself.
+		if (l == -1 && r == -r) || (r == -1 && l == -l) {
+			return res, false
+		}
+		return res, res/l == r
+	}
+}
+
+// arithmeticUncertainty returns the uncertainty ranging over every result of applying m to a value
+// in l and a value in r. Division and modulo of uncertainties are not defined so return null. The
+// bounds are computed in int64, so a bound outside of the Integer range returns an OverflowError.
+func arithmeticUncertainty(m model.IBinaryExpression, l, r result.Uncertainty) (result.Value, error) {
+	lLow, lHigh, rLow, rHigh := int64(l.Low), int64(l.High), int64(r.Low), int64(r.High)
+	var low, high int64
+	switch m.(type) {
+	case *model.Add:
+		low, high = lLow+rLow, lHigh+rHigh
+	case *model.Subtract:
+		low, high = lLow-rHigh, lHigh-rLow
+	case *model.Multiply:
+		products := []int64{lLow * rLow, lLow * rHigh, lHigh * rLow, lHigh * rHigh}
+		low, high = slices.Min(products), slices.Max(products)
+	case *model.TruncatedDivide, *model.Modulo:
+		return result.New(nil)
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported Binary Arithmetic Expression %v", m)
+	}
+	if low < math.MinInt32 || high > math.MaxInt32 {
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+	}
+	return newUncertainty(int32(low), int32(high))
+}
+
 // Precision(arg Date) Integer
 // Precision(arg DateTime) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#precision
@@ -376,7 +651,9 @@ func evalPrecisionTime(_ model.IUnaryExpression, obj result.Value) (result.Value
 // ^(left Decimal, right Decimal) Decimal
 // https://cql.hl7.org/09-b-cqlreference.html#power
 // In this case because we need different logic for different types, we aren't using the
-// evalArithmeticInteger function.
+// evalArithmeticInteger function. Integer and Long results outside of the range of the type return
+// an error. A negative Decimal base with a fractional exponent, or zero raised to a negative
+// exponent, returns null.
 func evalPower(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
@@ -387,58 +664,95 @@ func evalPower(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value
 		if err != nil {
 			return result.Value{}, err
 		}
-		bigPow := bigIntPow(int64(l), int64(r))
-		if _, ok := bigPow.(float64); ok {
-			return result.New(bigPow)
+		if r < 0 {
+			return negativeIntPow(m, int64(l), int64(r))
+		}
+		pow, ok := bigIntPow(int64(l), int64(r))
+		if !ok || !pow.IsInt64() || pow.Int64() < math.MinInt32 || pow.Int64() > math.MaxInt32 {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
 		}
-		return result.New(int32(bigPow.(int64)))
+		return result.New(int32(pow.Int64()))
 	case types.Long:
 		l, r, err := applyToValues(lObj, rObj, result.ToInt64)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return result.New(bigIntPow(l, r))
+		if r < 0 {
+			return negativeIntPow(m, l, r)
+		}
+		pow, ok := bigIntPow(l, r)
+		if !ok || !pow.IsInt64() {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Long}
+		}
+		return result.New(pow.Int64())
 	case types.Decimal:
 		l, r, err := applyToValues(lObj, rObj, result.ToFloat64)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return result.New(math.Pow(l, r))
+		if l == 0 && r < 0 {
+			return result.New(nil)
+		}
+		// A negative base with a fractional exponent has no real result, so is NaN.
+		return decimalRangeResult(m.GetName(), math.Pow(l, r))
 	default:
 		return result.Value{}, fmt.Errorf("internal error - unsupported type %v", m.GetResultType())
 	}
 }
 
-// bigIntPow performs integer exponentiation on big ints using the big package.
-// Returns a float64 if the right hand side is negative, otherwise returns an int64.
-// We do this because Golang does not have native support for exponents on integers.
-func bigIntPow(l, r int64) any {
-	if r == 0 {
-		return int64(1)
-	}
-	if r == 1 {
-		return l
-	}
-	exponentNegative := r < 0
-	if exponentNegative {
-		r = -r
-	}
-	bigL := big.NewInt(l)
-	bigR := big.NewInt(r)
-	bigResult := new(big.Int)
-	bigResult.Exp(bigL, bigR, nil)
-	if exponentNegative {
-		return 1.0 / float64(bigResult.Int64())
+// bigIntPow performs integer exponentiation for a non negative exponent on big ints using the big
+// package, so that callers can check whether the result fits in the range of their type. We do
+// this because Golang does not have native support for exponents on integers. Returns false if the
+// result is known to overflow a Long without computing it.
+func bigIntPow(l, r int64) (*big.Int, bool) {
+	// Any base other than -1, 0 and 1 overflows a Long with an exponent of 64 or more, so we avoid
+	// computing arbitrarily large powers.
+	if r >= 64 && (l < -1 || l > 1) {
+		return nil, false
+	}
+	return new(big.Int).Exp(big.NewInt(l), big.NewInt(r), nil), true
+}
+
+// negativeIntPow returns l raised to the negative exponent r as a Decimal. Zero raised to a
+// negative exponent is a division by zero and returns null.
+func negativeIntPow(m model.IBinaryExpression, l, r int64) (result.Value, error) {
+	if l == 0 {
+		return result.New(nil)
 	}
-	return bigResult.Int64()
+	return decimalRangeResult(m.GetName(), math.Pow(float64(l), float64(r)))
 }
 
-// TODO(b/319156186): Add support for converting quantities between different units.
+// arithmeticQuantity performs arithmetic operations for Quantity values. Add and Subtract convert
+// the right operand to the unit of the left operand. Multiply and Divide combine the units of their
+// operands, for example 10 'mg' / 2 'mL' is 5 'mg/mL'. Combined units are only simplified by
+// dropping unitless operands, squaring identical units and converting units of the same dimension
+// before dividing.
 // TODO(b/319333058): Add support for Date + Quantity arithmetic.
 // TODO(b/319525986): Add support for additional arithmetic for Quantities.
 func arithmeticQuantity(m model.IBinaryExpression, l, r result.Quantity) (result.Value, error) {
 	if l.Unit != r.Unit {
-		return result.Value{}, fmt.Errorf("internal error - quantity unit conversion unsupported, got units: %s and %s", l.Unit, r.Unit)
+		switch m.(type) {
+		case *model.Add, *model.Subtract:
+			// The result is in the unit of the left operand.
+			cr, err := convertQuantity(r, l.Unit)
+			if err != nil {
+				return result.Value{}, err
+			}
+			r = cr
+		case *model.Divide:
+			// Dividing quantities of the same dimension results in a unitless quantity.
+			if !isUnitless(r.Unit) && ucum.CanConvert(string(r.Unit), string(l.Unit)) {
+				cr, err := convertQuantity(r, l.Unit)
+				if err != nil {
+					return result.Value{}, err
+				}
+				r = cr
+			}
+		case *model.Multiply:
+			// The units of both operands are combined, so no conversion is needed.
+		default:
+			return result.Value{}, fmt.Errorf("internal error - quantity unit conversion unsupported, got units: %s and %s", l.Unit, r.Unit)
+		}
 	}
 	switch m.(type) {
 	case *model.Add:
@@ -446,11 +760,17 @@ func arithmeticQuantity(m model.IBinaryExpression, l, r result.Quantity) (result
 	case *model.Subtract:
 		return result.New(result.Quantity{Value: l.Value - r.Value, Unit: l.Unit})
 	case *model.Multiply:
-		return result.Value{}, fmt.Errorf("internal error - quantity multiplication unsupported, got: %v and %v", l, r)
+		return result.New(result.Quantity{Value: l.Value * r.Value, Unit: multiplyUnits(l.Unit, r.Unit)})
 	case *model.TruncatedDivide:
-		return result.New(result.Quantity{Value: float64(int64(l.Value / r.Value)), Unit: model.ONEUNIT})
+		if r.Value == 0 {
+			return result.New(nil)
+		}
+		return result.New(result.Quantity{Value: math.Trunc(l.Value / r.Value), Unit: model.ONEUNIT})
 	case *model.Divide:
-		return result.New(result.Quantity{Value: l.Value / r.Value, Unit: model.ONEUNIT})
+		if r.Value == 0 {
+			return result.New(nil)
+		}
+		return result.New(result.Quantity{Value: l.Value / r.Value, Unit: divideUnits(l.Unit, r.Unit)})
 	case *model.Modulo:
 		if l.Unit != r.Unit {
 			return result.Value{}, fmt.Errorf("internal error - quantity modulo with different units unsupported, got units: %s and %s", l.Unit, r.Unit)
@@ -463,6 +783,64 @@ func arithmeticQuantity(m model.IBinaryExpression, l, r result.Quantity) (result
 	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Arithmetic Expression %v", m)
 }
 
+// isUnitless returns whether the unit represents a unitless quantity.
+func isUnitless(unit model.Unit) bool {
+	return unit == "" || unit == model.ONEUNIT
+}
+
+// multiplyUnits returns the UCUM unit of the product of quantities in units l and r. Equal units
+// are squared, unless they already have an exponent such as cm2 or s-1.
+func multiplyUnits(l, r model.Unit) model.Unit {
+	switch {
+	case isUnitless(l) && isUnitless(r):
+		return model.ONEUNIT
+	case isUnitless(l):
+		return r
+	case isUnitless(r):
+		return l
+	case l == r && compoundUnit(l) == l && !hasExponent(l):
+		return l + "2"
+	}
+	return l + "." + compoundUnit(r)
+}
+
+// hasExponent returns whether the unit ends in a UCUM exponent, for example cm2 or s-1.
+func hasExponent(unit model.Unit) bool {
+	return unit != "" && unicode.IsDigit(rune(unit[len(unit)-1]))
+}
+
+// divideUnits returns the UCUM unit of the quotient of quantities in units l and r.
+func divideUnits(l, r model.Unit) model.Unit {
+	switch {
+	case l == r || isUnitless(l) && isUnitless(r):
+		return model.ONEUNIT
+	case isUnitless(r):
+		return l
+	case isUnitless(l):
+		return "1/" + compoundUnit(r)
+	}
+	return l + "/" + compoundUnit(r)
+}
+
+// compoundUnit wraps units containing UCUM operators in parentheses so they can be used as the
+// right operand of another UCUM operator.
+func compoundUnit(unit model.Unit) model.Unit {
+	if strings.ContainsAny(string(unit), "./") {
+		return "(" + unit + ")"
+	}
+	return unit
+}
+
+// convertQuantity converts the Quantity to the given unit using UCUM unit conversion. Returns an
+// error if the Quantity's unit cannot be converted to the given unit.
+func convertQuantity(q result.Quantity, unit model.Unit) (result.Quantity, error) {
+	v, err := ucum.ConvertUnit(q.Value, string(q.Unit), string(unit))
+	if err != nil {
+		return result.Quantity{}, err
+	}
+	return result.Quantity{Value: v, Unit: unit}, nil
+}
+
 // arithmeticDateTime performs arithmetic operations for Date, Quantity values.
 // When performing arithmetic over differing precisions, only whole values up to
 // the given Date or DateTime's precision should be added.
@@ -482,10 +860,12 @@ func arithmeticDateTime(m model.IBinaryExpression, l result.DateTime, r result.Q
 	}
 
 	switch cq.Unit {
+	// Years and months are clamped to the last day of the resulting month, so @2020-01-31 + 1 month
+	// is @2020-02-29.
 	case model.YEARUNIT:
-		return result.DateTime{Date: l.Date.AddDate(int(sign)*int(cq.Value), 0, 0), Precision: l.Precision}, nil
+		return result.DateTime{Date: addMonthsClamped(l.Date, 12*int(sign)*int(cq.Value)), Precision: l.Precision}, nil
 	case model.MONTHUNIT:
-		return result.DateTime{Date: l.Date.AddDate(0, int(sign)*int(cq.Value), 0), Precision: l.Precision}, nil
+		return result.DateTime{Date: addMonthsClamped(l.Date, int(sign)*int(cq.Value)), Precision: l.Precision}, nil
 	case model.WEEKUNIT:
 		// Weeks need to be converted to days before they can be operated on.
 		return result.DateTime{Date: l.Date.AddDate(0, 0, int(sign)*int(cq.Value*7)), Precision: l.Precision}, nil
@@ -511,7 +891,7 @@ func arithmeticDateTime(m model.IBinaryExpression, l result.DateTime, r result.Q
 
 // Truncate(arg Decimal) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#truncate
-func evalTruncate(_ model.IUnaryExpression, decimalVal result.Value) (result.Value, error) {
+func evalTruncate(m model.IUnaryExpression, decimalVal result.Value) (result.Value, error) {
 	if result.IsNull(decimalVal) {
 		return result.New(nil)
 	}
@@ -519,15 +899,25 @@ func evalTruncate(_ model.IUnaryExpression, decimalVal result.Value) (result.Val
 	if err != nil {
 		return result.Value{}, err
 	}
+	if d <= math.MinInt32-1 || d >= math.MaxInt32+1 {
+		return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+	}
 	return result.New(int32(d))
 }
 
 // -(argument Integer) Integer
 // https://cql.hl7.org/09-b-cqlreference.html#negate
+// The negation of an uncertain Integer is the uncertainty over the negation of each of its values.
 func evalNegateInteger(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	if result.IsNull(obj) {
 		return result.New(nil)
 	}
+	if u, ok := obj.GolangValue().(result.Uncertainty); ok {
+		if u.Low == math.MinInt32 {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+		}
+		return newUncertainty(-u.High, -u.Low)
+	}
 	val, err := result.ToInt32(obj)
 	if err != nil {
 		return result.Value{}, err
@@ -652,6 +1042,11 @@ func predecessor(obj result.Value, evaluationTimestamp *time.Time) (result.Value
 		if err != nil {
 			return result.Value{}, err
 		}
+		// The minimum Quantity has the default unit, so quantities of any other unit are compared by
+		// value.
+		if q.Value <= minDecimal {
+			return result.Value{}, fmt.Errorf("tried to compute predecessor for value that is already a min value, %v", obj.GolangValue())
+		}
 		return result.New(result.Quantity{Value: q.Value - 0.00000001, Unit: q.Unit})
 	case types.Date, types.DateTime, types.Time:
 		return dateTimePredecessor(obj, evaluationTimestamp)
@@ -697,6 +1092,11 @@ func successor(obj result.Value, evaluationTimestamp *time.Time) (result.Value,
 		if err != nil {
 			return result.Value{}, err
 		}
+		// The maximum Quantity has the default unit, so quantities of any other unit are compared by
+		// value.
+		if q.Value >= maxDecimal {
+			return result.Value{}, fmt.Errorf("tried to compute successor for value that is already a max value, %v", obj.GolangValue())
+		}
 		return result.New(result.Quantity{Value: q.Value + 0.00000001, Unit: q.Unit})
 	case types.Date, types.DateTime, types.Time:
 		return dateTimeSuccessor(obj, evaluationTimestamp)
@@ -868,7 +1268,7 @@ func maxValue(t types.IType, evaluationTimestamp *time.Time) (result.Value, erro
 		if evaluationTimestamp == nil {
 			return result.Value{}, fmt.Errorf("internal error - evaluation timestamp cannot be nil for DateTime max value")
 		}
-		return result.New(result.DateTime{Date: time.Date(9999, 12, 31, 23, 59, 59, 999, evaluationTimestamp.Location()), Precision: model.MILLISECOND})
+		return result.New(result.DateTime{Date: time.Date(9999, 12, 31, 23, 59, 59, 999000000, evaluationTimestamp.Location()), Precision: model.MILLISECOND})
 	case types.Time:
 		if evaluationTimestamp == nil {
 			return result.Value{}, fmt.Errorf("internal error - evaluation timestamp cannot be nil for Time max value")
@@ -936,7 +1336,7 @@ func mod(l, r any) (result.Value, error) {
 		if rVal == 0 {
 			return result.New(nil)
 		}
-		return result.New(math.Mod(l.(float64), r.(float64)))
+		return result.New(math.Mod(l.(float64), rVal))
 	}
 	return result.Value{}, fmt.Errorf("internal error - mod does not support %v", reflect.TypeOf(l))
 }
@@ -25,88 +25,72 @@ import (
 
 // CLINICAL OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#clinical-operators-3
 
-// CalculateAgeIn[Years|Months|Weeks|Days]At(birthDate Date, asOf Date) Integer
-// https://cql.hl7.org/09-b-cqlreference.html#calculateageat
-func evalCalculateAgeAtDate(b model.IBinaryExpression, birthObj, asOfObj result.Value) (result.Value, error) {
-	m := b.(*model.CalculateAgeAt)
-	p := model.DateTimePrecision(m.Precision)
+// CalculateAgeIn[Years|Months|Weeks|Days](birthDate Date) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#calculateage
+// The age is calculated as of Today(), which is derived from the evaluation timestamp.
+func (i *interpreter) evalCalculateAgeDate(u model.IUnaryExpression, birthObj result.Value) (result.Value, error) {
+	p := u.(*model.CalculateAge).Precision
 	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY}); err != nil {
 		return result.Value{}, err
 	}
-	if result.IsNull(birthObj) || result.IsNull(asOfObj) {
-		return result.New(nil)
-	}
-
-	birth, asOf, err := applyToValues(birthObj, asOfObj, result.ToDateTime)
+	asOfObj, err := i.evalToday(nil, nil)
 	if err != nil {
 		return result.Value{}, err
 	}
-	return calculateAgeAt(birth, asOf, p)
+	return evalBetween(birthObj, asOfObj, p, dateTimeDuration)
 }
 
-// CalculateAgeIn[Years|Months|Weeks|Days|Hours|Minutes|Seconds]At(birthDate DateTime, asOf DateTime) Integer
-// https://cql.hl7.org/09-b-cqlreference.html#calculateageat
-func evalCalculateAgeAtDateTime(b model.IBinaryExpression, birthObj, asOfObj result.Value) (result.Value, error) {
-	m := b.(*model.CalculateAgeAt)
-	p := model.DateTimePrecision(m.Precision)
+// CalculateAgeIn[Years|Months|Weeks|Days|Hours|Minutes|Seconds](birthDate DateTime) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#calculateage
+// The age is calculated as of Now(), which is derived from the evaluation timestamp.
+func (i *interpreter) evalCalculateAgeDateTime(u model.IUnaryExpression, birthObj result.Value) (result.Value, error) {
+	p := u.(*model.CalculateAge).Precision
 	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY, model.HOUR, model.MINUTE, model.SECOND}); err != nil {
 		return result.Value{}, err
 	}
-	if result.IsNull(birthObj) || result.IsNull(asOfObj) {
-		return result.New(nil)
-	}
-
-	birth, asOf, err := applyToValues(birthObj, asOfObj, result.ToDateTime)
+	asOfObj, err := i.evalNow(nil, nil)
 	if err != nil {
 		return result.Value{}, err
 	}
-	return calculateAgeAt(birth, asOf, p)
+	return evalBetween(birthObj, asOfObj, p, dateTimeDuration)
 }
 
-// calculateAgeAt is the helper to calculate age for Date and DateTime overloads.
-func calculateAgeAt(birth, asOf result.DateTime, p model.DateTimePrecision) (result.Value, error) {
-	// TODO(b/304349114): CalculateAgeAt is just syntactic sugar over the duration system operator. We
-	// should fully implement duration and call the duration helper function from calculateAge.
-
-	if p == model.YEAR {
-		if asOf.Date.Month() > birth.Date.Month() ||
-			(asOf.Date.Month() == birth.Date.Month() && asOf.Date.Day() >= birth.Date.Day()) {
-			return result.New(asOf.Date.Year() - birth.Date.Year())
-		}
-
-		return result.New(asOf.Date.Year() - birth.Date.Year() - 1)
-	}
-
-	if p == model.MONTH {
-		months := 12*(asOf.Date.Year()-birth.Date.Year()) + int((asOf.Date.Month())) - int(birth.Date.Month())
-		if asOf.Date.Day() < birth.Date.Day() {
-			months--
-		}
-
-		return result.New(months)
-	}
-
-	if p == model.WEEK {
-		return result.New(int(asOf.Date.Sub(birth.Date).Hours() / 24 / 7))
+// CalculateAgeIn[Years|Months|Weeks|Days]At(birthDate Date, asOf Date) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#calculateageat
+// The age is the number of whole periods between birthDate and asOf, the same as duration between.
+// If either date is less precise than the requested precision the result is an uncertainty.
+func evalCalculateAgeAtDate(b model.IBinaryExpression, birthObj, asOfObj result.Value) (result.Value, error) {
+	m := b.(*model.CalculateAgeAt)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY}); err != nil {
+		return result.Value{}, err
 	}
+	return evalBetween(birthObj, asOfObj, p, dateTimeDuration)
+}
 
-	if p == model.DAY {
-		return result.New(int(asOf.Date.Sub(birth.Date).Hours() / 24))
+// CalculateAgeIn[Years|Months|Weeks|Days|Hours|Minutes|Seconds]At(birthDate DateTime, asOf DateTime) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#calculateageat
+// The age is the number of whole periods between birthDate and asOf, the same as duration between.
+// If either datetime is less precise than the requested precision the result is an uncertainty.
+func evalCalculateAgeAtDateTime(b model.IBinaryExpression, birthObj, asOfObj result.Value) (result.Value, error) {
+	m := b.(*model.CalculateAgeAt)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY, model.HOUR, model.MINUTE, model.SECOND}); err != nil {
+		return result.Value{}, err
 	}
-
-	// TODO(b/304349114): Per https://cql.hl7.org/09-b-cqlreference.html#ageat and
-	// the external tests mentioned in b/304349114#comment3, these date-related
-	// functions should propagate "uncertainty" ranges if precision is less than
-	// a day.
-	return result.Value{}, fmt.Errorf("Unsupported CalculateAgeAt precision %v", p)
+	return evalBetween(birthObj, asOfObj, p, dateTimeDuration)
 }
 
 // in(code Code, codesystem CodeSystemRef) Boolean
 // in(codes List<Code>, codesystem CodeSystemRef) Boolean
 // in(concept Concept, codesystem CodeSystemRef) Boolean
 // in(concepts List<Concept>, codesystem CodeSystemRef) Boolean
+// AnyInCodeSystem(codes List<Code>, codesystem CodeSystemRef) Boolean
+// AnyInCodeSystem(concepts List<Concept>, codesystem CodeSystemRef) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#in-codesystem
-// The In operator for list overloads checks if any value is in the CodeSystem.
+// https://cql.hl7.org/04-logicalspecification.html#anyincodesystem
+// The In operator for list overloads and AnyInCodeSystem check if any value is in the CodeSystem.
+// A null or empty list is not in the CodeSystem.
 // TODO: b/327282181 - add support for other In CodeSystem Operators
 func (i *interpreter) evalInCodeSystem(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) {
@@ -126,19 +110,45 @@ func (i *interpreter) evalInCodeSystem(b model.IBinaryExpression, lObj, rObj res
 		return result.Value{}, err
 	}
 
-	in, err := i.terminologyProvider.AnyInCodeSystem(termCodes, csv.ID, csv.Version)
+	in, err := i.terminologyProvider.AnyInCodeSystem(i.versionedCodes(termCodes), csv.ID, csv.Version)
 	if err != nil {
 		return result.Value{}, err
 	}
 	return result.New(in)
 }
 
+// in(code Code, concept Concept) Boolean
+// A Code is in a Concept if it is equal to one of the codes of the Concept, ignoring the version
+// and display. If the code is null the result is null, and if the Concept is null the result is
+// false.
+func evalInCodeConcept(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) {
+		return result.New(nil)
+	}
+	if result.IsNull(rObj) {
+		return result.New(false)
+	}
+	c, err := result.ToCode(lObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	concept, err := result.ToConcept(rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(conceptContains(concept, c))
+}
+
 // in(code Code, valueset ValueSetRef) Boolean
 // in(codes List<Code>, valueset ValueSetRef) Boolean
 // in(concept Concept, valueset ValueSetRef) Boolean
 // in(concepts List<Concept>, valueset ValueSetRef) Boolean
+// AnyInValueSet(codes List<Code>, valueset ValueSetRef) Boolean
+// AnyInValueSet(concepts List<Concept>, valueset ValueSetRef) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#in-valueset
-// The In operator for list overloads checks if any value is in the ValueSet.
+// https://cql.hl7.org/04-logicalspecification.html#anyinvalueset
+// The In operator for list overloads and AnyInValueSet check if any value is in the ValueSet. A null
+// or empty list is not in the ValueSet.
 // TODO: b/327281742 - add support for other In ValueSet Operators
 func (i *interpreter) evalInValueSet(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) {
@@ -158,13 +168,28 @@ func (i *interpreter) evalInValueSet(b model.IBinaryExpression, lObj, rObj resul
 		return result.Value{}, err
 	}
 
-	in, err := i.terminologyProvider.AnyInValueSet(termCodes, vsv.ID, vsv.Version)
+	in, err := i.terminologyProvider.AnyInValueSet(i.versionedCodes(termCodes), vsv.ID, vsv.Version)
 	if err != nil {
 		return result.Value{}, err
 	}
 	return result.New(in)
 }
 
+// versionedCodes returns the codes to pass to the terminology provider. Unless the interpreter
+// is configured with strict code system versions, the versions are cleared so codes match on
+// system and code alone.
+func (i *interpreter) versionedCodes(codes []terminology.Code) []terminology.Code {
+	if i.strictCodeVersions {
+		return codes
+	}
+	unversioned := make([]terminology.Code, 0, len(codes))
+	for _, c := range codes {
+		c.Version = ""
+		unversioned = append(unversioned, c)
+	}
+	return unversioned
+}
+
 // valueToCodes is the helper to convert a value to a list of terminology.Code. Returns an error for
 // value types that are not valid clinical values. Currently only supports Code, Concept,
 // List<Code>, List<Concept>.
@@ -176,14 +201,14 @@ func valueToCodes(o result.Value) ([]terminology.Code, error) {
 		if err != nil {
 			return nil, err
 		}
-		return []terminology.Code{{System: lv.System, Code: lv.Code}}, nil
+		return []terminology.Code{{System: lv.System, Code: lv.Code, Version: lv.Version}}, nil
 	} else if rt.Equal(types.Concept) {
 		concept, err := result.ToConcept(o)
 		if err != nil {
 			return nil, err
 		}
 		for _, c := range concept.NonNullCodeValues() {
-			termCodes = append(termCodes, terminology.Code{System: c.System, Code: c.Code})
+			termCodes = append(termCodes, terminology.Code{System: c.System, Code: c.Code, Version: c.Version})
 		}
 		return termCodes, nil
 	} else if rt.Equal(&types.List{ElementType: types.Code}) {
@@ -193,11 +218,14 @@ func valueToCodes(o result.Value) ([]terminology.Code, error) {
 		}
 
 		for _, c := range list {
+			if result.IsNull(c) {
+				continue
+			}
 			code, err := result.ToCode(c)
 			if err != nil {
 				return nil, err
 			}
-			termCodes = append(termCodes, terminology.Code{System: code.System, Code: code.Code})
+			termCodes = append(termCodes, terminology.Code{System: code.System, Code: code.Code, Version: code.Version})
 		}
 		return termCodes, nil
 	} else if rt.Equal(&types.List{ElementType: types.Concept}) {
@@ -207,12 +235,15 @@ func valueToCodes(o result.Value) ([]terminology.Code, error) {
 		}
 
 		for _, c := range list {
+			if result.IsNull(c) {
+				continue
+			}
 			concept, err := result.ToConcept(c)
 			if err != nil {
 				return nil, err
 			}
 			for _, c := range concept.NonNullCodeValues() {
-				termCodes = append(termCodes, terminology.Code{System: c.System, Code: c.Code})
+				termCodes = append(termCodes, terminology.Code{System: c.System, Code: c.Code, Version: c.Version})
 			}
 		}
 		return termCodes, nil
@@ -18,10 +18,12 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"unicode"
 
 	"github.com/google/cql/internal/convert"
+	"github.com/google/cql/internal/ucum"
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
@@ -39,9 +41,133 @@ func (i *interpreter) evalEqual(_ model.IBinaryExpression, lObj, rObj result.Val
 	return result.New(lObj.Equal(rObj))
 }
 
+// =(left Integer, right Integer) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// Either operand may be an uncertain Integer. Uncertainties that do not overlap are not equal,
+// otherwise the result is uncertain and null is returned.
+func evalEqualInteger(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := applyToValues(lObj, rObj, toUncertainty)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if l.High < r.Low || r.High < l.Low {
+		return result.New(false)
+	}
+	if l.Low == l.High && l == r {
+		return result.New(true)
+	}
+	return result.New(nil)
+}
+
+// =(left Quantity, right Quantity) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// The right quantity is converted to the unit of the left quantity before comparing. Quantities
+// with units of different dimensions cannot be compared and return an error.
+func evalEqualQuantity(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := quantitiesInSameUnit(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(equivalentDecimal(l.Value, r.Value))
+}
+
+// =(left Ratio, right Ratio) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// The numerators and denominators are compared separately as quantities, so 1 'g':2 'mL' =
+// 1000 'mg':2 'mL' is true, but 1:100 = 10:1000 is false.
+func evalEqualRatio(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToRatio)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rNumerator, err := convertQuantity(r.Numerator, l.Numerator.Unit)
+	if err != nil {
+		return result.Value{}, fmt.Errorf("cannot compare ratio numerators %v '%v' and %v '%v': %w", l.Numerator.Value, l.Numerator.Unit, r.Numerator.Value, r.Numerator.Unit, err)
+	}
+	rDenominator, err := convertQuantity(r.Denominator, l.Denominator.Unit)
+	if err != nil {
+		return result.Value{}, fmt.Errorf("cannot compare ratio denominators %v '%v' and %v '%v': %w", l.Denominator.Value, l.Denominator.Unit, r.Denominator.Value, r.Denominator.Unit, err)
+	}
+	return result.New(equivalentDecimal(l.Numerator.Value, rNumerator.Value) && equivalentDecimal(l.Denominator.Value, rDenominator.Value))
+}
+
+// =(left Code, right Code) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// Codes are equal if they have the same code and system. The version and display are ignored, so
+// the same code from different versions of a code system is equal.
+func evalEqualCode(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToCode)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(codesEqual(l, r))
+}
+
+// =(left Concept, right Concept) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// Concepts are equal if every code in each Concept is equal to a code in the other. The display is
+// ignored.
+func evalEqualConcept(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToConcept)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(conceptContainsAll(l, r) && conceptContainsAll(r, l))
+}
+
+// codesEqual returns true if the codes have the same code and system.
+func codesEqual(l, r result.Code) bool {
+	return l.Code == r.Code && l.System == r.System
+}
+
+// conceptContains returns true if a code in the concept is equal to c. Null codes in the concept
+// are skipped.
+func conceptContains(concept result.Concept, c result.Code) bool {
+	for _, conCode := range concept.Codes {
+		if conCode != nil && codesEqual(*conCode, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// conceptContainsAll returns true if every code in other is equal to a code in concept.
+func conceptContainsAll(concept, other result.Concept) bool {
+	for _, c := range other.Codes {
+		if c != nil && !conceptContains(concept, *c) {
+			return false
+		}
+	}
+	return true
+}
+
 // =(left DateTime, right DateTime) Boolean
 // =(left Date, right Date) Boolean
+// =(left Time, right Time) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#equal
+//
+// If the values are equal up to the precision of only one of them the result is uncertain, for
+// example @2020 = @2020-06, and null is returned.
 func evalEqualDateTime(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
@@ -74,9 +200,63 @@ func evalEquivalentSimpleType(_ model.IBinaryExpression, lObj, rObj result.Value
 	if result.IsNull(lObj) && result.IsNull(rObj) {
 		return result.New(true)
 	}
+	if result.IsNull(lObj) != result.IsNull(rObj) {
+		return result.New(false)
+	}
 	return result.New(lObj.Equal(rObj))
 }
 
+// ~<T>(left T, right T) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equivalent
+//
+// Unlike equal, equivalent never returns null. Operands such as null literals have a static type of
+// Any, which does not match any overload, so they fall back to matching on the runtime types.
+func (i *interpreter) evalEquivalent(m *model.Equivalent) (result.Value, error) {
+	lObj, err := i.evalExpression(m.Left())
+	if err != nil {
+		return result.Value{}, err
+	}
+	rObj, err := i.evalExpression(m.Right())
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	overloads, err := i.binaryOverloads(m)
+	if err != nil {
+		return result.Value{}, err
+	}
+	var res result.Value
+	evalFunc, err := convert.ExactOverloadMatch([]types.IType{m.Left().GetResultType(), m.Right().GetResultType()}, overloads, i.modelInfo, m.GetName())
+	switch {
+	case err == nil:
+		res, err = evalFunc(m, lObj, rObj)
+	case errors.Is(err, convert.ErrNoMatch):
+		res, err = i.evalEquivalentValue(lObj, rObj)
+	}
+	if err != nil {
+		return result.Value{}, err
+	}
+	return res.WithSources(m, lObj, rObj), nil
+}
+
+// evalEqualValue applies the CQL equal operator to the passed Values, matching the overload on
+// their runtime types.
+func (i *interpreter) evalEqualValue(lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	overloads, err := i.binaryOverloads(&model.Equal{})
+	if err != nil {
+		return result.Value{}, err
+	}
+	opTypes := []types.IType{lObj.RuntimeType(), rObj.RuntimeType()}
+	innerEqualFunc, err := convert.ExactOverloadMatch(opTypes, overloads, i.modelInfo, "Equal")
+	if err != nil {
+		return result.Value{}, err
+	}
+	return innerEqualFunc(nil, lObj, rObj)
+}
+
 // evalEquivalentValue applies the CQL equivalent operator to the passed Values.
 func (i *interpreter) evalEquivalentValue(lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) && result.IsNull(rObj) {
@@ -199,6 +379,94 @@ func evalEquivalentString(_ model.IBinaryExpression, lObj, rObj result.Value) (r
 	return result.New(equivalentString(lStr) == equivalentString(rStr))
 }
 
+// ~(left Decimal, right Decimal) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equivalent
+func evalEquivalentDecimal(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) && result.IsNull(rObj) {
+		return result.New(true)
+	}
+	if result.IsNull(lObj) != result.IsNull(rObj) {
+		return result.New(false)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToFloat64)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(equivalentDecimal(l, r))
+}
+
+// ~(left Quantity, right Quantity) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equivalent
+func evalEquivalentQuantity(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) && result.IsNull(rObj) {
+		return result.New(true)
+	}
+	if result.IsNull(lObj) != result.IsNull(rObj) {
+		return result.New(false)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToQuantity)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(equivalentQuantity(l, r))
+}
+
+// ~(left Ratio, right Ratio) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equivalent
+//
+// Ratios are equivalent if they represent the same ratio, for example 1:100 ~ 10:1000.
+func evalEquivalentRatio(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) && result.IsNull(rObj) {
+		return result.New(true)
+	}
+	if result.IsNull(lObj) != result.IsNull(rObj) {
+		return result.New(false)
+	}
+	l, r, err := applyToValues(lObj, rObj, result.ToRatio)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if l.Denominator.Value == 0 || r.Denominator.Value == 0 {
+		return result.New(equivalentQuantity(l.Numerator, r.Numerator) && equivalentQuantity(l.Denominator, r.Denominator))
+	}
+	rNumerator, err := ucum.ConvertUnit(r.Numerator.Value, string(r.Numerator.Unit), string(l.Numerator.Unit))
+	if err != nil {
+		return result.New(false)
+	}
+	rDenominator, err := ucum.ConvertUnit(r.Denominator.Value, string(r.Denominator.Unit), string(l.Denominator.Unit))
+	if err != nil {
+		return result.New(false)
+	}
+	return result.New(equivalentDecimal(l.Numerator.Value/l.Denominator.Value, rNumerator/rDenominator))
+}
+
+// decimalPlaces is the maximum number of decimal places of a CQL Decimal.
+const decimalPlaces = 8
+
+// roundDecimal rounds v to the precision of a CQL Decimal, removing floating point error such as
+// the error introduced by unit conversion.
+func roundDecimal(v float64) float64 {
+	scale := math.Pow10(decimalPlaces)
+	return math.Round(v*scale) / scale
+}
+
+// equivalentDecimal returns whether two decimals are equal when rounded to the precision of a CQL
+// Decimal, so trailing zeros and floating point error do not affect the result.
+func equivalentDecimal(l, r float64) bool {
+	return roundDecimal(l) == roundDecimal(r)
+}
+
+// equivalentQuantity returns whether two quantities are equivalent after converting the right
+// quantity to the unit of the left quantity. Quantities with incompatible units are not
+// equivalent.
+func equivalentQuantity(l, r result.Quantity) bool {
+	rValue, err := ucum.ConvertUnit(r.Value, string(r.Unit), string(l.Unit))
+	if err != nil {
+		return false
+	}
+	return equivalentDecimal(l.Value, rValue)
+}
+
 // equivalentString converts all characters to lowercase, and normalizes all whitespace to a single
 // space for equivalent string comparison.
 func equivalentString(input string) string {
@@ -255,6 +523,7 @@ func (i *interpreter) evalEquivalentInterval(_ model.IBinaryExpression, lObj, rO
 
 // ~(left DateTime, right DateTime) Boolean
 // ~(left Date, right Date) Boolean
+// ~(left Time, right Time) Boolean
 // All equivalent overloads should be resilient to a nil model.
 // https://cql.hl7.org/09-b-cqlreference.html#equivalent
 func evalEquivalentDateTime(_ model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
@@ -322,6 +591,45 @@ func (i *interpreter) evalEquivalentConceptCode(b model.IBinaryExpression, lObj,
 	return result.New(false)
 }
 
+// ~(left Concept, right Concept) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#equivalent
+//
+// Concepts are equivalent if any code in one is equivalent to a code in the other.
+func (i *interpreter) evalEquivalentConceptConcept(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) && result.IsNull(rObj) {
+		return result.New(true)
+	}
+	if result.IsNull(lObj) != result.IsNull(rObj) {
+		return result.New(false)
+	}
+
+	lCon, err := result.ToConcept(lObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	for _, c := range lCon.Codes {
+		if c == nil {
+			continue
+		}
+		codeObj, err := result.New(*c)
+		if err != nil {
+			return result.Value{}, err
+		}
+		equi, err := i.evalEquivalentConceptCode(b, rObj, codeObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		equiBool, err := result.ToBool(equi)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if equiBool {
+			return result.New(true)
+		}
+	}
+	return result.New(false)
+}
+
 func (i *interpreter) evalEquivalentCodeCode(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) && result.IsNull(rObj) {
 		return result.New(true)
@@ -359,10 +667,20 @@ func (i *interpreter) evalEquivalentCodeCode(b model.IBinaryExpression, lObj, rO
 // https://cql.hl7.org/09-b-cqlreference.html#less-or-equal
 // https://cql.hl7.org/09-b-cqlreference.html#greater
 // https://cql.hl7.org/09-b-cqlreference.html#greater-or-equal
+//
+// Either operand may be an uncertain Integer, in which case the result is null unless it is the
+// same for every value in the uncertainties.
 func evalCompareInteger(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
 	}
+	if isUncertain(lObj) || isUncertain(rObj) {
+		l, r, err := applyToValues(lObj, rObj, toUncertainty)
+		if err != nil {
+			return result.Value{}, err
+		}
+		return compareUncertainty(m, l, r)
+	}
 	l, r, err := applyToValues(lObj, rObj, result.ToInt32)
 	if err != nil {
 		return result.Value{}, err
@@ -402,6 +720,36 @@ func evalCompareDecimal(m model.IBinaryExpression, lObj, rObj result.Value) (res
 	return compare(m, l, r)
 }
 
+// op(left Quantity, right Quantity) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#less
+// https://cql.hl7.org/09-b-cqlreference.html#less-or-equal
+// https://cql.hl7.org/09-b-cqlreference.html#greater
+// https://cql.hl7.org/09-b-cqlreference.html#greater-or-equal
+func evalCompareQuantity(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := quantitiesInSameUnit(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return compare(m, roundDecimal(l.Value), roundDecimal(r.Value))
+}
+
+// quantitiesInSameUnit returns the left and right quantities with the right quantity converted to
+// the unit of the left quantity. Returns an error if the units have different dimensions.
+func quantitiesInSameUnit(lObj, rObj result.Value) (result.Quantity, result.Quantity, error) {
+	l, r, err := applyToValues(lObj, rObj, result.ToQuantity)
+	if err != nil {
+		return result.Quantity{}, result.Quantity{}, err
+	}
+	converted, err := convertQuantity(r, l.Unit)
+	if err != nil {
+		return result.Quantity{}, result.Quantity{}, fmt.Errorf("cannot compare quantities %v '%v' and %v '%v': %w", l.Value, l.Unit, r.Value, r.Unit, err)
+	}
+	return l, converted, nil
+}
+
 // op(left String, right String) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#less
 // https://cql.hl7.org/09-b-cqlreference.html#less-or-equal
@@ -419,10 +767,16 @@ func evalCompareString(m model.IBinaryExpression, lObj, rObj result.Value) (resu
 }
 
 // op(left DateTime, right DateTime) Boolean
+// op(left Date, right Date) Boolean
+// op(left Time, right Time) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#less
 // https://cql.hl7.org/09-b-cqlreference.html#less-or-equal
 // https://cql.hl7.org/09-b-cqlreference.html#greater
 // https://cql.hl7.org/09-b-cqlreference.html#greater-or-equal
+//
+// Values with different precisions are compared up to the precision of the less precise value. If
+// they are equal up to that precision the result is uncertain, for example @2020 < @2020-06, and
+// null is returned.
 func evalCompareDateTime(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
@@ -444,6 +798,24 @@ func evalCompareDateTime(m model.IBinaryExpression, lObj, rObj result.Value) (re
 	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Comparison Expression %v", m)
 }
 
+// compareUncertainty compares the uncertainties l and r. Comparisons only depend on the difference
+// between the values, so it is enough to compare the pairs of values with the smallest and largest
+// difference. If they disagree the result is uncertain and null is returned.
+func compareUncertainty(m model.IBinaryExpression, l, r result.Uncertainty) (result.Value, error) {
+	smallest, err := compare(m, l.Low, r.High)
+	if err != nil {
+		return result.Value{}, err
+	}
+	largest, err := compare(m, l.High, r.Low)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !smallest.Equal(largest) {
+		return result.New(nil)
+	}
+	return smallest, nil
+}
+
 func compare[n cmp.Ordered](m model.IBinaryExpression, l, r n) (result.Value, error) {
 	switch m.(type) {
 	case *model.Less:
@@ -111,6 +111,24 @@ func precisionFromBinaryExpression(b model.IBinaryExpression) (model.DateTimePre
 		p = t.Precision
 	case *model.Overlaps:
 		p = t.Precision
+	case *model.OverlapsBefore:
+		p = t.Precision
+	case *model.OverlapsAfter:
+		p = t.Precision
+	case *model.Meets:
+		p = t.Precision
+	case *model.MeetsBefore:
+		p = t.Precision
+	case *model.MeetsAfter:
+		p = t.Precision
+	case *model.Starts:
+		p = t.Precision
+	case *model.Ends:
+		p = t.Precision
+	case *model.Includes:
+		p = t.Precision
+	case *model.IncludedIn:
+		p = t.Precision
 	default:
 		return model.DateTimePrecision(""), fmt.Errorf("internal error - unsupported Binary Comparison Expression %v", b)
 	}
@@ -300,16 +318,7 @@ func evalDifferenceBetweenDate(b model.IBinaryExpression, lObj, rObj result.Valu
 	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY}); err != nil {
 		return result.Value{}, err
 	}
-
-	if result.IsNull(lObj) || result.IsNull(rObj) {
-		return result.New(nil)
-	}
-
-	l, r, err := applyToValues(lObj, rObj, result.ToDateTime)
-	if err != nil {
-		return result.Value{}, err
-	}
-	return dateTimeDifference(l, r, p)
+	return evalBetween(lObj, rObj, p, dateTimeDifference)
 }
 
 // difference in _precision_ between(left DateTime, right DateTime) Integer
@@ -321,7 +330,60 @@ func evalDifferenceBetweenDateTime(b model.IBinaryExpression, lObj, rObj result.
 	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY, model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
 		return result.Value{}, err
 	}
+	return evalBetween(lObj, rObj, p, dateTimeDifference)
+}
+
+// difference in _precision_ between(left Time, right Time) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#difference
+// Returns the number of boundaries crossed between two times.
+func evalDifferenceBetweenTime(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	m := b.(*model.DifferenceBetween)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+		return result.Value{}, err
+	}
+	return evalBetween(lObj, rObj, p, dateTimeDifference)
+}
+
+// duration in _precision_ between(left Date, right Date) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#duration
+// Returns the number of whole periods between two dates.
+func evalDurationBetweenDate(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	m := b.(*model.DurationBetween)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY}); err != nil {
+		return result.Value{}, err
+	}
+	return evalBetween(lObj, rObj, p, dateTimeDuration)
+}
+
+// duration in _precision_ between(left DateTime, right DateTime) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#duration
+// Returns the number of whole periods between two datetimes.
+func evalDurationBetweenDateTime(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	m := b.(*model.DurationBetween)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.YEAR, model.MONTH, model.WEEK, model.DAY, model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+		return result.Value{}, err
+	}
+	return evalBetween(lObj, rObj, p, dateTimeDuration)
+}
+
+// duration in _precision_ between(left Time, right Time) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#duration
+// Returns the number of whole periods between two times.
+func evalDurationBetweenTime(b model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	m := b.(*model.DurationBetween)
+	p := model.DateTimePrecision(m.Precision)
+	if err := validatePrecision(p, []model.DateTimePrecision{model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+		return result.Value{}, err
+	}
+	return evalBetween(lObj, rObj, p, dateTimeDuration)
+}
 
+// evalBetween converts the Date, DateTime or Time operands and applies between, returning null if
+// either operand is null.
+func evalBetween(lObj, rObj result.Value, p model.DateTimePrecision, between func(l, r result.DateTime, p model.DateTimePrecision) (result.Value, error)) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
 	}
@@ -330,7 +392,83 @@ func evalDifferenceBetweenDateTime(b model.IBinaryExpression, lObj, rObj result.
 	if err != nil {
 		return result.Value{}, err
 	}
-	return dateTimeDifference(l, r, p)
+	return between(l, r, p)
+}
+
+// _precision_ from(argument Date) Integer
+// _precision_ from(argument DateTime) Integer
+// _precision_ from(argument Time) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#datetime-component-from
+// Returns null if the argument is not specified to the requested precision.
+func evalDateTimeComponentFrom(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	if result.IsNull(obj) {
+		return result.New(nil)
+	}
+	p := m.(*model.DateTimeComponentFrom).Precision
+	d, err := result.ToDateTime(obj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !precisionGreaterOrEqual(p, d.Precision) {
+		return result.New(nil)
+	}
+
+	switch p {
+	case model.YEAR:
+		return result.New(d.Date.Year())
+	case model.MONTH:
+		return result.New(int(d.Date.Month()))
+	case model.DAY:
+		return result.New(d.Date.Day())
+	case model.HOUR:
+		return result.New(d.Date.Hour())
+	case model.MINUTE:
+		return result.New(d.Date.Minute())
+	case model.SECOND:
+		return result.New(d.Date.Second())
+	case model.MILLISECOND:
+		return result.New(d.Date.Nanosecond() / int(time.Millisecond))
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported precision in date time component from: %v", p)
+	}
+}
+
+// date from(argument DateTime) Date
+// https://cql.hl7.org/09-b-cqlreference.html#datetime-component-from
+// Returns the date of the argument, with a precision no finer than day.
+func evalDateFrom(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	if result.IsNull(obj) {
+		return result.New(nil)
+	}
+	d, err := result.ToDateTime(obj)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	p := d.Precision
+	if !precisionGreaterOrEqual(p, model.DAY) {
+		p = model.DAY
+	}
+	return result.New(result.Date{Date: truncateTime(d.Date, p), Precision: p})
+}
+
+// time from(argument DateTime) Time
+// https://cql.hl7.org/09-b-cqlreference.html#datetime-component-from
+// Returns the time of day of the argument, or null if the argument has no hour component.
+func evalTimeFrom(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	if result.IsNull(obj) {
+		return result.New(nil)
+	}
+	d, err := result.ToDateTime(obj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !precisionGreaterOrEqual(model.HOUR, d.Precision) {
+		return result.New(nil)
+	}
+
+	t := time.Date(0, time.January, 1, d.Date.Hour(), d.Date.Minute(), d.Date.Second(), d.Date.Nanosecond(), d.Date.Location())
+	return result.New(result.Time{Date: truncateTime(t, d.Precision), Precision: d.Precision})
 }
 
 // Now() DateTime
@@ -412,17 +550,20 @@ func (i *interpreter) evalDateTime(n model.INaryExpression, objs []result.Value)
 		return result.New(nil)
 	}
 
+	// If the timezone offset is not specified it defaults to the offset of the evaluation timestamp.
 	loc := i.evaluationTimestamp.Location()
 	if len(objs) == 8 {
-		v, err := result.ToFloat64(objs[7])
-		if err != nil {
-			return result.Value{}, err
-		}
-		if v > 14 || v < -14 {
-			return result.Value{}, fmt.Errorf("timezone offset %v is out of range", v)
+		if !result.IsNull(objs[7]) {
+			v, err := result.ToFloat64(objs[7])
+			if err != nil {
+				return result.Value{}, err
+			}
+			if v > 14 || v < -14 {
+				return result.Value{}, fmt.Errorf("timezone offset %v is out of range", v)
+			}
+			// int() will truncate timezones with greater than second precision.
+			loc = time.FixedZone(fmt.Sprintf("%v", v), int(v*60.0*60.0))
 		}
-		// int() will truncate timezones with greater than second precision.
-		loc = time.FixedZone(fmt.Sprintf("%v", v), int(v*60.0*60.0))
 		objs = objs[:7]
 	}
 
@@ -453,6 +594,9 @@ func (i *interpreter) evalDateTime(n model.INaryExpression, objs []result.Value)
 		return result.New(result.DateTime{Date: t, Precision: model.YEAR})
 	case 2:
 		t := time.Date(dateVals[0], time.Month(dateVals[1]), 1, 0, 0, 0, 0, loc)
+		if err := validateDateTime(dateVals, t); err != nil {
+			return result.Value{}, err
+		}
 		return result.New(result.DateTime{Date: t, Precision: model.MONTH})
 	case 3:
 		t := time.Date(dateVals[0], time.Month(dateVals[1]), dateVals[2], 0, 0, 0, 0, loc)
@@ -626,61 +770,201 @@ func (i *interpreter) evalTimeOfDay(_ model.INaryExpression, _ []result.Value) (
 func (i *interpreter) evalToday(n model.INaryExpression, _ []result.Value) (result.Value, error) {
 	year, month, day := i.evaluationTimestamp.Date()
 	return result.New(result.Date{
-		Date:      time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+		Date:      time.Date(year, month, day, 0, 0, 0, 0, i.evaluationTimestamp.Location()),
 		Precision: model.DAY,
 	})
 }
 
-// dateTimeDifference returns the difference at the desired precision for two Go time values.
-// Left value can be greater than right value, in such cases a negative value should be returned.
-// TODO b/318386749 - Add uncertainty logic once uncertainties are implemented.
+// dateTimeDifference returns the number of opPrecision boundaries crossed between l and r. Left
+// value can be greater than right value, in such cases a negative value is returned.
 func dateTimeDifference(l, r result.DateTime, opPrecision model.DateTimePrecision) (result.Value, error) {
-	if !precisionGreaterOrEqual(opPrecision, l.Precision) || !precisionGreaterOrEqual(opPrecision, r.Precision) {
-		// TODO b/318386749 - precisionGreaterOrEqual is a temporary check to ensure the precision of
-		// "difference in _precision_ between" is greater than the precision of the
-		// l, r dateTimeValues. In the future we need to support all cases by returning
-		// an uncertainty.
-		return result.Value{}, fmt.Errorf("difference between specified a precision greater than argument precision got, %s, and %s, wanted %v", l.Precision, r.Precision, opPrecision)
+	return uncertainBetween(l, r, opPrecision, differenceBetween)
+}
+
+// dateTimeDuration returns the number of whole opPrecision periods between l and r. Left value can
+// be greater than right value, in such cases a negative value is returned.
+func dateTimeDuration(l, r result.DateTime, opPrecision model.DateTimePrecision) (result.Value, error) {
+	return uncertainBetween(l, r, opPrecision, durationBetween)
+}
+
+// uncertainBetween applies between to l and r. If l or r are less precise than opPrecision they
+// each represent a range of times, so the result is an uncertainty from the smallest to the largest
+// possible result.
+func uncertainBetween(l, r result.DateTime, opPrecision model.DateTimePrecision, between func(left, right time.Time, opPrecision model.DateTimePrecision) (int, error)) (result.Value, error) {
+	if precisionGreaterOrEqual(opPrecision, l.Precision) && precisionGreaterOrEqual(opPrecision, r.Precision) {
+		v, err := between(truncateTime(l.Date, l.Precision), truncateTime(r.Date, r.Precision), opPrecision)
+		if err != nil {
+			return result.Value{}, err
+		}
+		return result.New(v)
+	}
+
+	lEarliest, lLatest, err := dateTimeRange(l)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rEarliest, rLatest, err := dateTimeRange(r)
+	if err != nil {
+		return result.Value{}, err
+	}
+	// The result is smallest when left is as late and right is as early as possible.
+	low, err := between(lLatest, rEarliest, opPrecision)
+	if err != nil {
+		return result.Value{}, err
+	}
+	high, err := between(lEarliest, rLatest, opPrecision)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return newUncertainty(int32(low), int32(high))
+}
+
+// newUncertainty returns an uncertain Integer ranging from low to high, or an Integer if low and
+// high are the same.
+func newUncertainty(low, high int32) (result.Value, error) {
+	return result.New(result.Uncertainty{Low: low, High: high})
+}
+
+// isUncertain returns true if v is an uncertain Integer.
+func isUncertain(v result.Value) bool {
+	_, ok := v.GolangValue().(result.Uncertainty)
+	return ok
+}
+
+// toUncertainty converts an Integer or uncertain Integer to an Uncertainty, so that operators can
+// handle both in the same way. An Integer is an Uncertainty with the same low and high.
+func toUncertainty(v result.Value) (result.Uncertainty, error) {
+	if u, ok := v.GolangValue().(result.Uncertainty); ok {
+		return u, nil
 	}
-	left, right := l.Date, r.Date
+	i, err := result.ToInt32(v)
+	if err != nil {
+		return result.Uncertainty{}, err
+	}
+	return result.Uncertainty{Low: i, High: i}, nil
+}
 
+// dateTimeRange returns the earliest and latest times that d may represent at millisecond
+// precision, for example @2014-02 ranges from @2014-02-01T00:00:00.000 to @2014-02-28T23:59:59.999.
+func dateTimeRange(d result.DateTime) (time.Time, time.Time, error) {
+	t := truncateTime(d.Date, d.Precision)
+	var next time.Time
+	switch d.Precision {
+	case model.YEAR:
+		next = t.AddDate(1, 0, 0)
+	case model.MONTH:
+		next = t.AddDate(0, 1, 0)
+	case model.DAY:
+		next = t.AddDate(0, 0, 1)
+	case model.HOUR:
+		next = t.Add(time.Hour)
+	case model.MINUTE:
+		next = t.Add(time.Minute)
+	case model.SECOND:
+		next = t.Add(time.Second)
+	case model.MILLISECOND:
+		return t, t, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("internal error - unsupported precision %v when computing the range of a DateTime", d.Precision)
+	}
+	return t, next.Add(-time.Millisecond), nil
+}
+
+// differenceBetween returns the number of opPrecision boundaries crossed between left and right.
+func differenceBetween(left, right time.Time, opPrecision model.DateTimePrecision) (int, error) {
 	switch opPrecision {
 	case model.YEAR:
-		return result.New(right.Year() - left.Year())
+		return right.Year() - left.Year(), nil
 	case model.MONTH:
-		return result.New(12*(right.Year()-left.Year()) + int((right.Month())) - int(left.Month()))
+		return 12*(right.Year()-left.Year()) + int((right.Month())) - int(left.Month()), nil
 	case model.WEEK:
 		// Weekly borders crossed are number of times a Sunday boundary has been crossed.
 		// TODO(b/301606416): Weeks do not correctly support negative values.
 		diffInDays := int(right.Sub(left).Hours() / 24)
 		leftDaysSinceSunday, rightDaysSinceSunday := int(left.Weekday()), int(right.Weekday())
 		if diffInDays < 7 && rightDaysSinceSunday < leftDaysSinceSunday {
-			return result.New(1)
+			return 1, nil
 		} else if diffInDays < 7 {
-			return result.New(0)
+			return 0, nil
 		}
 		// There is at least one week here. Remove the left side days until Sunday and add a week to account for that.
 		// From there the number of remaining weeks are only whole seven day weeks.
-		return result.New(int((diffInDays-(7-leftDaysSinceSunday))/7) + 1)
+		return int((diffInDays-(7-leftDaysSinceSunday))/7) + 1, nil
+	case model.DAY:
+		// Only the calendar dates, each in their own timezone offset, are compared to count the day
+		// boundaries crossed. The dates are compared in UTC so that every day is 24 hours long.
+		leftDate := time.Date(left.Year(), left.Month(), left.Day(), 0, 0, 0, 0, time.UTC)
+		rightDate := time.Date(right.Year(), right.Month(), right.Day(), 0, 0, 0, 0, time.UTC)
+		return int(rightDate.Sub(leftDate) / (24 * time.Hour)), nil
+	case model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND:
+		// Truncating both values to opPrecision leaves only the boundaries crossed, so that there is
+		// one hour boundary between 10:59 and 11:01.
+		right = right.In(left.Location())
+		return durationBetween(truncateTime(left, opPrecision), truncateTime(right, opPrecision), opPrecision)
+	default:
+		return 0, fmt.Errorf("unsupported precision for difference between: %v", opPrecision)
+	}
+}
+
+// addMonthsClamped adds months to t, clamping the day to the last day of the resulting month
+// rather than overflowing into the next month.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return firstOfMonth.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
+
+// truncateTime returns t with all components more precise than p set to zero.
+func truncateTime(t time.Time, p model.DateTimePrecision) time.Time {
+	switch p {
+	case model.YEAR:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	case model.MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
 	case model.DAY:
-		// This logic is to ensure we are only counting by day boundaries crossed.
-		epoch := time.UnixMilli(0)
-		leftDaysSinceEpoch := int(left.Sub(epoch).Hours() / 24)
-		rightDaysSinceEpoch := int(right.Sub(epoch).Hours() / 24)
-		return result.New(rightDaysSinceEpoch - leftDaysSinceEpoch)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 	case model.HOUR:
-		return result.New(int(right.Sub(left).Hours()))
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
 	case model.MINUTE:
-		return result.New(int(right.Sub(left).Minutes()))
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
 	case model.SECOND:
-		// TODO(b/301606416): According to the spec seconds and milliseconds should be combined and
-		// compared as a decimal. It is not clear what this means, but this implementation may be
-		// incorrect.
-		return result.New(int(right.Sub(left).Seconds()))
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	default:
+		return t
+	}
+}
+
+// durationBetween returns the number of whole opPrecision periods between left and right.
+func durationBetween(left, right time.Time, opPrecision model.DateTimePrecision) (int, error) {
+	switch opPrecision {
+	case model.YEAR, model.MONTH:
+		right = right.In(left.Location())
+		months := 12*(right.Year()-left.Year()) + int(right.Month()) - int(left.Month())
+		// The last month is only whole once right reaches left plus the months, for example there is
+		// one month from January 31st to February 29th 2020.
+		if end := addMonthsClamped(left, months); months > 0 && right.Before(end) {
+			months--
+		} else if months < 0 && right.After(end) {
+			months++
+		}
+		if opPrecision == model.YEAR {
+			return months / 12, nil
+		}
+		return months, nil
+	case model.WEEK:
+		return int(right.Sub(left) / (7 * 24 * time.Hour)), nil
+	case model.DAY:
+		return int(right.Sub(left) / (24 * time.Hour)), nil
+	case model.HOUR:
+		return int(right.Sub(left) / time.Hour), nil
+	case model.MINUTE:
+		return int(right.Sub(left) / time.Minute), nil
+	case model.SECOND:
+		return int(right.Sub(left) / time.Second), nil
 	case model.MILLISECOND:
-		return result.New(int(right.Sub(left).Milliseconds()))
+		return int(right.Sub(left) / time.Millisecond), nil
 	default:
-		return result.Value{}, fmt.Errorf("unsupported precision for dateTimeDifference: %v", opPrecision)
+		return 0, fmt.Errorf("unsupported precision for duration between: %v", opPrecision)
 	}
 }
 
@@ -732,8 +1016,7 @@ func compareDateTimeWithPrecision(left, right result.DateTime, maxPrecision mode
 		maxPrecision = finestPrecision
 	}
 
-	left = normalizeDateTime(left)
-	right = normalizeDateTime(right)
+	left, right = normalizeDateTimes(left, right)
 
 	for _, p := range orderedPrecisions {
 		switch p {
@@ -785,12 +1068,23 @@ func compareDateTime(left, right result.DateTime) (comparison, error) {
 	return compareDateTimeWithPrecision(left, right, model.UNSETDATETIMEPRECISION)
 }
 
-func normalizeDateTime(d result.DateTime) result.DateTime {
-	if precisionGreaterOrEqual(d.Precision, model.DAY) {
-		return d
-	}
-	d.Date = d.Date.In(time.UTC)
-	return d
+// normalizeDateTimes converts left and right to a common timezone so their fields can be compared
+// as instants. Values with a precision of day or coarser are not converted, since changing their
+// timezone could change their day. Values without an offset were already given the evaluation
+// timezone when they were created.
+func normalizeDateTimes(left, right result.DateTime) (result.DateTime, result.DateTime) {
+	leftHasTime := !precisionGreaterOrEqual(left.Precision, model.DAY)
+	rightHasTime := !precisionGreaterOrEqual(right.Precision, model.DAY)
+	switch {
+	case leftHasTime && rightHasTime:
+		left.Date = left.Date.In(time.UTC)
+		right.Date = right.Date.In(time.UTC)
+	case leftHasTime:
+		left.Date = left.Date.In(right.Date.Location())
+	case rightHasTime:
+		right.Date = right.Date.In(left.Date.Location())
+	}
+	return left, right
 }
 
 // toComparison converts the result of cmp.Compare() to comparison.
@@ -174,6 +174,45 @@ func TestCompareWithPrecision(t *testing.T) {
 			},
 			want: insufficientPrecision,
 		},
+		{
+			name:      "leftEqualRight: different offsets are compared as instants",
+			precision: model.UNSETDATETIMEPRECISION,
+			l: result.DateTime{
+				Date:      time.Date(2020, time.January, 1, 12, 0, 0, 0, time.FixedZone("", -5*60*60)),
+				Precision: model.SECOND,
+			},
+			r: result.DateTime{
+				Date:      time.Date(2020, time.January, 1, 17, 0, 0, 0, time.UTC),
+				Precision: model.SECOND,
+			},
+			want: leftEqualRight,
+		},
+		{
+			name:      "leftBeforeRight: different offsets on different days are compared as instants",
+			precision: model.UNSETDATETIMEPRECISION,
+			l: result.DateTime{
+				Date:      time.Date(2020, time.January, 2, 1, 0, 0, 0, time.FixedZone("", 4*60*60)),
+				Precision: model.SECOND,
+			},
+			r: result.DateTime{
+				Date:      time.Date(2020, time.January, 1, 22, 0, 0, 0, time.UTC),
+				Precision: model.SECOND,
+			},
+			want: leftBeforeRight,
+		},
+		{
+			name:      "insufficientPrecision: time is compared in the timezone of a day precision value",
+			precision: model.UNSETDATETIMEPRECISION,
+			l: result.DateTime{
+				Date:      time.Date(2020, time.January, 1, 21, 0, 0, 0, time.UTC),
+				Precision: model.SECOND,
+			},
+			r: result.DateTime{
+				Date:      time.Date(2020, time.January, 2, 0, 0, 0, 0, time.FixedZone("", 4*60*60)),
+				Precision: model.DAY,
+			},
+			want: insufficientPrecision,
+		},
 	}
 
 	for _, test := range tests {
@@ -179,6 +179,35 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalPrecisionTime,
 			},
 		}, nil
+	case *model.DateTimeComponentFrom:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Date},
+				Result:   evalDateTimeComponentFrom,
+			},
+			{
+				Operands: []types.IType{types.DateTime},
+				Result:   evalDateTimeComponentFrom,
+			},
+			{
+				Operands: []types.IType{types.Time},
+				Result:   evalDateTimeComponentFrom,
+			},
+		}, nil
+	case *model.DateFrom:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.DateTime},
+				Result:   evalDateFrom,
+			},
+		}, nil
+	case *model.TimeFrom:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.DateTime},
+				Result:   evalTimeFrom,
+			},
+		}, nil
 	case *model.Exists:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -200,6 +229,27 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalLast,
 			},
 		}, nil
+	case *model.Distinct:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}},
+				Result:   i.evalDistinct,
+			},
+		}, nil
+	case *model.Tail:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}},
+				Result:   evalTail,
+			},
+		}, nil
+	case *model.Flatten:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.List{ElementType: types.Any}}},
+				Result:   evalFlatten,
+			},
+		}, nil
 	case *model.As:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -222,6 +272,17 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   i.evalToDateTimeString,
 			},
 		}, nil
+	case *model.ToTime:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Time},
+				Result:   evalToTimeTime,
+			},
+			{
+				Operands: []types.IType{types.String},
+				Result:   i.evalToTimeString,
+			},
+		}, nil
 	case *model.ToDate:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -237,6 +298,29 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   i.evalToDateString,
 			},
 		}, nil
+	case *model.ToBoolean:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Boolean},
+				Result:   evalToBooleanBoolean,
+			},
+			{
+				Operands: []types.IType{types.Decimal},
+				Result:   evalToBooleanNumber,
+			},
+			{
+				Operands: []types.IType{types.Long},
+				Result:   evalToBooleanNumber,
+			},
+			{
+				Operands: []types.IType{types.Integer},
+				Result:   evalToBooleanNumber,
+			},
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalToBooleanString,
+			},
+		}, nil
 	case *model.ToDecimal:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -260,6 +344,25 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalToDecimalBoolean,
 			},
 		}, nil
+	case *model.ToInteger:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Integer},
+				Result:   evalToIntegerInteger,
+			},
+			{
+				Operands: []types.IType{types.Long},
+				Result:   evalToIntegerLong,
+			},
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalToIntegerString,
+			},
+			{
+				Operands: []types.IType{types.Boolean},
+				Result:   evalToIntegerBoolean,
+			},
+		}, nil
 	case *model.ToLong:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -294,6 +397,17 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalToQuantityString,
 			},
 		}, nil
+	case *model.ToRatio:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Ratio},
+				Result:   evalToRatioRatio,
+			},
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalToRatioString,
+			},
+		}, nil
 	case *model.ToConcept:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -347,7 +461,31 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Operands: []types.IType{types.Time},
 				Result:   evalToString,
 			},
+			{
+				Operands: []types.IType{types.Code},
+				Result:   evalToString,
+			},
 		}, nil
+	case *model.ConvertsToBoolean:
+		return i.convertsToOverloads(&model.ToBoolean{})
+	case *model.ConvertsToDate:
+		return i.convertsToOverloads(&model.ToDate{})
+	case *model.ConvertsToDateTime:
+		return i.convertsToOverloads(&model.ToDateTime{})
+	case *model.ConvertsToDecimal:
+		return i.convertsToOverloads(&model.ToDecimal{})
+	case *model.ConvertsToInteger:
+		return i.convertsToOverloads(&model.ToInteger{})
+	case *model.ConvertsToLong:
+		return i.convertsToOverloads(&model.ToLong{})
+	case *model.ConvertsToQuantity:
+		return i.convertsToOverloads(&model.ToQuantity{})
+	case *model.ConvertsToRatio:
+		return i.convertsToOverloads(&model.ToRatio{})
+	case *model.ConvertsToString:
+		return i.convertsToOverloads(&model.ToString{})
+	case *model.ConvertsToTime:
+		return i.convertsToOverloads(&model.ToTime{})
 	case *model.End:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -362,6 +500,27 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   i.evalStart,
 			},
 		}, nil
+	case *model.PointFrom:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Any}},
+				Result:   i.evalPointFrom,
+			},
+		}, nil
+	case *model.Width:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Any}},
+				Result:   i.evalWidth,
+			},
+		}, nil
+	case *model.Size:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Any}},
+				Result:   i.evalSize,
+			},
+		}, nil
 	case *model.SingletonFrom:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -369,6 +528,13 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalSingletonFrom,
 			},
 		}, nil
+	case *model.ToList:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Any},
+				Result:   evalToList,
+			},
+		}, nil
 	case *model.Is:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -423,6 +589,31 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   evalNegateQuantity,
 			},
 		}, nil
+	case *model.Upper:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalUpper,
+			},
+		}, nil
+	case *model.Lower:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalLower,
+			},
+		}, nil
+	case *model.Length:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.String},
+				Result:   evalLengthString,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}},
+				Result:   evalLengthList,
+			},
+		}, nil
 	case *model.Truncate:
 		return []convert.Overload[evalUnarySignature]{
 			{
@@ -512,6 +703,14 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
 				Result:   i.evalAvg,
 			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalAvg,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   i.evalAvg,
+			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.Quantity}},
 				Result:   i.evalAvg,
@@ -524,26 +723,85 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Result:   i.evalCount,
 			},
 		}, nil
+	case *model.GeometricMean:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   i.evalGeometricMean,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalGeometricMean,
+			},
+		}, nil
 	case *model.Max:
 		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   evalMax,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   evalMax,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   evalMax,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Quantity}},
+				Result:   evalMax,
+			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.Date}},
-				Result:   i.evalMaxDateTime,
+				Result:   evalMax,
 			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.DateTime}},
-				Result:   i.evalMaxDateTime,
+				Result:   evalMax,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Time}},
+				Result:   evalMax,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.String}},
+				Result:   evalMax,
 			},
 		}, nil
 	case *model.Min:
 		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   evalMin,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   evalMin,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   evalMin,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Quantity}},
+				Result:   evalMin,
+			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.Date}},
-				Result:   i.evalMinDateTime,
+				Result:   evalMin,
 			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.DateTime}},
-				Result:   i.evalMinDateTime,
+				Result:   evalMin,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Time}},
+				Result:   evalMin,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.String}},
+				Result:   evalMin,
 			},
 		}, nil
 	case *model.Sum:
@@ -571,97 +829,236 @@ func (i *interpreter) unaryOverloads(m model.IUnaryExpression) ([]convert.Overlo
 				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
 				Result:   i.evalMedianDecimal,
 			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalMedianDecimal,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   i.evalMedianDecimal,
+			},
 			{
 				Operands: []types.IType{&types.List{ElementType: types.Quantity}},
 				Result:   i.evalMedianQuantity,
 			},
 		}, nil
-	default:
-		return nil, fmt.Errorf("unsupported Unary Expression %v", m.GetName())
-	}
-}
-
-// TODO(b/312172420): Move BinaryOverloads and UnaryOverloads to their own files.
-func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Overload[evalBinarySignature], error) {
-	switch m.(type) {
-	case *model.Add, *model.Subtract:
-		return []convert.Overload[evalBinarySignature]{
+	case *model.Mode:
+		return []convert.Overload[evalUnarySignature]{
 			{
-				Operands: []types.IType{types.Integer, types.Integer},
-				Result:   evalArithmeticInteger,
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   evalMode,
 			},
 			{
-				Operands: []types.IType{types.Long, types.Long},
-				Result:   evalArithmeticLong,
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   evalMode,
 			},
 			{
-				Operands: []types.IType{types.Decimal, types.Decimal},
-				Result:   evalArithmeticDecimal,
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   evalMode,
 			},
 			{
-				Operands: []types.IType{types.Quantity, types.Quantity},
-				Result:   evalArithmeticQuantity,
+				Operands: []types.IType{&types.List{ElementType: types.String}},
+				Result:   evalMode,
 			},
 			{
-				Operands: []types.IType{types.Date, types.Quantity},
-				Result:   evalArithmeticDate,
+				Operands: []types.IType{&types.List{ElementType: types.Date}},
+				Result:   evalMode,
 			},
 			{
-				Operands: []types.IType{types.DateTime, types.Quantity},
-				Result:   evalArithmeticDateTime,
+				Operands: []types.IType{&types.List{ElementType: types.DateTime}},
+				Result:   evalMode,
 			},
-		}, nil
-	case *model.Multiply, *model.TruncatedDivide, *model.Modulo:
-		return []convert.Overload[evalBinarySignature]{
 			{
-				Operands: []types.IType{types.Integer, types.Integer},
-				Result:   evalArithmeticInteger,
+				Operands: []types.IType{&types.List{ElementType: types.Time}},
+				Result:   evalMode,
 			},
+		}, nil
+	case *model.PopulationStdDev:
+		return []convert.Overload[evalUnarySignature]{
 			{
-				Operands: []types.IType{types.Long, types.Long},
-				Result:   evalArithmeticLong,
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   i.evalPopulationStdDev,
 			},
 			{
-				Operands: []types.IType{types.Decimal, types.Decimal},
-				Result:   evalArithmeticDecimal,
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalPopulationStdDev,
 			},
+		}, nil
+	case *model.PopulationVariance:
+		return []convert.Overload[evalUnarySignature]{
 			{
-				Operands: []types.IType{types.Quantity, types.Quantity},
-				Result:   evalArithmeticQuantity,
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   i.evalPopulationVariance,
 			},
-		}, nil
-	case *model.Power:
-		return []convert.Overload[evalBinarySignature]{
 			{
-				Operands: []types.IType{types.Integer, types.Integer},
-				Result:   evalPower,
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalPopulationVariance,
 			},
+		}, nil
+	case *model.Product:
+		return []convert.Overload[evalUnarySignature]{
 			{
-				Operands: []types.IType{types.Long, types.Long},
-				Result:   evalPower,
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   evalProduct,
 			},
 			{
-				Operands: []types.IType{types.Decimal, types.Decimal},
-				Result:   evalPower,
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   evalProduct,
 			},
 			{
-				Operands: []types.IType{types.Quantity, types.Quantity},
-				Result:   evalPower,
+				Operands: []types.IType{&types.List{ElementType: types.Long}},
+				Result:   evalProduct,
 			},
 		}, nil
-	case *model.Divide:
-		return []convert.Overload[evalBinarySignature]{
+	case *model.StdDev:
+		return []convert.Overload[evalUnarySignature]{
 			{
-				Operands: []types.IType{types.Decimal, types.Decimal},
-				Result:   evalArithmeticDecimal,
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   i.evalStdDev,
 			},
 			{
-				Operands: []types.IType{types.Quantity, types.Quantity},
-				Result:   evalArithmeticQuantity,
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalStdDev,
 			},
 		}, nil
-	case *model.And, *model.Or, *model.XOr, *model.Implies:
-		return []convert.Overload[evalBinarySignature]{
+	case *model.Variance:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Decimal}},
+				Result:   i.evalVariance,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Integer}},
+				Result:   i.evalVariance,
+			},
+		}, nil
+	case *model.CalculateAge:
+		return []convert.Overload[evalUnarySignature]{
+			{
+				Operands: []types.IType{types.Date},
+				Result:   i.evalCalculateAgeDate,
+			},
+			{
+				Operands: []types.IType{types.DateTime},
+				Result:   i.evalCalculateAgeDateTime,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported Unary Expression %v", m.GetName())
+	}
+}
+
+// TODO(b/312172420): Move BinaryOverloads and UnaryOverloads to their own files.
+func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Overload[evalBinarySignature], error) {
+	switch m.(type) {
+	case *model.Add, *model.Subtract:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Integer, types.Integer},
+				Result:   evalArithmeticInteger,
+			},
+			{
+				Operands: []types.IType{types.Long, types.Long},
+				Result:   evalArithmeticLong,
+			},
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   evalArithmeticDecimal,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalArithmeticQuantity,
+			},
+			{
+				Operands: []types.IType{types.Date, types.Quantity},
+				Result:   evalArithmeticDate,
+			},
+			{
+				Operands: []types.IType{types.DateTime, types.Quantity},
+				Result:   evalArithmeticDateTime,
+			},
+			{
+				Operands: []types.IType{types.Time, types.Quantity},
+				Result:   evalArithmeticTime,
+			},
+		}, nil
+	case *model.Multiply, *model.TruncatedDivide:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Integer, types.Integer},
+				Result:   evalArithmeticInteger,
+			},
+			{
+				Operands: []types.IType{types.Long, types.Long},
+				Result:   evalArithmeticLong,
+			},
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   evalArithmeticDecimal,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalArithmeticQuantity,
+			},
+		}, nil
+	case *model.Modulo:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Integer, types.Integer},
+				Result:   evalArithmeticInteger,
+			},
+			{
+				Operands: []types.IType{types.Long, types.Long},
+				Result:   evalArithmeticLong,
+			},
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   i.evalRoundedArithmeticDecimal,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   i.evalRoundedArithmeticQuantity,
+			},
+		}, nil
+	case *model.Log:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   evalLog,
+			},
+		}, nil
+	case *model.Power:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Integer, types.Integer},
+				Result:   evalPower,
+			},
+			{
+				Operands: []types.IType{types.Long, types.Long},
+				Result:   evalPower,
+			},
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   evalPower,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalPower,
+			},
+		}, nil
+	case *model.Divide:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   i.evalRoundedArithmeticDecimal,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   i.evalRoundedArithmeticQuantity,
+			},
+		}, nil
+	case *model.XOr:
+		return []convert.Overload[evalBinarySignature]{
 			{
 				Operands: []types.IType{types.Boolean, types.Boolean},
 				Result:   evalLogic,
@@ -673,6 +1070,18 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.Any, types.Any},
 				Result:   i.evalEqual,
 			},
+			{
+				Operands: []types.IType{types.Integer, types.Integer},
+				Result:   evalEqualInteger,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalEqualQuantity,
+			},
+			{
+				Operands: []types.IType{types.Ratio, types.Ratio},
+				Result:   evalEqualRatio,
+			},
 			{
 				Operands: []types.IType{types.DateTime, types.DateTime},
 				Result:   evalEqualDateTime,
@@ -681,6 +1090,18 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.Date, types.Date},
 				Result:   evalEqualDateTime,
 			},
+			{
+				Operands: []types.IType{types.Time, types.Time},
+				Result:   evalEqualDateTime,
+			},
+			{
+				Operands: []types.IType{types.Code, types.Code},
+				Result:   evalEqualCode,
+			},
+			{
+				Operands: []types.IType{types.Concept, types.Concept},
+				Result:   evalEqualConcept,
+			},
 		}, nil
 	case *model.Equivalent:
 		// TODO(b/301606416): Expand equivalent support to all types.
@@ -702,6 +1123,18 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.String, types.String},
 				Result:   evalEquivalentString,
 			},
+			{
+				Operands: []types.IType{types.Decimal, types.Decimal},
+				Result:   evalEquivalentDecimal,
+			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalEquivalentQuantity,
+			},
+			{
+				Operands: []types.IType{types.Ratio, types.Ratio},
+				Result:   evalEquivalentRatio,
+			},
 			{
 				Operands: []types.IType{types.DateTime, types.DateTime},
 				Result:   evalEquivalentDateTime,
@@ -710,6 +1143,10 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.Date, types.Date},
 				Result:   evalEquivalentDateTime,
 			},
+			{
+				Operands: []types.IType{types.Time, types.Time},
+				Result:   evalEquivalentDateTime,
+			},
 			// The parser will make sure the List<T>, List<T> have correctly matching or converted T.
 			{
 				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
@@ -724,6 +1161,10 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.Concept, types.Code},
 				Result:   i.evalEquivalentConceptCode,
 			},
+			{
+				Operands: []types.IType{types.Concept, types.Concept},
+				Result:   i.evalEquivalentConceptConcept,
+			},
 			{
 				Operands: []types.IType{types.Code, types.Code},
 				Result:   i.evalEquivalentCodeCode,
@@ -747,6 +1188,10 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.String, types.String},
 				Result:   evalCompareString,
 			},
+			{
+				Operands: []types.IType{types.Quantity, types.Quantity},
+				Result:   evalCompareQuantity,
+			},
 			{
 				Operands: []types.IType{types.Date, types.Date},
 				Result:   evalCompareDateTime,
@@ -755,6 +1200,10 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.DateTime, types.DateTime},
 				Result:   evalCompareDateTime,
 			},
+			{
+				Operands: []types.IType{types.Time, types.Time},
+				Result:   evalCompareDateTime,
+			},
 		}, nil
 	case *model.After, *model.Before, *model.SameOrAfter, *model.SameOrBefore:
 		return []convert.Overload[evalBinarySignature]{
@@ -766,32 +1215,210 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.DateTime, types.DateTime},
 				Result:   evalCompareDateTimeWithPrecision,
 			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{types.Integer, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{types.Long, &types.Interval{PointType: types.Long}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{types.Decimal, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{types.Quantity, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalCompareIntervals,
+			},
 			{
 				Operands: []types.IType{types.Date, &types.Interval{PointType: types.Date}},
-				Result:   i.evalCompareDateTimeInterval,
+				Result:   i.evalCompareIntervals,
 			},
 			{
 				Operands: []types.IType{types.DateTime, &types.Interval{PointType: types.DateTime}},
-				Result:   i.evalCompareDateTimeInterval,
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{types.Time, &types.Interval{PointType: types.Time}},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, types.Integer},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, types.Long},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, types.Decimal},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, types.Quantity},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, types.Date},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, types.DateTime},
+				Result:   i.evalCompareIntervals,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, types.Time},
+				Result:   i.evalCompareIntervals,
+			},
+		}, nil
+	case *model.Overlaps, *model.OverlapsBefore, *model.OverlapsAfter:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalOverlaps,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalOverlaps,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalOverlaps,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalOverlaps,
 			},
 			{
 				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
-				Result:   i.evalCompareIntervalDateTimeInterval,
+				Result:   i.evalOverlaps,
 			},
 			{
 				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
-				Result:   i.evalCompareIntervalDateTimeInterval,
+				Result:   i.evalOverlaps,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalOverlaps,
 			},
 		}, nil
-	case *model.Overlaps:
+	case *model.Meets, *model.MeetsBefore, *model.MeetsAfter:
 		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalMeets,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalMeets,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalMeets,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalMeets,
+			},
 			{
 				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
-				Result:   i.evalOverlapsIntervalDateTimeInterval,
+				Result:   i.evalMeets,
 			},
 			{
 				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
-				Result:   i.evalOverlapsIntervalDateTimeInterval,
+				Result:   i.evalMeets,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalMeets,
+			},
+		}, nil
+	case *model.Starts, *model.Ends:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Integer, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Long, &types.Interval{PointType: types.Long}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Decimal, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Quantity, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Date, &types.Interval{PointType: types.Date}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.DateTime, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalStartsEnds,
+			},
+			{
+				Operands: []types.IType{types.Time, &types.Interval{PointType: types.Time}},
+				Result:   i.evalStartsEnds,
 			},
 		}, nil
 	case *model.CanConvertQuantity:
@@ -811,13 +1438,36 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Operands: []types.IType{types.DateTime, types.DateTime},
 				Result:   evalDifferenceBetweenDateTime,
 			},
+			{
+				Operands: []types.IType{types.Time, types.Time},
+				Result:   evalDifferenceBetweenTime,
+			},
+		}, nil
+	case *model.DurationBetween:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.Date, types.Date},
+				Result:   evalDurationBetweenDate,
+			},
+			{
+				Operands: []types.IType{types.DateTime, types.DateTime},
+				Result:   evalDurationBetweenDateTime,
+			},
+			{
+				Operands: []types.IType{types.Time, types.Time},
+				Result:   evalDurationBetweenTime,
+			},
 		}, nil
 	case *model.In:
 		// TODO(b/301606416): Support all other In operator overloads.
 		return []convert.Overload[evalBinarySignature]{
 			{
 				Operands: []types.IType{types.Any, &types.List{ElementType: types.Any}},
-				Result:   evalInList,
+				Result:   i.evalInList,
+			},
+			{
+				Operands: []types.IType{types.Code, types.Concept},
+				Result:   evalInCodeConcept,
 			},
 			{
 				Operands: []types.IType{types.Decimal, &types.Interval{PointType: types.Decimal}},
@@ -882,6 +1532,28 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Result:   i.evalInValueSet,
 			},
 		}, nil
+	case *model.AnyInCodeSystem:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Code}, types.CodeSystem},
+				Result:   i.evalInCodeSystem,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Concept}, types.CodeSystem},
+				Result:   i.evalInCodeSystem,
+			},
+		}, nil
+	case *model.AnyInValueSet:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Code}, types.ValueSet},
+				Result:   i.evalInValueSet,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Concept}, types.ValueSet},
+				Result:   i.evalInValueSet,
+			},
+		}, nil
 	case *model.CalculateAgeAt:
 		return []convert.Overload[evalBinarySignature]{
 			{
@@ -900,6 +1572,271 @@ func (i *interpreter) binaryOverloads(m model.IBinaryExpression) ([]convert.Over
 				Result:   i.evalSplit,
 			},
 		}, nil
+	case *model.Matches:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalMatches,
+			},
+		}, nil
+	case *model.PositionOf:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalPositionOf,
+			},
+		}, nil
+	case *model.Collapse:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Integer}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Long}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Date}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Time}}, types.Quantity},
+				Result:   i.evalCollapse,
+			},
+		}, nil
+	case *model.Expand:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Integer}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Long}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Date}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+			{
+				Operands: []types.IType{&types.List{ElementType: &types.Interval{PointType: types.Time}}, types.Quantity},
+				Result:   i.evalExpand,
+			},
+		}, nil
+	case *model.Except:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
+				Result:   i.evalExcept,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalExceptInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalExceptInterval,
+			},
+		}, nil
+	case *model.Includes, *model.IncludedIn:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
+				Result:   i.evalIncludesList,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalIncludesInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalIncludesInterval,
+			},
+		}, nil
+	case *model.ProperIncludes, *model.ProperIncludedIn:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
+				Result:   i.evalIncludesList,
+			},
+		}, nil
+	case *model.IndexOf:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, types.Any},
+				Result:   i.evalIndexOf,
+			},
+		}, nil
+	case *model.Intersect:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
+				Result:   i.evalIntersect,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalIntersectInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalIntersectInterval,
+			},
+		}, nil
+	case *model.Skip:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, types.Integer},
+				Result:   evalSkip,
+			},
+		}, nil
+	case *model.Take:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, types.Integer},
+				Result:   evalTake,
+			},
+		}, nil
+	case *model.Union:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
+				Result:   i.evalUnion,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				Result:   i.evalUnionInterval,
+			},
+			{
+				Operands: []types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				Result:   i.evalUnionInterval,
+			},
+		}, nil
+	case *model.LastPositionOf:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalLastPositionOf,
+			},
+		}, nil
+	case *model.StartsWith:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalStartsWith,
+			},
+		}, nil
+	case *model.EndsWith:
+		return []convert.Overload[evalBinarySignature]{
+			{
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalEndsWith,
+			},
+		}, nil
 	case *model.Indexer:
 		return []convert.Overload[evalBinarySignature]{
 			{
@@ -1008,34 +1945,36 @@ func (i *interpreter) naryOverloads(m model.INaryExpression) ([]convert.Overload
 				Result:   i.evalToday,
 			},
 		}, nil
-	case *model.Coalesce:
+	case *model.Concatenate:
 		return []convert.Overload[evalNarySignature]{
 			{
-				Operands: []types.IType{types.Any, types.Any},
-				Result:   evalCoalesce,
-			},
-			{
-				Operands: []types.IType{types.Any, types.Any, types.Any},
-				Result:   evalCoalesce,
+				Operands: []types.IType{types.String, types.String},
+				Result:   evalConcatenate,
 			},
+		}, nil
+	case *model.ReplaceMatches:
+		return []convert.Overload[evalNarySignature]{
 			{
-				Operands: []types.IType{types.Any, types.Any, types.Any, types.Any},
-				Result:   evalCoalesce,
+				Operands: []types.IType{types.String, types.String, types.String},
+				Result:   evalReplaceMatches,
 			},
+		}, nil
+	case *model.Slice:
+		return []convert.Overload[evalNarySignature]{
 			{
-				Operands: []types.IType{types.Any, types.Any, types.Any, types.Any, types.Any},
-				Result:   evalCoalesce,
-			},
-			{
-				Operands: []types.IType{&types.List{ElementType: types.Any}},
-				Result:   evalCoalesceList,
+				Operands: []types.IType{&types.List{ElementType: types.Any}, types.Integer, types.Integer},
+				Result:   evalSlice,
 			},
 		}, nil
-	case *model.Concatenate:
+	case *model.Substring:
 		return []convert.Overload[evalNarySignature]{
 			{
-				Operands: []types.IType{types.String, types.String},
-				Result:   evalConcatenate,
+				Operands: []types.IType{types.String, types.Integer},
+				Result:   evalSubstring,
+			},
+			{
+				Operands: []types.IType{types.String, types.Integer, types.Integer},
+				Result:   evalSubstring,
 			},
 		}, nil
 	case *model.Combine:
@@ -1049,6 +1988,17 @@ func (i *interpreter) naryOverloads(m model.INaryExpression) ([]convert.Overload
 				Result:   i.evalCombine,
 			},
 		}, nil
+	case *model.Round:
+		return []convert.Overload[evalNarySignature]{
+			{
+				Operands: []types.IType{types.Decimal},
+				Result:   i.evalRound,
+			},
+			{
+				Operands: []types.IType{types.Decimal, types.Integer},
+				Result:   i.evalRound,
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported Nary Expression %v", m.GetName())
 	}
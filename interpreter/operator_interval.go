@@ -15,7 +15,12 @@
 package interpreter
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/cql/model"
@@ -107,258 +112,1198 @@ func startAndEnd(intervalObj result.Value, evaluationTimestamp *time.Time) (resu
 	return start, end, nil
 }
 
-// op(left DateTime, right Interval<DateTime>) Boolean
-// op(left Date, right Interval<Date>) Boolean
-// https://cql.hl7.org/09-b-cqlreference.html#after-1
-// https://cql.hl7.org/09-b-cqlreference.html#before-1
-// https://cql.hl7.org/09-b-cqlreference.html#on-or-after-2
-// https://cql.hl7.org/09-b-cqlreference.html#on-or-before-2
-// TODO(b/308016038): Once Start and End are properly supported, handle low/high inclusivity.
-func (i *interpreter) evalCompareDateTimeInterval(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
-	if result.IsNull(lObj) || result.IsNull(rObj) {
+// point from(argument Interval<T>) T
+// https://cql.hl7.org/09-b-cqlreference.html#point-from
+func (i *interpreter) evalPointFrom(m model.IUnaryExpression, intervalObj result.Value) (result.Value, error) {
+	if result.IsNull(intervalObj) {
 		return result.New(nil)
 	}
-	l, err := result.ToDateTime(lObj)
+	start, end, err := startAndEnd(intervalObj, &i.evaluationTimestamp)
 	if err != nil {
 		return result.Value{}, err
 	}
-
-	p, err := precisionFromBinaryExpression(be)
+	cmp, err := comparePoints(start, end, model.UNSETDATETIMEPRECISION)
 	if err != nil {
 		return result.Value{}, err
 	}
+	switch cmp {
+	case leftEqualRight:
+		return start, nil
+	case comparedToNull:
+		// The interval has an unknown boundary, so it is unknown whether it is a unit interval.
+		return result.New(nil)
+	default:
+		return result.Value{}, fmt.Errorf("point from requires a unit interval, got start %v and end %v", start.GolangValue(), end.GolangValue())
+	}
+}
 
-	allowUnsetPrec := true
-	if err := validatePrecisionByType(p, allowUnsetPrec, be.Left().GetResultType()); err != nil {
+// width of(argument Interval<T>) T
+// https://cql.hl7.org/09-b-cqlreference.html#width
+func (i *interpreter) evalWidth(m model.IUnaryExpression, intervalObj result.Value) (result.Value, error) {
+	return width(m, intervalObj, false, &i.evaluationTimestamp)
+}
+
+// Size(argument Interval<T>) T
+// https://cql.hl7.org/09-b-cqlreference.html#size
+func (i *interpreter) evalSize(m model.IUnaryExpression, intervalObj result.Value) (result.Value, error) {
+	return width(m, intervalObj, true, &i.evaluationTimestamp)
+}
+
+// width returns the difference between the end and start of the interval. If withPointSize is
+// true the size of a single point is added, so that Size(Interval[1, 1]) is 1. The width of a
+// Date, DateTime or Time interval is a Quantity in the precision of its boundaries. Null and
+// unbounded intervals return null. Integer and Long widths outside of the range of the point type
+// return an OverflowError.
+func width(m model.IUnaryExpression, intervalObj result.Value, withPointSize bool, evaluationTimestamp *time.Time) (result.Value, error) {
+	if result.IsNull(intervalObj) {
+		return result.New(nil)
+	}
+	interval, err := result.ToInterval(intervalObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if result.IsNull(interval.Low) || result.IsNull(interval.High) {
+		return result.New(nil)
+	}
+	s, e, err := startAndEnd(intervalObj, evaluationTimestamp)
+	if err != nil {
 		return result.Value{}, err
 	}
 
-	switch be.(type) {
-	case *model.After:
-		e, err := end(rObj, &i.evaluationTimestamp)
+	switch sv := s.GolangValue().(type) {
+	case int32:
+		ev, err := result.ToInt32(e)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rHigh, err := result.ToDateTime(e)
+		w := int64(ev) - int64(sv)
+		if withPointSize {
+			w++
+		}
+		if w < math.MinInt32 || w > math.MaxInt32 {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Integer}
+		}
+		return result.New(int32(w))
+	case int64:
+		ev, err := result.ToInt64(e)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return afterDateTimeWithPrecision(l, rHigh, p)
-	case *model.Before:
-		s, err := start(rObj, &i.evaluationTimestamp)
+		w := new(big.Int).Sub(big.NewInt(ev), big.NewInt(sv))
+		if withPointSize {
+			w.Add(w, big.NewInt(1))
+		}
+		if !w.IsInt64() {
+			return result.Value{}, result.OverflowError{Operator: m.GetName(), Type: types.Long}
+		}
+		return result.New(w.Int64())
+	case float64:
+		ev, err := result.ToFloat64(e)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rLow, err := result.ToDateTime(s)
+		if withPointSize {
+			return result.New(ev - sv + 0.00000001)
+		}
+		return result.New(ev - sv)
+	case result.Quantity:
+		eq, err := result.ToQuantity(e)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return beforeDateTimeWithPrecision(l, rLow, p)
-	case *model.SameOrAfter:
-		e, err := end(rObj, &i.evaluationTimestamp)
+		if eq.Unit != sv.Unit {
+			eq, err = convertQuantity(eq, sv.Unit)
+			if err != nil {
+				return result.Value{}, err
+			}
+		}
+		w := eq.Value - sv.Value
+		if withPointSize {
+			w += 0.00000001
+		}
+		return result.New(result.Quantity{Value: w, Unit: sv.Unit})
+	case result.Date, result.DateTime, result.Time:
+		sdt, err := result.ToDateTime(s)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rHigh, err := result.ToDateTime(e)
+		edt, err := result.ToDateTime(e)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return afterOrEqualDateTimeWithPrecision(l, rHigh, p)
-	case *model.SameOrBefore:
-		s, err := start(rObj, &i.evaluationTimestamp)
+		// The width is computed in the coarser precision of the two boundaries.
+		p := edt.Precision
+		if precisionGreaterOrEqual(sdt.Precision, edt.Precision) {
+			p = sdt.Precision
+		}
+		diff, err := dateTimeDifference(sdt, edt, p)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rLow, err := result.ToDateTime(s)
+		w, err := result.ToInt32(diff)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return beforeOrEqualDateTimeWithPrecision(l, rLow, p)
+		if withPointSize {
+			w++
+		}
+		return result.New(result.Quantity{Value: float64(w), Unit: model.Unit(p)})
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported point type %v for width", s.RuntimeType())
 	}
-	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Comparison Expression %v", be)
 }
 
-// op(left Interval<DateTime>, right Interval<DateTime>) Boolean
-// op(left Interval<Date>, right Interval<Date>) Boolean
+// op(left Interval<T>, right Interval<T>) Boolean
+// op(left T, right Interval<T>) Boolean
+// op(left Interval<T>, right T) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#after-1
 // https://cql.hl7.org/09-b-cqlreference.html#before-1
 // https://cql.hl7.org/09-b-cqlreference.html#on-or-after-2
 // https://cql.hl7.org/09-b-cqlreference.html#on-or-before-2
-func (i *interpreter) evalCompareIntervalDateTimeInterval(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+// A point operand is treated as an interval that starts and ends at the point.
+func (i *interpreter) evalCompareIntervals(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
 	if result.IsNull(lObj) || result.IsNull(rObj) {
 		return result.New(nil)
 	}
-	p, err := precisionFromBinaryExpression(be)
+	p, err := i.intervalOperatorPrecision(be)
 	if err != nil {
 		return result.Value{}, err
 	}
-
-	iType, ok := be.Left().GetResultType().(*types.Interval)
-	if !ok {
-		return result.Value{}, fmt.Errorf("internal error - evalCompareIntervalDateTimeInterval got Value that is not an interval type")
+	lStart, lEnd, err := i.boundaries(lObj)
+	if err != nil {
+		return result.Value{}, err
 	}
-	pointType := iType.PointType
-	allowUnsetPrec := true
-	if err := validatePrecisionByType(p, allowUnsetPrec, pointType); err != nil {
+	rStart, rEnd, err := i.boundaries(rObj)
+	if err != nil {
 		return result.Value{}, err
 	}
 
 	switch be.(type) {
 	case *model.After:
-		// lObj starts after the rObj ends
-		lObjStart, err := start(lObj, &i.evaluationTimestamp)
+		// lObj starts after rObj ends.
+		return compareBoundaries(lStart, rEnd, p, leftAfterRight)
+	case *model.Before:
+		// lObj ends before rObj starts.
+		return compareBoundaries(lEnd, rStart, p, leftBeforeRight)
+	case *model.SameOrAfter:
+		// lObj starts on or after rObj ends.
+		return compareBoundaries(lStart, rEnd, p, leftAfterRight, leftEqualRight)
+	case *model.SameOrBefore:
+		// lObj ends on or before rObj starts.
+		return compareBoundaries(lEnd, rStart, p, leftBeforeRight, leftEqualRight)
+	}
+	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Comparison Expression in evalCompareIntervals: %v", be)
+}
+
+// overlaps(left Interval<T>, right Interval<T>) Boolean
+// overlaps before(left Interval<T>, right Interval<T>) Boolean
+// overlaps after(left Interval<T>, right Interval<T>) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#overlaps
+// https://cql.hl7.org/09-b-cqlreference.html#overlaps-before
+// https://cql.hl7.org/09-b-cqlreference.html#overlaps-after
+// A closed null boundary is unbounded, and is treated as the minimum or maximum value of the point
+// type. An open null boundary is unknown, so the result is null unless it can be determined from the
+// known boundaries. For example Interval[null, 5] overlaps before Interval[3, 10] is true, while
+// Interval(null, 5] overlaps before Interval[3, 10] is null.
+func (i *interpreter) evalOverlaps(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	p, err := i.intervalOperatorPrecision(be)
+	if err != nil {
+		return result.Value{}, err
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	overlaps, err := overlapBoundaries(lStart, lEnd, rStart, rEnd, p)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	var extra *bool
+	switch be.(type) {
+	case *model.Overlaps:
+		return overlaps, nil
+	case *model.OverlapsBefore:
+		// Left additionally starts before right starts.
+		extra, err = boundaryComparison(lStart, rStart, p, leftBeforeRight)
+	case *model.OverlapsAfter:
+		// Left additionally ends after right ends.
+		extra, err = boundaryComparison(lEnd, rEnd, p, leftAfterRight)
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported Binary Expression in evalOverlaps: %v", be)
+	}
+	if err != nil {
+		return result.Value{}, err
+	}
+	return and(toNullableBool(overlaps), extra)
+}
+
+// overlapBoundaries returns whether the interval from lStart to lEnd overlaps the interval from
+// rStart to rEnd, which is the case if left starts on or before right ends, and left ends on or
+// after right starts. Unbounded boundaries are already the minimum or maximum value of the point
+// type, so only unknown boundaries are null.
+func overlapBoundaries(lStart, lEnd, rStart, rEnd result.Value, p model.DateTimePrecision) (result.Value, error) {
+	startsBeforeEnd, err := startsOnOrBeforeEnd(lStart, lEnd, rStart, rEnd, p)
+	if err != nil {
+		return result.Value{}, err
+	}
+	endsAfterStart, err := startsOnOrBeforeEnd(rStart, rEnd, lStart, lEnd, p)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return and(startsBeforeEnd, endsAfterStart)
+}
+
+// startsOnOrBeforeEnd returns whether the interval from lStart to lEnd starts on or before the end of
+// the interval from rStart to rEnd. An unknown boundary is still within its own interval, so an
+// unknown lStart is on or before lEnd, and an unknown rEnd is on or after rStart. The result is only
+// null if it cannot be determined from the known boundaries.
+func startsOnOrBeforeEnd(lStart, lEnd, rStart, rEnd result.Value, p model.DateTimePrecision) (*bool, error) {
+	b, err := boundaryComparison(lStart, rEnd, p, leftBeforeRight, leftEqualRight)
+	if err != nil {
+		return nil, err
+	}
+	if b != nil {
+		return b, nil
+	}
+	// Left starts on or before some point that is known to be on or before the end of right.
+	candidates := [][2]result.Value{{lStart, rStart}, {lEnd, rEnd}, {lEnd, rStart}}
+	for _, c := range candidates {
+		before, err := boundaryComparison(c[0], c[1], p, leftBeforeRight, leftEqualRight)
 		if err != nil {
-			return result.Value{}, err
+			return nil, err
 		}
-		rObjEnd, err := end(rObj, &i.evaluationTimestamp)
+		if before != nil && *before {
+			return before, nil
+		}
+	}
+	return nil, nil
+}
+
+// starts(left Interval<T>, right Interval<T>) Boolean
+// ends(left Interval<T>, right Interval<T>) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#starts
+// https://cql.hl7.org/09-b-cqlreference.html#ends
+// Left starts right if both start at the same point and left ends on or before right ends. Left ends
+// right if both end at the same point and left starts on or after right starts. Open boundaries are
+// compared by their start and end points, so Interval(1, 5] starts Interval[2, 10]. A point left
+// operand is treated as a unit interval, so 5 starts Interval[5, 10] is true.
+func (i *interpreter) evalStartsEnds(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	p, err := i.intervalOperatorPrecision(be)
+	if err != nil {
+		return result.Value{}, err
+	}
+	lStart, lEnd := lObj, lObj
+	if _, ok := lObj.GolangValue().(result.Interval); ok {
+		lStart, lEnd, err = startAndEnd(lObj, &i.evaluationTimestamp)
 		if err != nil {
 			return result.Value{}, err
 		}
-		lStart, rEnd, err := applyToValues(lObjStart, rObjEnd, result.ToDateTime)
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	var same, within *bool
+	switch be.(type) {
+	case *model.Starts:
+		if same, err = boundaryComparison(lStart, rStart, p, leftEqualRight); err != nil {
+			return result.Value{}, err
+		}
+		within, err = boundaryComparison(lEnd, rEnd, p, leftBeforeRight, leftEqualRight)
+	case *model.Ends:
+		if same, err = boundaryComparison(lEnd, rEnd, p, leftEqualRight); err != nil {
+			return result.Value{}, err
+		}
+		within, err = boundaryComparison(lStart, rStart, p, leftAfterRight, leftEqualRight)
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported Binary Expression in evalStartsEnds: %v", be)
+	}
+	if err != nil {
+		return result.Value{}, err
+	}
+	return and(same, within)
+}
+
+// meets(left Interval<T>, right Interval<T>) Boolean
+// meets before(left Interval<T>, right Interval<T>) Boolean
+// meets after(left Interval<T>, right Interval<T>) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#meets
+// https://cql.hl7.org/09-b-cqlreference.html#meets-before
+// https://cql.hl7.org/09-b-cqlreference.html#meets-after
+func (i *interpreter) evalMeets(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	p, err := i.intervalOperatorPrecision(be)
+	if err != nil {
+		return result.Value{}, err
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	switch be.(type) {
+	case *model.MeetsBefore:
+		return i.meetsBefore(lStart, lEnd, rStart, rEnd, p)
+	case *model.MeetsAfter:
+		return i.meetsBefore(rStart, rEnd, lStart, lEnd, p)
+	case *model.Meets:
+		before, err := i.meetsBefore(lStart, lEnd, rStart, rEnd, p)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return afterDateTimeWithPrecision(lStart, rEnd, p)
-	case *model.Before:
-		// lObj ends before rObj ond one starts
-		lObjEnd, err := end(lObj, &i.evaluationTimestamp)
+		after, err := i.meetsBefore(rStart, rEnd, lStart, lEnd, p)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rObjStart, err := start(rObj, &i.evaluationTimestamp)
+		return or(toNullableBool(before), toNullableBool(after))
+	}
+	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Expression in evalMeets: %v", be)
+}
+
+// includes(left Interval<T>, right Interval<T>) Boolean
+// included in(left Interval<T>, right Interval<T>) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#includes
+// https://cql.hl7.org/09-b-cqlreference.html#included-in
+// The point overloads of these operators are macros for In, and are forwarded to
+// evalInIntervalNumeral and evalInIntervalDateTime.
+func (i *interpreter) evalIncludesInterval(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	p, err := i.intervalOperatorPrecision(be)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if _, ok := be.(*model.IncludedIn); ok {
+		// IncludedIn is Includes with the operands reversed.
+		lObj, rObj = rObj, lObj
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	// Left includes right if left starts on or before right starts, and left ends on or after right
+	// ends.
+	startsOnOrBefore, err := boundaryComparison(lStart, rStart, p, leftBeforeRight, leftEqualRight)
+	if err != nil {
+		return result.Value{}, err
+	}
+	endsOnOrAfter, err := boundaryComparison(lEnd, rEnd, p, leftAfterRight, leftEqualRight)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return and(startsOnOrBefore, endsOnOrAfter)
+}
+
+// meetsBefore returns whether interval a ends immediately before interval b starts, meaning the
+// successor of the end of a is the start of b. If a precision is set the successor is computed at
+// that precision.
+func (i *interpreter) meetsBefore(aStart, aEnd, bStart, bEnd result.Value, p model.DateTimePrecision) (result.Value, error) {
+	if result.IsNull(aEnd) || result.IsNull(bStart) {
+		// Even with an unknown boundary a can not end right before b starts if a starts on or after
+		// b ends.
+		startsAfterEnd, err := boundaryComparison(aStart, bEnd, p, leftAfterRight, leftEqualRight)
 		if err != nil {
 			return result.Value{}, err
 		}
-		lEnd, rStart, err := applyToValues(lObjEnd, rObjStart, result.ToDateTime)
+		if startsAfterEnd != nil && *startsAfterEnd {
+			return result.New(false)
+		}
+		return result.New(nil)
+	}
+	maxVal, err := maxValue(aEnd.RuntimeType(), &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if aEnd.Equal(maxVal) {
+		// Nothing can start after the maximum value.
+		return result.New(false)
+	}
+
+	var next result.Value
+	if p == model.UNSETDATETIMEPRECISION {
+		next, err = successor(aEnd, &i.evaluationTimestamp)
+	} else {
+		next, err = dateTimeSuccessorWithPrecision(aEnd, p)
+	}
+	if err != nil {
+		return result.Value{}, err
+	}
+	return compareBoundaries(next, bStart, p, leftEqualRight)
+}
+
+// dateTimeSuccessorWithPrecision returns the Date, DateTime or Time one unit of the given
+// precision after dt.
+func dateTimeSuccessorWithPrecision(dt result.Value, p model.DateTimePrecision) (result.Value, error) {
+	return addToPoint(dt, result.Quantity{Value: 1, Unit: model.Unit(p)})
+}
+
+// addToPoint returns the interval point plus the Quantity q. For Integer, Long and Decimal points
+// q must be unitless, while for Date, DateTime and Time points q must have a temporal unit. Returns
+// a result.OverflowError if the sum of an Integer or Long point does not fit in its type.
+func addToPoint(point result.Value, q result.Quantity) (result.Value, error) {
+	switch v := point.GolangValue().(type) {
+	case int32, int64, float64:
+		if q.Unit != model.ONEUNIT && q.Unit != model.UNSETUNIT {
+			return result.Value{}, fmt.Errorf("can not add a Quantity with unit %v to a %v", q.Unit, point.RuntimeType())
+		}
+		switch v := v.(type) {
+		case int32:
+			if q.Value != math.Trunc(q.Value) {
+				return result.Value{}, fmt.Errorf("can not add a non integer Quantity %v to an Integer", q.Value)
+			}
+			sum := float64(v) + q.Value
+			if sum < math.MinInt32 || sum > math.MaxInt32 {
+				return result.Value{}, result.OverflowError{Operator: "Add", Type: types.Integer}
+			}
+			return result.New(int32(sum))
+		case int64:
+			if q.Value != math.Trunc(q.Value) {
+				return result.Value{}, fmt.Errorf("can not add a non integer Quantity %v to a Long", q.Value)
+			}
+			// Quantity values are float64, so Long sums are checked with integer arithmetic.
+			if q.Value >= math.MaxInt64 || q.Value < math.MinInt64 {
+				return result.Value{}, result.OverflowError{Operator: "Add", Type: types.Long}
+			}
+			add := int64(q.Value)
+			if (add > 0 && v > math.MaxInt64-add) || (add < 0 && v < math.MinInt64-add) {
+				return result.Value{}, result.OverflowError{Operator: "Add", Type: types.Long}
+			}
+			return result.New(v + add)
+		default:
+			return result.New(v.(float64) + q.Value)
+		}
+	case result.Quantity:
+		if q.Unit != v.Unit {
+			var err error
+			q, err = convertQuantity(q, v.Unit)
+			if err != nil {
+				return result.Value{}, err
+			}
+		}
+		return result.New(result.Quantity{Value: v.Value + q.Value, Unit: v.Unit})
+	case result.Date, result.DateTime, result.Time:
+		d, err := result.ToDateTime(point)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return beforeDateTimeWithPrecision(lEnd, rStart, p)
-	case *model.SameOrAfter:
-		// lObj starts on or after the rObj ends
-		lObjStart, err := start(lObj, &i.evaluationTimestamp)
+		next, err := arithmeticDateTime(&model.Add{}, d, q)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rObjEnd, err := end(rObj, &i.evaluationTimestamp)
+		return newDateTimeLike(point, next)
+	}
+	return result.Value{}, fmt.Errorf("internal error - unsupported point type %v in addToPoint", point.RuntimeType())
+}
+
+// newDateTimeLike returns d as a Date, DateTime or Time Value, matching the type of like.
+func newDateTimeLike(like result.Value, d result.DateTime) (result.Value, error) {
+	switch like.GolangValue().(type) {
+	case result.Date:
+		return result.New(result.Date(d))
+	case result.Time:
+		return result.New(result.Time(d))
+	default:
+		return result.New(d)
+	}
+}
+
+// intersect(left Interval<T>, right Interval<T>) Interval<T>
+// https://cql.hl7.org/09-b-cqlreference.html#intersect-1
+func (i *interpreter) evalIntersectInterval(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	overlaps, err := overlapBoundaries(lStart, lEnd, rStart, rEnd, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if b := toNullableBool(overlaps); b == nil || !*b {
+		return result.New(nil)
+	}
+
+	// The intersection starts at the later low boundary and ends at the earlier high boundary.
+	return i.combineIntervals(m, lObj, rObj, leftAfterRight, leftBeforeRight)
+}
+
+// union(left Interval<T>, right Interval<T>) Interval<T>
+// https://cql.hl7.org/09-b-cqlreference.html#union-1
+func (i *interpreter) evalUnionInterval(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	// The union is only an interval if the intervals overlap or meet.
+	overlaps, err := overlapBoundaries(lStart, lEnd, rStart, rEnd, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return result.Value{}, err
+	}
+	before, err := i.meetsBefore(lStart, lEnd, rStart, rEnd, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return result.Value{}, err
+	}
+	after, err := i.meetsBefore(rStart, rEnd, lStart, lEnd, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return result.Value{}, err
+	}
+	meets, err := or(toNullableBool(before), toNullableBool(after))
+	if err != nil {
+		return result.Value{}, err
+	}
+	overlapsOrMeets, err := or(toNullableBool(overlaps), toNullableBool(meets))
+	if err != nil {
+		return result.Value{}, err
+	}
+	if b := toNullableBool(overlapsOrMeets); b == nil || !*b {
+		return result.New(nil)
+	}
+
+	// The union starts at the earlier low boundary and ends at the later high boundary.
+	return i.combineIntervals(m, lObj, rObj, leftBeforeRight, leftAfterRight)
+}
+
+// except(left Interval<T>, right Interval<T>) Interval<T>
+// https://cql.hl7.org/09-b-cqlreference.html#except-1
+// Returns null if the right interval is properly included in the left interval, as the result would
+// not be a single interval.
+func (i *interpreter) evalExceptInterval(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	lStart, lEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	rStart, rEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	if err != nil {
+		return result.Value{}, err
+	}
+	overlaps, err := overlapBoundaries(lStart, lEnd, rStart, rEnd, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return result.Value{}, err
+	}
+	o := toNullableBool(overlaps)
+	if o == nil {
+		return result.New(nil)
+	}
+	if !*o {
+		return lObj, nil
+	}
+
+	startsOnOrBefore, err := boundaryComparison(rStart, lStart, model.UNSETDATETIMEPRECISION, leftBeforeRight, leftEqualRight)
+	if err != nil {
+		return result.Value{}, err
+	}
+	endsOnOrAfter, err := boundaryComparison(rEnd, lEnd, model.UNSETDATETIMEPRECISION, leftAfterRight, leftEqualRight)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if startsOnOrBefore == nil || endsOnOrAfter == nil || *startsOnOrBefore == *endsOnOrAfter {
+		// Either right includes left and nothing is left over, or right is properly included in left
+		// and the result would be split into two intervals.
+		return result.New(nil)
+	}
+
+	l, err := result.ToInterval(lObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if *startsOnOrBefore {
+		// Right overlaps the start of left, so the result starts right after right ends.
+		low, err := successor(rEnd, &i.evaluationTimestamp)
 		if err != nil {
 			return result.Value{}, err
 		}
-		lStart, rEnd, err := applyToValues(lObjStart, rObjEnd, result.ToDateTime)
+		l.Low, l.LowInclusive = low, true
+	} else {
+		// Right overlaps the end of left, so the result ends right before right starts.
+		high, err := predecessor(rStart, &i.evaluationTimestamp)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return afterOrEqualDateTimeWithPrecision(lStart, rEnd, p)
-	case *model.SameOrBefore:
-		// lObj ends on or before rObj one starts
-		lObjEnd, err := end(lObj, &i.evaluationTimestamp)
+		l.High, l.HighInclusive = high, true
+	}
+	return result.NewWithSources(l, m, lObj, rObj)
+}
+
+// collapse(argument List<Interval<T>>, per Quantity) List<Interval<T>>
+// https://cql.hl7.org/09-b-cqlreference.html#collapse
+// Intervals that overlap or meet are merged. If per is set, intervals are also merged if the gap
+// between them is smaller than per.
+func (i *interpreter) evalCollapse(m model.IBinaryExpression, listObj, perObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	intervals, err := i.sortedIntervals(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if len(intervals) == 0 {
+		return newListResult(m, nil)
+	}
+
+	var collapsed []result.Value
+	cur := intervals[0]
+	for _, next := range intervals[1:] {
+		merge, err := i.collapsible(cur, next, perObj)
 		if err != nil {
 			return result.Value{}, err
 		}
-		rObjStart, err := start(rObj, &i.evaluationTimestamp)
+		if !merge {
+			collapsed = append(collapsed, cur.obj)
+			cur = next
+			continue
+		}
+		combined, err := i.combineIntervals(m, cur.obj, next.obj, leftBeforeRight, leftAfterRight)
 		if err != nil {
 			return result.Value{}, err
 		}
-		lEnd, rStart, err := applyToValues(lObjEnd, rObjStart, result.ToDateTime)
+		cur, err = i.newBoundedInterval(combined)
 		if err != nil {
 			return result.Value{}, err
 		}
-		return beforeOrEqualDateTimeWithPrecision(lEnd, rStart, p)
 	}
-	return result.Value{}, fmt.Errorf("internal error - unsupported Binary Comparison Expression in evalCompareIntervalDateTimeInterval: %v", be)
+	collapsed = append(collapsed, cur.obj)
+	return newListResult(m, collapsed)
 }
 
-// Overlaps(left Interval<Date>, right Interval<Date>) Boolean
-// Overlaps(left Interval<DateTime>, right Interval<DateTime>) Boolean
-// https://cql.hl7.org/09-b-cqlreference.html#overlaps
-func (i *interpreter) evalOverlapsIntervalDateTimeInterval(be model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
-	if result.IsNull(lObj) || result.IsNull(rObj) {
+// collapsible returns whether next, which does not start before cur, should be merged with cur.
+func (i *interpreter) collapsible(cur, next boundedInterval, perObj result.Value) (bool, error) {
+	overlaps, err := overlapBoundaries(cur.start, cur.end, next.start, next.end, model.UNSETDATETIMEPRECISION)
+	if err != nil {
+		return false, err
+	}
+	if b := toNullableBool(overlaps); b != nil && *b {
+		return true, nil
+	}
+
+	if result.IsNull(perObj) {
+		meets, err := i.meetsBefore(cur.start, cur.end, next.start, next.end, model.UNSETDATETIMEPRECISION)
+		if err != nil {
+			return false, err
+		}
+		b := toNullableBool(meets)
+		return b != nil && *b, nil
+	}
+
+	if result.IsNull(cur.end) {
+		return false, nil
+	}
+	per, err := result.ToQuantity(perObj)
+	if err != nil {
+		return false, err
+	}
+	limit, err := addToPoint(cur.end, per)
+	if errors.As(err, &result.OverflowError{}) {
+		// The gap allowed after cur extends past the maximum value, so next starts within it.
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	b, err := boundaryComparison(next.start, limit, model.UNSETDATETIMEPRECISION, leftBeforeRight, leftEqualRight)
+	if err != nil {
+		return false, err
+	}
+	return b != nil && *b, nil
+}
+
+// expand(argument List<Interval<T>>, per Quantity) List<Interval<T>>
+// https://cql.hl7.org/09-b-cqlreference.html#expand
+// Each interval is split into consecutive intervals of size per, keeping only those that end within
+// the interval. If per is not set, each interval is split into unit intervals of its points, or
+// per 1 for Decimal intervals. Date, DateTime and Time boundaries more precise than per are truncated
+// to the precision of per.
+func (i *interpreter) evalExpand(m model.IBinaryExpression, listObj, perObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
 		return result.New(nil)
 	}
-	p, err := precisionFromBinaryExpression(be)
+	list, err := result.ToSlice(listObj)
 	if err != nil {
 		return result.Value{}, err
 	}
+	var per *result.Quantity
+	if !result.IsNull(perObj) {
+		q, err := result.ToQuantity(perObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if q.Value <= 0 {
+			return result.Value{}, fmt.Errorf("expand per must be positive, got %v", q.Value)
+		}
+		per = &q
+	}
 
-	iType, ok := be.Left().GetResultType().(*types.Interval)
-	if !ok {
-		return result.Value{}, fmt.Errorf("internal error - evalCompareIntervalDateTimeInterval got Value that is not an interval type")
+	var expanded []result.Value
+	for _, obj := range list {
+		if result.IsNull(obj) {
+			continue
+		}
+		interval, err := result.ToInterval(obj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if result.IsNull(interval.Low) || result.IsNull(interval.High) {
+			// Intervals with unknown or unbounded boundaries can not be expanded.
+			return result.New(nil)
+		}
+		s, e, err := startAndEnd(obj, &i.evaluationTimestamp)
+		if err != nil {
+			return result.Value{}, err
+		}
+		points, err := i.expandInterval(s, e, per, interval.StaticType)
+		if err != nil {
+			return result.Value{}, err
+		}
+		expanded = append(expanded, points...)
 	}
-	pointType := iType.PointType
-	allowUnsetPrec := true
-	if err := validatePrecisionByType(p, allowUnsetPrec, pointType); err != nil {
+	return newListResult(m, expanded)
+}
+
+// expandInterval splits the interval from start to end into closed intervals of size per, or unit
+// intervals if per is nil. Decimals have no unit interval, so they are split per 1 if per is nil.
+func (i *interpreter) expandInterval(start, end result.Value, per *result.Quantity, staticType *types.Interval) ([]result.Value, error) {
+	if _, ok := start.GolangValue().(float64); ok && per == nil {
+		per = &result.Quantity{Value: 1, Unit: model.ONEUNIT}
+	}
+	if per != nil {
+		// Per units more precise than the points yield no intervals.
+		finer, err := finerThanPoint(start, per.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if finer {
+			return nil, nil
+		}
+		if start, err = truncateToUnit(start, per.Unit); err != nil {
+			return nil, err
+		}
+		if end, err = truncateToUnit(end, per.Unit); err != nil {
+			return nil, err
+		}
+	}
+
+	var expanded []result.Value
+	for low := start; ; {
+		var high, next result.Value
+		var err error
+		if per == nil {
+			high = low
+		} else {
+			next, err = addToPoint(low, *per)
+			switch {
+			case errors.As(err, &result.OverflowError{}):
+				// Only Integer and Long points overflow. The start of the next interval is past the
+				// maximum value, but this interval may still end on it, in which case it is the last.
+				high, err = addToPoint(low, result.Quantity{Value: per.Value - 1, Unit: per.Unit})
+				if errors.As(err, &result.OverflowError{}) {
+					return expanded, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+			case err != nil:
+				return nil, err
+			default:
+				if high, err = predecessor(next, &i.evaluationTimestamp); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// Only intervals that end within the interval being expanded are included.
+		c, err := comparePoints(high, end, model.UNSETDATETIMEPRECISION)
+		if err != nil {
+			return nil, err
+		}
+		if c != leftBeforeRight && c != leftEqualRight {
+			return expanded, nil
+		}
+		interval, err := result.New(result.Interval{
+			Low:           low,
+			High:          high,
+			LowInclusive:  true,
+			HighInclusive: true,
+			StaticType:    staticType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, interval)
+		if c == leftEqualRight {
+			// Stop before taking the successor of end, which may be the maximum value.
+			return expanded, nil
+		}
+
+		if per == nil {
+			if next, err = successor(low, &i.evaluationTimestamp); err != nil {
+				return nil, err
+			}
+		}
+		low = next
+	}
+}
+
+// boundedInterval is an interval with its start and end.
+type boundedInterval struct {
+	obj, start, end result.Value
+}
+
+// newBoundedInterval computes the start and end of the interval obj.
+func (i *interpreter) newBoundedInterval(obj result.Value) (boundedInterval, error) {
+	s, e, err := startAndEnd(obj, &i.evaluationTimestamp)
+	if err != nil {
+		return boundedInterval{}, err
+	}
+	return boundedInterval{obj: obj, start: s, end: e}, nil
+}
+
+// sortedIntervals returns the non null intervals of the list sorted by their start.
+func (i *interpreter) sortedIntervals(listObj result.Value) ([]boundedInterval, error) {
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return nil, err
+	}
+	var intervals []boundedInterval
+	for _, obj := range list {
+		if result.IsNull(obj) {
+			continue
+		}
+		b, err := i.newBoundedInterval(obj)
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, b)
+	}
+
+	var sortErr error
+	slices.SortStableFunc(intervals, func(a, b boundedInterval) int {
+		c, err := comparePoints(a.start, b.start, model.UNSETDATETIMEPRECISION)
+		if err != nil {
+			sortErr = err
+		}
+		switch c {
+		case leftBeforeRight:
+			return -1
+		case leftAfterRight:
+			return 1
+		}
+		return 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+	return intervals, nil
+}
+
+// truncateToUnit truncates a Date, DateTime or Time point to the precision of the temporal unit if
+// it is more precise than the unit. Other points are returned unchanged.
+func truncateToUnit(point result.Value, unit model.Unit) (result.Value, error) {
+	switch point.GolangValue().(type) {
+	case result.Date, result.DateTime, result.Time:
+	default:
+		return point, nil
+	}
+	d, err := result.ToDateTime(point)
+	if err != nil {
 		return result.Value{}, err
 	}
-	if p != "" {
-		return result.Value{}, fmt.Errorf("internal error - overlaps does not yet support precision")
+	p := model.DateTimePrecision(unit)
+	if !slices.Contains(orderedPrecisions, p) || p == model.WEEK || precisionGreaterOrEqual(d.Precision, p) {
+		return point, nil
+	}
+	return newDateTimeLike(point, result.DateTime{Date: truncateTime(d.Date, p), Precision: p})
+}
+
+// finerThanPoint returns true if the date or time point is less precise than the unit.
+func finerThanPoint(point result.Value, unit model.Unit) (bool, error) {
+	switch point.GolangValue().(type) {
+	case result.Date, result.DateTime, result.Time:
+	default:
+		return false, nil
+	}
+	d, err := result.ToDateTime(point)
+	if err != nil {
+		return false, err
 	}
+	p := model.DateTimePrecision(unit)
+	if !slices.Contains(orderedPrecisions, p) || p == model.WEEK {
+		return false, nil
+	}
+	return d.Precision != p && precisionGreaterOrEqual(d.Precision, p), nil
+}
+
+// intervalBoundary is the low or high boundary of an interval. value is the boundary as it appears
+// in the interval, while point is the value used to compare boundaries, where a null inclusive
+// boundary is the minimum or maximum value of the point type.
+type intervalBoundary struct {
+	value     result.Value
+	point     result.Value
+	inclusive bool
+}
 
-	// Get left interval bounds.
-	lObjStart, lObjEnd, err := startAndEnd(lObj, &i.evaluationTimestamp)
+// combineIntervals returns the interval whose low boundary is the lowWant of the two low
+// boundaries and whose high boundary is the highWant of the two high boundaries. For example, the
+// intersection of two intervals takes the later low boundary and the earlier high boundary. The
+// openness of the chosen boundaries is preserved.
+func (i *interpreter) combineIntervals(m model.IBinaryExpression, lObj, rObj result.Value, lowWant, highWant comparison) (result.Value, error) {
+	l, err := result.ToInterval(lObj)
 	if err != nil {
 		return result.Value{}, err
 	}
-	leftStart, leftEnd, err := applyToValues(lObjStart, lObjEnd, result.ToDateTime)
+	r, err := result.ToInterval(rObj)
 	if err != nil {
 		return result.Value{}, err
 	}
-
-	// Get right interval bounds.
-	rObjStart, rObjEnd, err := startAndEnd(rObj, &i.evaluationTimestamp)
+	lLow, lHigh, err := i.intervalBoundaries(lObj, l)
 	if err != nil {
 		return result.Value{}, err
 	}
-	rightStart, rightEnd, err := applyToValues(rObjStart, rObjEnd, result.ToDateTime)
+	rLow, rHigh, err := i.intervalBoundaries(rObj, r)
 	if err != nil {
 		return result.Value{}, err
 	}
 
-	// Due to complexity regarding DateTime precision, we will calculate each case separately and
-	// return the OR of all results. If any of the cases are true, then the result is true, if any
-	// of the cases are null, then the result is null, otherwise the result is false.
-	compResults := []result.Value{}
-	// Case 1. Left starts during right interval.
-	leftStartsDuringRightIntervalValue, err := dateTimeInIntervalWithPrecision(leftStart, rightStart, rightEnd, p)
+	// When two boundaries are equal, an intersection is only inclusive if both boundaries are,
+	// while a union is inclusive if either boundary is.
+	_, intersect := m.(*model.Intersect)
+	low, err := pickBoundary(lLow, rLow, lowWant, intersect)
 	if err != nil {
 		return result.Value{}, err
 	}
-	compResults = append(compResults, leftStartsDuringRightIntervalValue)
-
-	// Case 2. Left ends during right interval.
-	leftEndsDuringRightIntervalValue, err := dateTimeInIntervalWithPrecision(leftEnd, rightStart, rightEnd, p)
+	high, err := pickBoundary(lHigh, rHigh, highWant, intersect)
 	if err != nil {
 		return result.Value{}, err
 	}
-	compResults = append(compResults, leftEndsDuringRightIntervalValue)
+	return result.NewWithSources(result.Interval{
+		Low:           low.value,
+		High:          high.value,
+		LowInclusive:  low.inclusive,
+		HighInclusive: high.inclusive,
+		StaticType:    l.StaticType,
+	}, m, lObj, rObj)
+}
+
+// intervalBoundaries returns the low and high boundaries of the interval.
+func (i *interpreter) intervalBoundaries(obj result.Value, interval result.Interval) (intervalBoundary, intervalBoundary, error) {
+	low := intervalBoundary{value: interval.Low, point: interval.Low, inclusive: interval.LowInclusive}
+	high := intervalBoundary{value: interval.High, point: interval.High, inclusive: interval.HighInclusive}
+	if low.inclusive && result.IsNull(low.point) {
+		s, err := start(obj, &i.evaluationTimestamp)
+		if err != nil {
+			return intervalBoundary{}, intervalBoundary{}, err
+		}
+		low.point = s
+	}
+	if high.inclusive && result.IsNull(high.point) {
+		e, err := end(obj, &i.evaluationTimestamp)
+		if err != nil {
+			return intervalBoundary{}, intervalBoundary{}, err
+		}
+		high.point = e
+	}
+	return low, high, nil
+}
 
-	// Case 3. Right starts during left interval.
-	rightStartsDuringLeftIntervalValue, err := dateTimeInIntervalWithPrecision(rightStart, leftStart, leftEnd, p)
+// pickBoundary returns a if comparing a to b results in want, and otherwise b. If a and b are
+// equal the boundary is inclusive depending on bothInclusive. An unknown boundary is returned if
+// the boundaries can not be compared.
+func pickBoundary(a, b intervalBoundary, want comparison, bothInclusive bool) (intervalBoundary, error) {
+	c, err := comparePoints(a.point, b.point, model.UNSETDATETIMEPRECISION)
 	if err != nil {
-		return result.Value{}, err
+		return intervalBoundary{}, err
+	}
+	switch c {
+	case want:
+		return a, nil
+	case leftEqualRight:
+		if bothInclusive {
+			a.inclusive = a.inclusive && b.inclusive
+		} else {
+			a.inclusive = a.inclusive || b.inclusive
+		}
+		return a, nil
+	case comparedToNull, insufficientPrecision:
+		unknown, err := result.New(nil)
+		if err != nil {
+			return intervalBoundary{}, err
+		}
+		return intervalBoundary{value: unknown, point: unknown}, nil
 	}
-	compResults = append(compResults, rightStartsDuringLeftIntervalValue)
+	return b, nil
+}
 
-	// Case 4. Right ends during left interval.
-	rightEndsDuringLeftIntervalValue, err := dateTimeInIntervalWithPrecision(rightEnd, leftStart, leftEnd, p)
+// intervalOperatorPrecision returns the precision of an interval operator, and validates that it
+// can be applied to the point type of the operands.
+func (i *interpreter) intervalOperatorPrecision(be model.IBinaryExpression) (model.DateTimePrecision, error) {
+	p, err := precisionFromBinaryExpression(be)
 	if err != nil {
-		return result.Value{}, err
+		return model.UNSETDATETIMEPRECISION, err
+	}
+	pointType := be.Left().GetResultType()
+	if iType, ok := pointType.(*types.Interval); ok {
+		pointType = iType.PointType
+	}
+	switch pointType {
+	case types.Date, types.DateTime:
+		allowUnsetPrec := true
+		if err := validatePrecisionByType(p, allowUnsetPrec, pointType); err != nil {
+			return model.UNSETDATETIMEPRECISION, err
+		}
+	case types.Time:
+		if p != model.UNSETDATETIMEPRECISION {
+			if err := validatePrecision(p, []model.DateTimePrecision{model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND}); err != nil {
+				return model.UNSETDATETIMEPRECISION, err
+			}
+		}
+	default:
+		if p != model.UNSETDATETIMEPRECISION {
+			return model.UNSETDATETIMEPRECISION, fmt.Errorf("internal error - precision %v is not supported for point type %v", p, pointType)
+		}
+	}
+	return p, nil
+}
+
+// boundaries returns the start and end of obj if it is an interval. Otherwise obj is a point, which
+// is both its own start and end.
+func (i *interpreter) boundaries(obj result.Value) (result.Value, result.Value, error) {
+	if _, ok := obj.GolangValue().(result.Interval); ok {
+		return startAndEnd(obj, &i.evaluationTimestamp)
 	}
-	compResults = append(compResults, rightEndsDuringLeftIntervalValue)
+	return obj, obj, nil
+}
 
-	trueVal, err := result.New(true)
+// compareBoundaries returns true if comparing the interval boundary points l and r results in one
+// of the wanted comparisons. Null is returned if the comparison is indeterminate, for example if
+// one of the boundaries is null.
+func compareBoundaries(l, r result.Value, p model.DateTimePrecision, want ...comparison) (result.Value, error) {
+	b, err := boundaryComparison(l, r, p, want...)
 	if err != nil {
 		return result.Value{}, err
 	}
-	nullVal, err := result.New(nil)
+	if b == nil {
+		return result.New(nil)
+	}
+	return result.New(*b)
+}
+
+// boundaryComparison is like compareBoundaries, but returns a nil *bool if the comparison is
+// indeterminate so that the result can be combined with the three valued and/or helpers.
+func boundaryComparison(l, r result.Value, p model.DateTimePrecision, want ...comparison) (*bool, error) {
+	c, err := comparePoints(l, r, p)
 	if err != nil {
-		return result.Value{}, err
+		return nil, err
+	}
+	if c == comparedToNull || c == insufficientPrecision {
+		return nil, nil
+	}
+	b := slices.Contains(want, c)
+	return &b, nil
+}
+
+// comparePoints compares two interval points of the same type. Date, DateTime and Time points are
+// compared up to the precision p. comparedToNull is returned if either point is null.
+func comparePoints(l, r result.Value, p model.DateTimePrecision) (comparison, error) {
+	if result.IsNull(l) || result.IsNull(r) {
+		return comparedToNull, nil
+	}
+	switch lv := l.GolangValue().(type) {
+	case int32:
+		rv, err := result.ToInt32(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareNumeral(lv, rv), nil
+	case int64:
+		rv, err := result.ToInt64(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareNumeral(lv, rv), nil
+	case float64:
+		rv, err := result.ToFloat64(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareNumeral(lv, rv), nil
+	case string:
+		rv, err := result.ToString(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return toComparison(strings.Compare(lv, rv)), nil
+	case result.Quantity:
+		rv, err := result.ToQuantity(r)
+		if err != nil {
+			return unsetComparison, err
+		}
+		if rv.Unit != lv.Unit {
+			rv, err = convertQuantity(rv, lv.Unit)
+			if err != nil {
+				return unsetComparison, err
+			}
+		}
+		return compareNumeral(lv.Value, rv.Value), nil
+	case result.Date, result.DateTime, result.Time:
+		ldt, rdt, err := applyToValues(l, r, result.ToDateTime)
+		if err != nil {
+			return unsetComparison, err
+		}
+		return compareDateTimeWithPrecision(ldt, rdt, p)
+	}
+	return unsetComparison, fmt.Errorf("internal error - unsupported point type %v in comparePoints", l.RuntimeType())
+}
+
+// toNullableBool converts a Boolean result to a *bool, which is nil if the result is null.
+func toNullableBool(v result.Value) *bool {
+	if result.IsNull(v) {
+		return nil
 	}
-	if valueInList(trueVal, compResults) {
-		return trueVal, nil
-	} else if valueInList(nullVal, compResults) {
-		return nullVal, nil
+	b, ok := v.GolangValue().(bool)
+	if !ok {
+		return nil
 	}
-	return result.New(false)
+	return &b
 }
 
 // in _precision_ (point Decimal, argument Interval<Decimal>) Boolean
@@ -457,29 +1402,6 @@ func compareNumeral[t float64 | int64 | int32](left, right t) comparison {
 	return leftAfterRight
 }
 
-// duringDateTimeWithPrecision returns whether or not the given DateTimeValue is during the given
-// low high interval. Returns null in cases where values cannot be compared such as right precision being
-// less than left precision.
-// All values are expected to be inclusive bounds.
-// Return a null value if the comparison cannot be made due to insufficient precision.
-func dateTimeInIntervalWithPrecision(a, low, high result.DateTime, p model.DateTimePrecision) (result.Value, error) {
-	lowComp, err := compareDateTimeWithPrecision(a, low, p)
-	if err != nil {
-		return result.Value{}, err
-	}
-	highComp, err := compareDateTimeWithPrecision(a, high, p)
-	if err != nil {
-		return result.Value{}, err
-	}
-
-	if lowComp == insufficientPrecision || highComp == insufficientPrecision {
-		return result.New(nil)
-	} else if (lowComp == leftEqualRight || lowComp == leftAfterRight) && (highComp == leftEqualRight || highComp == leftBeforeRight) {
-		return result.New(true)
-	}
-	return result.New(false)
-}
-
 // in _precision_ (point DateTime, argument Interval<DateTime>) Boolean
 // in _precision_ (point Date, argument Interval<Date>) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#in
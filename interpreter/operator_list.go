@@ -16,15 +16,18 @@ package interpreter
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
+	"github.com/google/cql/types"
 )
 
 // LIST OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#list-operators-2
 
 // exists(argument List<T>) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#exists
+// Returns true if the list contains any non-null elements, and false for a null or empty list.
 func evalExists(m model.IUnaryExpression, listObj result.Value) (result.Value, error) {
 	if result.IsNull(listObj) {
 		return result.New(false)
@@ -34,22 +37,21 @@ func evalExists(m model.IUnaryExpression, listObj result.Value) (result.Value, e
 		return result.Value{}, err
 	}
 
-	if len(list) == 0 {
-		return result.New(false)
-	}
-
 	for _, elemObj := range list {
-		if result.IsNull(elemObj) {
-			return result.New(false)
+		if !result.IsNull(elemObj) {
+			return result.New(true)
 		}
 	}
-
-	return result.New(true)
+	return result.New(false)
 }
 
 // in(element T, argument List<T>) Boolean
 // https://cql.hl7.org/09-b-cqlreference.html#in-1
-func evalInList(m model.IBinaryExpression, lObj, listObj result.Value) (result.Value, error) {
+// contains(argument List<T>, element T) Boolean is converted to in by the parser.
+// A null element is in the list if the list contains a null, and a null list returns null. Elements
+// are compared with CQL equality, so if no element is equal but the equality of some element is
+// uncertain the result is null.
+func (i *interpreter) evalInList(m model.IBinaryExpression, lObj, listObj result.Value) (result.Value, error) {
 	if result.IsNull(listObj) {
 		return result.New(nil)
 	}
@@ -58,7 +60,34 @@ func evalInList(m model.IBinaryExpression, lObj, listObj result.Value) (result.V
 		return result.Value{}, err
 	}
 
-	return result.New(valueInList(lObj, r))
+	if result.IsNull(lObj) {
+		return result.New(slices.ContainsFunc(r, result.IsNull))
+	}
+	uncertain := false
+	for _, elemObj := range r {
+		if result.IsNull(elemObj) {
+			continue
+		}
+		eq, err := i.evalEqualValue(lObj, elemObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if result.IsNull(eq) {
+			uncertain = true
+			continue
+		}
+		isEqual, err := result.ToBool(eq)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if isEqual {
+			return result.New(true)
+		}
+	}
+	if uncertain {
+		return result.New(nil)
+	}
+	return result.New(false)
 }
 
 // First(argument List<T>) T
@@ -117,6 +146,416 @@ func evalSingletonFrom(m model.IUnaryExpression, listObj result.Value) (result.V
 	}
 }
 
+// ToList(argument T) List<T>
+// https://cql.hl7.org/04-logicalspecification.html#tolist
+// ToList promotes a single value to a list containing only that value. A null argument returns an
+// empty list.
+func evalToList(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	if result.IsNull(obj) {
+		return newListResult(m, nil)
+	}
+	return newListResult(m, []result.Value{obj})
+}
+
+// distinct(argument List<T>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#distinct
+// The first occurrence of each element is kept, so the result is in order of first appearance.
+// Elements are compared with CQL equality, so 1 'g' and 1000 'mg' are duplicates, except that nulls
+// are considered equal so multiple nulls collapse to a single null. Elements whose equality is
+// uncertain are both kept.
+func (i *interpreter) evalDistinct(m model.IUnaryExpression, listObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	distinct, err := i.distinctValues(list)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return newListResult(m, distinct)
+}
+
+// distinctValues returns the elements of list with duplicates removed, keeping the first
+// occurrence of each element. All nulls are considered equal to one another. Elements are compared
+// pairwise with CQL equality rather than hashed into a map, so the result order is deterministic.
+func (i *interpreter) distinctValues(list []result.Value) ([]result.Value, error) {
+	distinct := []result.Value{}
+	seenNull := false
+	for _, elem := range list {
+		if result.IsNull(elem) {
+			if !seenNull {
+				distinct = append(distinct, elem)
+				seenNull = true
+			}
+			continue
+		}
+		var err error
+		distinct, err = i.appendIfDistinct(distinct, elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return distinct, nil
+}
+
+// except(left List<T>, right List<T>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#except-1
+// If left is null the result is null, a null right is treated as an empty list. Elements are
+// compared as in distinct. The result is in order of first appearance in left.
+func (i *interpreter) evalExcept(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) {
+		return result.New(nil)
+	}
+	l, r, err := listOperands(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	distinct, err := i.distinctValues(l)
+	if err != nil {
+		return result.Value{}, err
+	}
+	var except []result.Value
+	for _, elem := range distinct {
+		in, err := i.valueInDistinctList(elem, r)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if !in {
+			except = append(except, elem)
+		}
+	}
+	return newListResult(m, except)
+}
+
+// IndexOf(argument List<T>, element T) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#indexof
+// Returns the 0-based index of the first element equal to element using CQL equality, or -1 if
+// there is none. A null element matches the first null in the list.
+func (i *interpreter) evalIndexOf(_ model.IBinaryExpression, listObj, elemObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	for idx, obj := range list {
+		eq, err := i.equalOrBothNull(elemObj, obj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if eq {
+			return result.New(int32(idx))
+		}
+	}
+	return result.New(int32(-1))
+}
+
+// included in(left List<T>, right List<T>) Boolean
+// includes(left List<T>, right List<T>) Boolean
+// properly included in(left List<T>, right List<T>) Boolean
+// properly includes(left List<T>, right List<T>) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#includes-1
+// The model determines which list must include the other, and whether the inclusion must be
+// proper, meaning the including list also has an element the included list does not. An empty list
+// is included in every list. If either argument is null the result is null.
+func (i *interpreter) evalIncludesList(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	var proper bool
+	switch m.(type) {
+	case *model.Includes:
+	case *model.IncludedIn:
+		lObj, rObj = rObj, lObj
+	case *model.ProperIncludes:
+		proper = true
+	case *model.ProperIncludedIn:
+		lObj, rObj = rObj, lObj
+		proper = true
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported list inclusion operator %v", m.GetName())
+	}
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := listOperands(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	includes, err := i.listIncludes(l, r)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !includes {
+		return result.New(false)
+	}
+	if proper {
+		includedIn, err := i.listIncludes(r, l)
+		if err != nil {
+			return result.Value{}, err
+		}
+		return result.New(!includedIn)
+	}
+	return result.New(true)
+}
+
+// listIncludes returns true if every element of sub is in list, where nulls are considered equal.
+func (i *interpreter) listIncludes(list, sub []result.Value) (bool, error) {
+	for _, elem := range sub {
+		in, err := i.valueInDistinctList(elem, list)
+		if err != nil || !in {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// intersect(left List<T>, right List<T>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#intersect-1
+// If either argument is null the result is null. Elements are compared as in distinct. The result
+// is in order of first appearance in left.
+func (i *interpreter) evalIntersect(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	if result.IsNull(lObj) || result.IsNull(rObj) {
+		return result.New(nil)
+	}
+	l, r, err := listOperands(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	distinct, err := i.distinctValues(l)
+	if err != nil {
+		return result.Value{}, err
+	}
+	var intersect []result.Value
+	for _, elem := range distinct {
+		in, err := i.valueInDistinctList(elem, r)
+		if err != nil {
+			return result.Value{}, err
+		}
+		if in {
+			intersect = append(intersect, elem)
+		}
+	}
+	return newListResult(m, intersect)
+}
+
+// Skip(argument List<T>, number Integer) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#skip
+// If number is null or less than or equal to zero the entire list is returned.
+func evalSkip(m model.IBinaryExpression, listObj, numObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if result.IsNull(numObj) {
+		return newListResult(m, list)
+	}
+	num, err := result.ToInt32(numObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	start := min(max(int(num), 0), len(list))
+	return newListResult(m, list[start:])
+}
+
+// Slice(source List<T>, startIndex Integer, endIndex Integer) List<T>
+// https://cql.hl7.org/04-logicalspecification.html#slice
+// Returns the elements from startIndex (inclusive) to endIndex (exclusive). Out of range indices are
+// clamped to the list, a null startIndex defaults to 0 and a null endIndex to the list length.
+func evalSlice(m model.INaryExpression, operands []result.Value) (result.Value, error) {
+	if len(operands) != 3 {
+		// Dispatcher and Parser should prevent this from happening.
+		return result.Value{}, fmt.Errorf("internal error - Slice must have three operands, got %d", len(operands))
+	}
+	if result.IsNull(operands[0]) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(operands[0])
+	if err != nil {
+		return result.Value{}, err
+	}
+	start, end := 0, len(list)
+	if !result.IsNull(operands[1]) {
+		s, err := result.ToInt32(operands[1])
+		if err != nil {
+			return result.Value{}, err
+		}
+		start = min(max(int(s), 0), len(list))
+	}
+	if !result.IsNull(operands[2]) {
+		e, err := result.ToInt32(operands[2])
+		if err != nil {
+			return result.Value{}, err
+		}
+		end = min(max(int(e), 0), len(list))
+	}
+	if start >= end {
+		return newListResult(m, []result.Value{})
+	}
+	return newListResult(m, list[start:end])
+}
+
+// Tail(argument List<T>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#tail
+func evalTail(m model.IUnaryExpression, listObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if len(list) == 0 {
+		return newListResult(m, list)
+	}
+	return newListResult(m, list[1:])
+}
+
+// Take(argument List<T>, number Integer) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#take
+// If number is null or less than or equal to zero an empty list is returned.
+func evalTake(m model.IBinaryExpression, listObj, numObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if result.IsNull(numObj) {
+		return newListResult(m, []result.Value{})
+	}
+	num, err := result.ToInt32(numObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	end := min(max(int(num), 0), len(list))
+	return newListResult(m, list[:end])
+}
+
+// union(left List<T>, right List<T>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#union-1
+// A null argument is treated as an empty list. Elements are compared as in distinct. The result is
+// in order of first appearance in left, followed by the elements only in right in order of first
+// appearance in right.
+func (i *interpreter) evalUnion(m model.IBinaryExpression, lObj, rObj result.Value) (result.Value, error) {
+	l, r, err := listOperands(lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	union, err := i.distinctValues(append(l, r...))
+	if err != nil {
+		return result.Value{}, err
+	}
+	return newListResult(m, union)
+}
+
+// listOperands converts the operands of a binary list operator to slices, treating null operands
+// as empty lists.
+func listOperands(lObj, rObj result.Value) ([]result.Value, []result.Value, error) {
+	var l, r []result.Value
+	var err error
+	if !result.IsNull(lObj) {
+		if l, err = result.ToSlice(lObj); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !result.IsNull(rObj) {
+		if r, err = result.ToSlice(rObj); err != nil {
+			return nil, nil, err
+		}
+	}
+	// Copy the left slice so that appending to it never modifies the operand.
+	return append([]result.Value{}, l...), r, nil
+}
+
+// newListResult returns a list Value of the elements, with the result type of m as the static type.
+func newListResult(m model.IExpression, elems []result.Value) (result.Value, error) {
+	staticType, ok := m.GetResultType().(*types.List)
+	if !ok {
+		return result.Value{}, fmt.Errorf("internal error - expected a List result type, got %v", m.GetResultType())
+	}
+	if elems == nil {
+		elems = []result.Value{}
+	}
+	return result.New(result.List{Value: elems, StaticType: staticType})
+}
+
+// valueInDistinctList returns true if the value is in the list using the same semantics as
+// distinct, where nulls are considered equal.
+func (i *interpreter) valueInDistinctList(value result.Value, list []result.Value) (bool, error) {
+	for _, elem := range list {
+		eq, err := i.equalOrBothNull(value, elem)
+		if err != nil {
+			return false, err
+		}
+		if eq {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// equalOrBothNull returns true if the values are equal or are both null. Values whose equality is
+// uncertain, where CQL equality returns null, are not considered equal.
+func (i *interpreter) equalOrBothNull(l, r result.Value) (bool, error) {
+	if result.IsNull(l) || result.IsNull(r) {
+		return result.IsNull(l) && result.IsNull(r), nil
+	}
+	eq, err := i.evalEqualValue(l, r)
+	if err != nil {
+		return false, err
+	}
+	if result.IsNull(eq) {
+		return false, nil
+	}
+	return result.ToBool(eq)
+}
+
+// flatten(argument List<List<T>>) List<T>
+// https://cql.hl7.org/09-b-cqlreference.html#flatten
+// Only one level is flattened. Null elements of the inner lists are kept, while null inner lists
+// contribute no elements.
+func evalFlatten(m model.IUnaryExpression, listObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(nil)
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	flattened := []result.Value{}
+	for _, innerObj := range list {
+		if result.IsNull(innerObj) {
+			continue
+		}
+		inner, err := result.ToSlice(innerObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		flattened = append(flattened, inner...)
+	}
+	return newListResult(m, flattened)
+}
+
+// Length(argument List<T>) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#length-1
+// Null elements are counted, and a null list has a length of 0.
+func evalLengthList(_ model.IUnaryExpression, listObj result.Value) (result.Value, error) {
+	if result.IsNull(listObj) {
+		return result.New(int32(0))
+	}
+	list, err := result.ToSlice(listObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(int32(len(list)))
+}
+
 // Indexer(argument List<T>, index Integer) T
 // [](argument List<T>, index Integer) T
 // https://cql.hl7.org/09-b-cqlreference.html#indexer-1
@@ -138,13 +577,3 @@ func (i *interpreter) evalIndexerList(m model.IBinaryExpression, lObj, rObj resu
 	}
 	return list[idx], nil
 }
-
-// valueInList returns true if the value is in the list using equality scemantics.
-func valueInList(value result.Value, list []result.Value) bool {
-	for _, elemObj := range list {
-		if value.Equal(elemObj) {
-			return true
-		}
-	}
-	return false
-}
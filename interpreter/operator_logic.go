@@ -23,6 +23,46 @@ import (
 
 // LOGICAL OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#logical-operators-3
 
+// evalShortCircuitLogic evaluates And, Or and Implies. The right operand is only evaluated if the
+// left operand does not already determine the result, for example false and X is always false.
+func (i *interpreter) evalShortCircuitLogic(m model.IBinaryExpression) (result.Value, error) {
+	lObj, err := i.evalExpression(m.Left())
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	if !result.IsNull(lObj) {
+		l, err := result.ToBool(lObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		switch m.(type) {
+		case *model.And:
+			if !l {
+				return result.NewWithSources(false, m, lObj)
+			}
+		case *model.Or:
+			if l {
+				return result.NewWithSources(true, m, lObj)
+			}
+		case *model.Implies:
+			if !l {
+				return result.NewWithSources(true, m, lObj)
+			}
+		}
+	}
+
+	rObj, err := i.evalExpression(m.Right())
+	if err != nil {
+		return result.Value{}, err
+	}
+	res, err := evalLogic(m, lObj, rObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return res.WithSources(m, lObj, rObj), nil
+}
+
 // and (left Boolean, right Boolean) Boolean
 // or (left Boolean, right Boolean) Boolean
 // xor (left Boolean, right Boolean) Boolean
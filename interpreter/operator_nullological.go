@@ -19,39 +19,61 @@ import (
 
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
+	"github.com/google/cql/types"
 )
 
 // Coalesce<T>(argument1 T, argument2 T) T
 // Coalesce<T>(argument1 T, argument2 T, argument3 T) T
 // Coalesce<T>(argument1 T, argument2 T, argument3 T, argument4 T) T
 // Coalesce<T>(argument1 T, argument2 T, argument3 T, argument4 T, argument5 T) T
+// Coalesce<T>(arguments List<T>) T
 // https://cql.hl7.org/09-b-cqlreference.html#coalesce
-func evalCoalesce(m model.INaryExpression, objs []result.Value) (result.Value, error) {
-	for _, obj := range objs {
+//
+// Operands are evaluated in order and evaluation stops at the first non null operand, so later
+// operands are never evaluated.
+func (i *interpreter) evalCoalesce(m *model.Coalesce) (result.Value, error) {
+	operands := m.GetOperands()
+	if len(operands) == 1 {
+		if _, ok := operands[0].GetResultType().(*types.List); !ok {
+			return result.Value{}, fmt.Errorf("internal error - Coalesce() overload with one operand should be of type list, got: %v", operands[0].GetResultType())
+		}
+		return i.evalCoalesceList(m, operands[0])
+	}
+
+	evalOps := make([]result.Value, 0, len(operands))
+	for _, operand := range operands {
+		obj, err := i.evalExpression(operand)
+		if err != nil {
+			return result.Value{}, err
+		}
+		evalOps = append(evalOps, obj)
 		if !result.IsNull(obj) {
-			return obj, nil
+			return obj.WithSources(m, evalOps...), nil
 		}
 	}
-	return result.New(nil)
+	return result.NewWithSources(nil, m, evalOps...)
 }
 
-// Coalesce<T>(arguments List<T>) T
-// https://cql.hl7.org/09-b-cqlreference.html#coalesce
-func evalCoalesceList(m model.INaryExpression, objs []result.Value) (result.Value, error) {
-	if len(objs) != 1 {
-		return result.Value{}, fmt.Errorf("coalesce list overload expected exactly 1 list argument, got: %v", len(objs))
+// evalCoalesceList returns the first non null element of the list operand.
+func (i *interpreter) evalCoalesceList(m *model.Coalesce, operand model.IExpression) (result.Value, error) {
+	listObj, err := i.evalExpression(operand)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if result.IsNull(listObj) {
+		return result.NewWithSources(nil, m, listObj)
 	}
-	list, err := result.ToSlice(objs[0])
+	list, err := result.ToSlice(listObj)
 	if err != nil {
 		return result.Value{}, fmt.Errorf("coalesce list overload unable to convert argument to List, err: %w", err)
 	}
 
 	for _, obj := range list {
 		if !result.IsNull(obj) {
-			return obj, nil
+			return obj.WithSources(m, listObj), nil
 		}
 	}
-	return result.New(nil)
+	return result.NewWithSources(nil, m, listObj)
 }
 
 // is null(argument Any) Boolean
@@ -70,7 +92,7 @@ func evalIsTrue(m model.IUnaryExpression, obj result.Value) (result.Value, error
 	if err != nil {
 		return result.Value{}, err
 	}
-	return result.New(objVal == true)
+	return result.New(objVal)
 }
 
 // is false(argument Boolean) Boolean
@@ -83,5 +105,5 @@ func evalIsFalse(m model.IUnaryExpression, obj result.Value) (result.Value, erro
 	if err != nil {
 		return result.Value{}, err
 	}
-	return result.New(objVal == false)
+	return result.New(!objVal)
 }
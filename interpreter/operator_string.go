@@ -16,8 +16,10 @@ package interpreter
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/google/cql/internal/datehelpers"
 	"github.com/google/cql/model"
@@ -87,7 +89,7 @@ func evalToString(_ model.IUnaryExpression, operand result.Value) (result.Value,
 		if err != nil {
 			return result.Value{}, err
 		}
-		return result.New(strconv.FormatFloat(d, 'f', -1, 64))
+		return result.New(decimalToString(d))
 	case types.Quantity:
 		q, err := result.ToQuantity(operand)
 		if err != nil {
@@ -133,6 +135,12 @@ func evalToString(_ model.IUnaryExpression, operand result.Value) (result.Value,
 		}
 		// Remove the leading 'T'
 		return result.New(s[1:])
+	case types.Code:
+		c, err := result.ToCode(operand)
+		if err != nil {
+			return result.Value{}, err
+		}
+		return result.New(codeToString(c))
 	default:
 		return result.Value{}, fmt.Errorf("unsupported operand type for ToString: %v", operand.RuntimeType())
 	}
@@ -153,6 +161,8 @@ func (i *interpreter) evalSplit(m model.IBinaryExpression, left, right result.Va
 		return result.Value{}, err
 	}
 
+	// The separator is matched literally, and consecutive, leading or trailing separators produce
+	// empty strings so that Combine(Split(s, sep), sep) returns s.
 	splits := strings.Split(s, sep)
 
 	l := result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.String}}
@@ -200,8 +210,8 @@ func (i *interpreter) evalCombine(m model.INaryExpression, operands []result.Val
 		}
 	}
 
-	resultBuilder := strings.Builder{}
-	for idx, str := range strList {
+	var strs []string
+	for _, str := range strList {
 		if result.IsNull(str) {
 			continue
 		}
@@ -209,14 +219,241 @@ func (i *interpreter) evalCombine(m model.INaryExpression, operands []result.Val
 		if err != nil {
 			return result.Value{}, err
 		}
-		resultBuilder.WriteString(s)
+		strs = append(strs, s)
+	}
+	// A list containing only nulls is treated the same as an empty list.
+	if len(strs) == 0 {
+		return result.New(nil)
+	}
+	return result.New(strings.Join(strs, sep))
+}
+
+// Substring(stringToSub String, startIndex Integer) String
+// Substring(stringToSub String, startIndex Integer, length Integer) String
+// https://cql.hl7.org/09-b-cqlreference.html#substring
+// A start index out of range or a negative length returns null. A length running past the end of
+// the string, or a null length, returns the rest of the string.
+func evalSubstring(_ model.INaryExpression, operands []result.Value) (result.Value, error) {
+	if len(operands) < 2 {
+		// Dispatcher and Parser should prevent this from happening.
+		return result.Value{}, fmt.Errorf("internal error - Substring must have at least two operands, got %d", len(operands))
+	}
+	if result.IsNull(operands[0]) || result.IsNull(operands[1]) {
+		return result.New(nil)
+	}
+	str, err := result.ToString(operands[0])
+	if err != nil {
+		return result.Value{}, err
+	}
+	start, err := result.ToInt32(operands[1])
+	if err != nil {
+		return result.Value{}, err
+	}
+	runes := []rune(str)
+	if start < 0 || int(start) >= len(runes) {
+		return result.New(nil)
+	}
+	end := len(runes)
+	if len(operands) == 3 && !result.IsNull(operands[2]) {
+		length, err := result.ToInt32(operands[2])
+		if err != nil {
+			return result.Value{}, err
+		}
+		if length < 0 {
+			return result.New(nil)
+		}
+		end = min(end, int(start)+int(length))
+	}
+	return result.New(string(runes[start:end]))
+}
+
+// Upper(argument String) String
+// https://cql.hl7.org/09-b-cqlreference.html#upper
+func evalUpper(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	return applyToString(obj, strings.ToUpper)
+}
+
+// Lower(argument String) String
+// https://cql.hl7.org/09-b-cqlreference.html#lower
+func evalLower(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	return applyToString(obj, strings.ToLower)
+}
+
+// applyToString applies fn to the String obj, propagating null.
+func applyToString(obj result.Value, fn func(string) string) (result.Value, error) {
+	if result.IsNull(obj) {
+		return result.New(nil)
+	}
+	s, err := result.ToString(obj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(fn(s))
+}
+
+// Length(argument String) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#length
+// Length is also defined for List<T>, see operator_list.go for that implementation.
+func evalLengthString(_ model.IUnaryExpression, obj result.Value) (result.Value, error) {
+	if result.IsNull(obj) {
+		return result.New(nil)
+	}
+	s, err := result.ToString(obj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(int32(utf8.RuneCountInString(s)))
+}
+
+// Matches(argument String, pattern String) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#matches
+// The pattern must match the entire argument.
+func evalMatches(m model.IBinaryExpression, argObj, patternObj result.Value) (result.Value, error) {
+	if result.IsNull(argObj) || result.IsNull(patternObj) {
+		return result.New(nil)
+	}
+	arg, pattern, err := applyToValues(argObj, patternObj, result.ToString)
+	if err != nil {
+		return result.Value{}, err
+	}
+	re, err := compileRegex(m.GetName(), pattern)
+	if err != nil {
+		return result.Value{}, err
+	}
+	// The whole argument must match. With leftmost-longest matching, the argument can be matched in
+	// full exactly when the first match spans all of it.
+	re.Longest()
+	loc := re.FindStringIndex(arg)
+	return result.New(loc != nil && loc[0] == 0 && loc[1] == len(arg))
+}
+
+// ReplaceMatches(argument String, pattern String, substitution String) String
+// https://cql.hl7.org/09-b-cqlreference.html#replacematches
+func evalReplaceMatches(m model.INaryExpression, operands []result.Value) (result.Value, error) {
+	if len(operands) != 3 {
+		// Dispatcher and Parser should prevent this from happening.
+		return result.Value{}, fmt.Errorf("internal error - ReplaceMatches must have three operands, got %d", len(operands))
+	}
+	strs := make([]string, 0, len(operands))
+	for _, operand := range operands {
+		if result.IsNull(operand) {
+			return result.New(nil)
+		}
+		s, err := result.ToString(operand)
+		if err != nil {
+			return result.Value{}, err
+		}
+		strs = append(strs, s)
+	}
+	re, err := compileRegex(m.GetName(), strs[1])
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(re.ReplaceAllString(strs[0], substitution(strs[2])))
+}
+
+// PositionOf(pattern String, argument String) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#positionof
+func evalPositionOf(_ model.IBinaryExpression, patternObj, argObj result.Value) (result.Value, error) {
+	return positionOf(patternObj, argObj, strings.Index)
+}
+
+// LastPositionOf(pattern String, argument String) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#lastpositionof
+func evalLastPositionOf(_ model.IBinaryExpression, patternObj, argObj result.Value) (result.Value, error) {
+	return positionOf(patternObj, argObj, strings.LastIndex)
+}
+
+// positionOf returns the character index of the pattern in the argument as found by the index
+// function, or -1 if the pattern is not found. An empty pattern is found at index 0.
+func positionOf(patternObj, argObj result.Value, index func(s, substr string) int) (result.Value, error) {
+	if result.IsNull(patternObj) || result.IsNull(argObj) {
+		return result.New(nil)
+	}
+	pattern, arg, err := applyToValues(patternObj, argObj, result.ToString)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if pattern == "" {
+		return result.New(int32(0))
+	}
+	idx := index(arg, pattern)
+	if idx == -1 {
+		return result.New(int32(-1))
+	}
+	// The strings package returns byte offsets, but CQL indexes characters.
+	return result.New(int32(utf8.RuneCountInString(arg[:idx])))
+}
 
-		// Write the separator unless we are on the last element.
-		if idx < len(strList)-1 {
-			resultBuilder.WriteString(sep)
+// StartsWith(argument String, prefix String) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#startswith
+func evalStartsWith(_ model.IBinaryExpression, argObj, prefixObj result.Value) (result.Value, error) {
+	if result.IsNull(argObj) || result.IsNull(prefixObj) {
+		return result.New(nil)
+	}
+	arg, prefix, err := applyToValues(argObj, prefixObj, result.ToString)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(strings.HasPrefix(arg, prefix))
+}
+
+// EndsWith(argument String, suffix String) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#endswith
+func evalEndsWith(_ model.IBinaryExpression, argObj, suffixObj result.Value) (result.Value, error) {
+	if result.IsNull(argObj) || result.IsNull(suffixObj) {
+		return result.New(nil)
+	}
+	arg, suffix, err := applyToValues(argObj, suffixObj, result.ToString)
+	if err != nil {
+		return result.Value{}, err
+	}
+	return result.New(strings.HasSuffix(arg, suffix))
+}
+
+// compileRegex compiles the pattern for the named operator, returning an error rather than
+// panicking if the pattern is invalid.
+func compileRegex(name, pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%v got an invalid regular expression: %w", name, err)
+	}
+	return re, nil
+}
+
+// substitution converts a CQL substitution string, which follows the Java conventions of $n for
+// capture groups and a backslash to escape the next character, into a Go regexp template.
+func substitution(sub string) string {
+	var b strings.Builder
+	runes := []rune(sub)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes):
+			i++
+			if runes[i] == '$' {
+				b.WriteString("$$")
+			} else {
+				b.WriteRune(runes[i])
+			}
+		case r == '$':
+			// Capture group references are wrapped in braces so that trailing characters are not read
+			// as part of the group name.
+			j := i + 1
+			for j < len(runes) && runes[j] >= '0' && runes[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				b.WriteString("$$")
+				continue
+			}
+			b.WriteString("${" + string(runes[i+1:j]) + "}")
+			i = j - 1
+		default:
+			b.WriteRune(r)
 		}
 	}
-	return result.New(resultBuilder.String())
+	return b.String()
 }
 
 // Indexer(argument String, index Integer) String
@@ -234,10 +471,12 @@ func (i *interpreter) evalIndexerString(m model.IBinaryExpression, lObj, rObj re
 	if err != nil {
 		return result.Value{}, err
 	}
-	if idx < 0 || idx >= int32(len(str)) {
+	// Strings are indexed by character, not by byte.
+	runes := []rune(str)
+	if idx < 0 || idx >= int32(len(runes)) {
 		return result.New(nil)
 	}
-	return result.New(string([]rune(str)[idx]))
+	return result.New(string(runes[idx]))
 }
 
 // convert a quantity value to a string
@@ -245,3 +484,32 @@ func quantityToString(q result.Quantity) string {
 	f := strconv.FormatFloat(q.Value, 'f', -1, 64)
 	return fmt.Sprintf("%s '%s'", f, q.Unit)
 }
+
+// decimalToString formats d with at least one and at most eight digits after the decimal point,
+// following the (+|-)?#0.0# format of https://cql.hl7.org/09-b-cqlreference.html#tostring.
+func decimalToString(d float64) string {
+	s := strings.TrimRight(strconv.FormatFloat(d, 'f', 8, 64), "0")
+	if strings.HasSuffix(s, ".") {
+		s += "0"
+	}
+	// Negative values that round to zero, and negative zero itself, are formatted without a sign.
+	if s == "-0.0" {
+		return "0.0"
+	}
+	return s
+}
+
+// codeToString formats c like a CQL Code selector, omitting any unset elements.
+func codeToString(c result.Code) string {
+	elems := []string{fmt.Sprintf("code: '%s'", c.Code)}
+	if c.System != "" {
+		elems = append(elems, fmt.Sprintf("system: '%s'", c.System))
+	}
+	if c.Version != "" {
+		elems = append(elems, fmt.Sprintf("version: '%s'", c.Version))
+	}
+	if c.Display != "" {
+		elems = append(elems, fmt.Sprintf("display: '%s'", c.Display))
+	}
+	return fmt.Sprintf("Code { %s }", strings.Join(elems, ", "))
+}
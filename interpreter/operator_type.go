@@ -16,9 +16,13 @@ package interpreter
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/google/cql/internal/convert"
+	"github.com/google/cql/internal/ucum"
 	"github.com/google/cql/model"
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
@@ -30,7 +34,11 @@ var longStringRegex = regexp.MustCompile(`(\+|\-)?\d+`)
 // The string should start with a decimal value that may have a prefix of + or -.
 // It optionally may also include a unit designation.
 // Currently assumes the that inner string has not been escaped.
-var quantityStringRegex = regexp.MustCompile(`^([\+|\-]?\d+(?:\.\d+)?){1}\s*('{1}[A-Za-z0-9-]+'{1})?$`)
+var quantityStringRegex = regexp.MustCompile(`^([\+|\-]?\d+(?:\.\d+)?){1}\s*('{1}[^']+'{1})?$`)
+
+// timeStringRegex matches an ISO-8601 time string such as T14:30:00.0+05:30, capturing the time
+// without the optional leading T and timezone offset.
+var timeStringRegex = regexp.MustCompile(`^T?([^TZ+\-]+)(Z|[\+\-]\d{2}:\d{2})?$`)
 
 // TYPE OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#type-operators-1
 
@@ -39,57 +47,101 @@ var quantityStringRegex = regexp.MustCompile(`^([\+|\-]?\d+(?:\.\d+)?){1}\s*('{1
 // https://cql.hl7.org/09-b-cqlreference.html#as
 func (i *interpreter) evalAs(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	a := m.(*model.As)
+	casted, ok, err := i.castAs(obj, a.AsTypeSpecifier)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if ok {
+		return casted, nil
+	}
 
+	if a.Strict {
+		return result.Value{}, fmt.Errorf("cannot strict cast type %v to type %v", obj.RuntimeType().String(), a.AsTypeSpecifier.String())
+	}
+	return result.New(nil)
+}
+
+// castAs casts obj to the type t. It returns false if the runtime type of obj is not t or a
+// subtype of t. Lists and Intervals are cast element by element, so List<Any>{1, 2} can be cast to
+// List<Integer> but List<Any>{1, 'a'} cannot.
+func (i *interpreter) castAs(obj result.Value, t types.IType) (result.Value, bool, error) {
 	// Null can be cast to anything https://cql.hl7.org/03-developersguide.html#implicit-casting
 	if result.IsNull(obj) {
-		return result.New(nil)
+		v, err := result.New(nil)
+		return v, err == nil, err
 	}
 
 	// This is a special case, anything can be cast to Any.
-	if a.AsTypeSpecifier.Equal(types.Any) {
-		return obj, nil
+	if t.Equal(types.Any) {
+		return obj, true, nil
+	}
+
+	switch ct := t.(type) {
+	case *types.List:
+		l, isList := obj.GolangValue().(result.List)
+		if !isList {
+			return result.Value{}, false, nil
+		}
+		vals := make([]result.Value, 0, len(l.Value))
+		for _, elem := range l.Value {
+			c, ok, err := i.castAs(elem, ct.ElementType)
+			if err != nil || !ok {
+				return result.Value{}, ok, err
+			}
+			vals = append(vals, c)
+		}
+		v, err := result.New(result.List{Value: vals, StaticType: ct})
+		return v, err == nil, err
+	case *types.Interval:
+		interval, isInterval := obj.GolangValue().(result.Interval)
+		if !isInterval {
+			return result.Value{}, false, nil
+		}
+		low, ok, err := i.castAs(interval.Low, ct.PointType)
+		if err != nil || !ok {
+			return result.Value{}, ok, err
+		}
+		high, ok, err := i.castAs(interval.High, ct.PointType)
+		if err != nil || !ok {
+			return result.Value{}, ok, err
+		}
+		v, err := result.New(result.Interval{
+			Low:           low,
+			High:          high,
+			LowInclusive:  interval.LowInclusive,
+			HighInclusive: interval.HighInclusive,
+			StaticType:    ct,
+		})
+		return v, err == nil, err
 	}
 
 	// At runtime a Choice<Integer, String> will be either Integer or String. So for Choice<Integer,
 	// String> As String if the runtime type is a String that will be handled here.
-	if obj.RuntimeType().Equal(a.AsTypeSpecifier) {
-		return obj, nil
+	if obj.RuntimeType().Equal(t) {
+		return obj, true, nil
 	}
-	isSub, err := i.modelInfo.IsSubType(a.AsTypeSpecifier, obj.RuntimeType())
+	isSub, err := i.modelInfo.IsSubType(obj.RuntimeType(), t)
 	if err != nil {
-		return result.Value{}, err
+		return result.Value{}, false, err
 	}
 	if isSub {
-		// TODO(b/301606416): The type should probably be changed to AsTypeSpecifier.
-		return obj, nil
+		// TODO(b/301606416): The type should probably be changed to the cast type.
+		return obj, true, nil
 	}
 
 	// This covers casts to choice types such as Decimal --> Choice<Decimal>. For cases that require a
 	// conversion such as Integer --> Choice<Decimal> the parser should have already inserted any
 	// necessary conversions so that obj is equal or a subtype of one of the choices
 	// As(ToDecimal(operand), Choice<Decimal>).
-	aChoice, ok := a.AsTypeSpecifier.(*types.Choice)
-	if ok {
-		for _, choice := range aChoice.ChoiceTypes {
-			if obj.RuntimeType().Equal(choice) {
-				return obj, nil
-			}
-
-			isSub, err := i.modelInfo.IsSubType(choice, obj.RuntimeType())
-			if err != nil {
-				return result.Value{}, err
-			}
-			if isSub {
-				return obj, nil
+	if choice, ok := t.(*types.Choice); ok {
+		for _, ct := range choice.ChoiceTypes {
+			c, ok, err := i.castAs(obj, ct)
+			if err != nil || ok {
+				return c, ok, err
 			}
 		}
 	}
-
-	if a.Strict {
-		return result.Value{}, fmt.Errorf("cannot strict cast type %v to type %v", obj.RuntimeType().String(), a.AsTypeSpecifier.String())
-	}
-
-	return result.New(nil)
+	return result.Value{}, false, nil
 }
 
 // is<T>(argument Any) Boolean
@@ -99,17 +151,17 @@ func (i *interpreter) evalAs(m model.IUnaryExpression, obj result.Value) (result
 func (i *interpreter) evalIs(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
 	isExpr := m.(*model.Is)
 
-	if obj.RuntimeType().Equal(isExpr.IsTypeSpecifier) {
-		return result.New(true)
+	// Unlike most operators Is returns false rather than null for a null argument.
+	if result.IsNull(obj) {
+		return result.New(false)
 	}
 
-	isSub, err := i.modelInfo.IsSubType(obj.RuntimeType(), isExpr.IsTypeSpecifier)
+	// obj is of type T exactly when it can be cast to T.
+	_, ok, err := i.castAs(obj, isExpr.IsTypeSpecifier)
 	if err != nil {
 		return result.Value{}, err
 	}
-	fmt.Println(isSub)
-
-	return result.New(isSub)
+	return result.New(ok)
 }
 
 // ToDate(argument DateTime) Date
@@ -147,20 +199,16 @@ func (i *interpreter) evalToDateString(m model.IUnaryExpression, opObj result.Va
 	}
 
 	obj, err := i.stringToDate(op, types.Date)
-	if err != nil {
-		return result.Value{}, err
-	}
-	if obj.RuntimeType() != types.Date {
-		return result.Value{}, fmt.Errorf("interal error - ToDateString, expected Date got: %v", obj.RuntimeType())
+	if err == nil {
+		return obj, nil
 	}
-
-	newDate := obj.GolangValue().(result.Date)
-	if newDate.Precision != model.DAY && newDate.Precision != model.MONTH && newDate.Precision != model.YEAR {
-		// Precision must be more precise than DAY, but we're converting to Date, so set the precision
-		// to DAY, which is the max precision for Dates.
-		newDate.Precision = model.DAY
+	// datetime formatted strings may also be parsed into a date.
+	dt, err := i.stringToDate(op, types.DateTime)
+	if err != nil {
+		// Strings that are not formatted as a date or datetime convert to null.
+		return result.New(nil)
 	}
-	return result.New(newDate)
+	return evalToDateDateTime(m, dt)
 }
 
 // ToDateTime(argument Date) DateTime
@@ -206,7 +254,8 @@ func (i *interpreter) evalToDateTimeString(m model.IUnaryExpression, opObj resul
 	// date formatted strings may also be parsed into a datetime.
 	d, err := i.stringToDate(op, types.Date)
 	if err != nil {
-		return result.Value{}, err
+		// Strings that are not formatted as a datetime or date convert to null.
+		return result.New(nil)
 	}
 	dtv, ok := d.GolangValue().(result.Date)
 	if !ok {
@@ -215,6 +264,38 @@ func (i *interpreter) evalToDateTimeString(m model.IUnaryExpression, opObj resul
 	return result.New(result.DateTime(dtv))
 }
 
+// ToTime(argument Time) Time
+// https://cql.hl7.org/09-b-cqlreference.html#totime
+func evalToTimeTime(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	return opObj, nil
+}
+
+// ToTime(argument String) Time
+// https://cql.hl7.org/09-b-cqlreference.html#totime
+//
+// Converts a ISO-8601 time formatted string such as hh:mm:ss.fff to a CQL Time. Strings that are
+// not formatted as a time convert to null.
+func (i *interpreter) evalToTimeString(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToString(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	// Times do not have a timezone offset, so any offset in the string is ignored.
+	matches := timeStringRegex.FindStringSubmatch(op)
+	if matches == nil {
+		return result.New(nil)
+	}
+	t, err := i.stringToDate(matches[1], types.Time)
+	if err != nil {
+		return result.New(nil)
+	}
+	return t, nil
+}
+
 // ToDecimal(argument Decimal) Decimal
 // ToDecimal(argument Long) Decimal
 // ToDecimal(argument Integer) Decimal
@@ -318,10 +399,10 @@ func evalToLongString(m model.IUnaryExpression, opObj result.Value) (result.Valu
 		return result.New(nil)
 	}
 
-	// ParseInt works for every string that meets the CQL spec.
 	f, err := strconv.ParseInt(op, 10, 64)
 	if err != nil {
-		return result.Value{}, err
+		// Strings that are out of range for a Long convert to null.
+		return result.New(nil)
 	}
 	return result.New(f)
 }
@@ -342,6 +423,130 @@ func evalToLongBoolean(m model.IUnaryExpression, opObj result.Value) (result.Val
 	return result.New(int64(0))
 }
 
+// ToInteger(argument Integer) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#tointeger
+func evalToIntegerInteger(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	return opObj, nil
+}
+
+// ToInteger(argument Long) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#tointeger
+//
+// Longs that are out of range for an Integer convert to null.
+func evalToIntegerLong(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToInt64(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if op > math.MaxInt32 || op < math.MinInt32 {
+		return result.New(nil)
+	}
+	return result.New(int32(op))
+}
+
+// ToInteger(argument String) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#tointeger
+func evalToIntegerString(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToString(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	// Check that the string meets the CQL integer spec requirements.
+	found := longStringRegex.FindString(op)
+	if found == "" || found != op {
+		return result.New(nil)
+	}
+
+	i, err := strconv.ParseInt(op, 10, 32)
+	if err != nil {
+		// Strings that are out of range for an Integer convert to null.
+		return result.New(nil)
+	}
+	return result.New(int32(i))
+}
+
+// ToInteger(argument Boolean) Integer
+// https://cql.hl7.org/09-b-cqlreference.html#tointeger
+func evalToIntegerBoolean(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToBool(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if op {
+		return result.New(int32(1))
+	}
+	return result.New(int32(0))
+}
+
+// ToBoolean(argument Boolean) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#toboolean
+func evalToBooleanBoolean(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	return opObj, nil
+}
+
+// ToBoolean(argument Decimal) Boolean
+// ToBoolean(argument Long) Boolean
+// ToBoolean(argument Integer) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#toboolean
+//
+// 1 converts to true and 0 converts to false, any other number converts to null.
+func evalToBooleanNumber(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	var op float64
+	switch v := opObj.GolangValue().(type) {
+	case int32:
+		op = float64(v)
+	case int64:
+		op = float64(v)
+	default:
+		f, err := result.ToFloat64(opObj)
+		if err != nil {
+			return result.Value{}, err
+		}
+		op = f
+	}
+	switch op {
+	case 1:
+		return result.New(true)
+	case 0:
+		return result.New(false)
+	}
+	return result.New(nil)
+}
+
+// ToBoolean(argument String) Boolean
+// https://cql.hl7.org/09-b-cqlreference.html#toboolean
+//
+// The string is matched case-insensitively, any string not listed in the spec converts to null.
+func evalToBooleanString(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToString(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+	switch strings.ToLower(op) {
+	case "true", "t", "yes", "y", "1":
+		return result.New(true)
+	case "false", "f", "no", "n", "0":
+		return result.New(false)
+	}
+	return result.New(nil)
+}
+
 // ToConcept(argument Code) Concept
 // https://cql.hl7.org/09-b-cqlreference.html#toconcept
 func evalToConceptCode(m model.IUnaryExpression, obj result.Value) (result.Value, error) {
@@ -385,7 +590,6 @@ func evalToConceptList(m model.IUnaryExpression, obj result.Value) (result.Value
 // ToQuantity(argument Integer) Quantity
 // https://cql.hl7.org/09-b-cqlreference.html#toquantity
 // TODO: b/323978857 - Implement ToQuantity for Ratio.
-// TODO: b/324131050 - Implement ToQuantity for strings.
 func evalToQuantity(m model.IUnaryExpression, opObj result.Value) (result.Value, error) {
 	if result.IsNull(opObj) {
 		return result.New(nil)
@@ -415,31 +619,130 @@ func evalToQuantityString(m model.IUnaryExpression, opObj result.Value) (result.
 		return result.Value{}, err
 	}
 
+	q, ok, err := stringToQuantity(op)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(q)
+}
+
+// ToRatio(argument Ratio) Ratio
+// https://cql.hl7.org/09-b-cqlreference.html#toratio
+func evalToRatioRatio(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	return opObj, nil
+}
+
+// ToRatio(argument String) Ratio
+// https://cql.hl7.org/09-b-cqlreference.html#toratio
+//
+// Converts a string formatted as <quantity>:<quantity>, for example 5 'mg':10 'mL', to a Ratio.
+// Strings that are not formatted as a ratio convert to null.
+func evalToRatioString(_ model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+	if result.IsNull(opObj) {
+		return result.New(nil)
+	}
+	op, err := result.ToString(opObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	// Split on the first colon that is not within a quoted unit.
+	inQuote := false
+	split := -1
+	for i, r := range op {
+		if r == '\'' {
+			inQuote = !inQuote
+		} else if r == ':' && !inQuote {
+			split = i
+			break
+		}
+	}
+	if split == -1 {
+		return result.New(nil)
+	}
+
+	numerator, ok, err := stringToQuantity(strings.TrimSpace(op[:split]))
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	denominator, ok, err := stringToQuantity(strings.TrimSpace(op[split+1:]))
+	if err != nil {
+		return result.Value{}, err
+	}
+	if !ok {
+		return result.New(nil)
+	}
+	return result.New(result.Ratio{Numerator: numerator, Denominator: denominator})
+}
+
+// stringToQuantity parses a string such as 5 'mg' into a Quantity. It returns false if the string
+// is not formatted as a quantity or the unit is not syntactically valid UCUM.
+func stringToQuantity(s string) (result.Quantity, bool, error) {
 	// On valid match FindStringSubmatch returns a list containing:
 	// the whole matched text, the captured number, the captured unit text.
-	found := quantityStringRegex.FindStringSubmatch(op)
+	found := quantityStringRegex.FindStringSubmatch(s)
 	if len(found) != 3 {
-		return result.New(nil)
+		return result.Quantity{}, false, nil
 	}
 
 	// ParseFloat works for every string that meets the CQL spec.
 	f, err := strconv.ParseFloat(found[1], 64)
 	if err != nil {
-		return result.Value{}, err
+		return result.Quantity{}, false, err
 	}
 	unit := "1"
 	if len(found[2]) != 0 {
 		// trim off quotations
 		unit = found[2][1 : len(found[2])-1]
 	}
-	// TODO(b/319156186): When UCUM values are supported we should validate the unit value as well.
-	return result.New(result.Quantity{Value: f, Unit: model.Unit(unit)})
+	if err := ucum.ValidateUnit(unit); err != nil {
+		return result.Quantity{}, false, nil
+	}
+	return result.Quantity{Value: f, Unit: model.Unit(unit)}, true, nil
+}
+
+// convertsToOverloads returns the overloads of the conversion operator toModel wrapped to return
+// whether the conversion succeeds instead of the converted value. This implements the ConvertsTo
+// operators, for example https://cql.hl7.org/09-b-cqlreference.html#convertstointeger.
+func (i *interpreter) convertsToOverloads(toModel model.IUnaryExpression) ([]convert.Overload[evalUnarySignature], error) {
+	overloads, err := i.unaryOverloads(toModel)
+	if err != nil {
+		return nil, err
+	}
+	convertsTo := make([]convert.Overload[evalUnarySignature], 0, len(overloads))
+	for _, o := range overloads {
+		toFunc := o.Result
+		convertsTo = append(convertsTo, convert.Overload[evalUnarySignature]{
+			Operands: o.Operands,
+			Result: func(m model.IUnaryExpression, opObj result.Value) (result.Value, error) {
+				if result.IsNull(opObj) {
+					return result.New(nil)
+				}
+				converted, err := toFunc(m, opObj)
+				if err != nil {
+					return result.Value{}, err
+				}
+				return result.New(!result.IsNull(converted))
+			},
+		})
+	}
+	return convertsTo, nil
 }
 
 // Add an @ symbol to the string so we can use the same parsing logic as engine literals.
 func (i *interpreter) stringToDate(input string, inputType types.System) (result.Value, error) {
+	prefix := "@"
+	if inputType == types.Time {
+		prefix = "@T"
+	}
 	return i.evalLiteral(&model.Literal{
-		Value:      "@" + unqoteSingle(input),
+		Value:      prefix + unqoteSingle(input),
 		Expression: &model.Expression{Element: &model.Element{ResultType: inputType}},
 	})
 }
@@ -447,7 +750,7 @@ func (i *interpreter) stringToDate(input string, inputType types.System) (result
 // unqoteSingle returns the unquoted version of the string, if it's quoted with single quotes,
 // otherwise returns the input string.
 func unqoteSingle(str string) string {
-	if string(str[0]) == "'" && string(str[len(str)-1]) == "'" {
+	if len(str) >= 2 && string(str[0]) == "'" && string(str[len(str)-1]) == "'" {
 		return str[1 : len(str)-1]
 	}
 	return str
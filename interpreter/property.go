@@ -155,7 +155,15 @@ func (i *interpreter) valueProperty(v result.Value, property string, staticResul
 		default:
 			return result.Value{}, fmt.Errorf("property %s is not supported on %v", property, types.CodeSystem)
 		}
-		// TODO(b/301606416): Support Ratio and Vocabulary properties.
+	case result.Ratio:
+		switch property {
+		case "numerator":
+			return result.New(ot.Numerator)
+		case "denominator":
+			return result.New(ot.Denominator)
+		default:
+			return result.Value{}, fmt.Errorf("property %s is not supported on %v", property, types.Ratio)
+		}
 	default:
 		return result.Value{}, fmt.Errorf("unable to eval property %s on unsupported type %v", property, ot)
 	}
@@ -387,6 +395,11 @@ func (i *interpreter) listProperty(l result.List, property string, staticResultT
 		if err != nil {
 			return result.Value{}, fmt.Errorf("at index %d: %w", idx, err)
 		}
+		// Path traversal on a list only returns the non-null property values, see
+		// https://cql.hl7.org/03-developersguide.html#path-traversal.
+		if result.IsNull(subObj) {
+			continue
+		}
 
 		isSub, err := i.modelInfo.IsSubType(subObj.RuntimeType(), &types.List{ElementType: types.Any})
 		if err != nil {
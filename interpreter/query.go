@@ -30,21 +30,23 @@ import (
 // to the alias.
 type iteration map[string]alias
 
-func (i iteration) Equal(a iteration) bool {
-	if len(i) != len(a) {
-		return false
-	}
-	for _, aAlias := range a {
-		iAlias, ok := i[aAlias.alias]
+// iterationsEqual returns true if the value of each alias in l is equal to, or both null with, the
+// value of the same alias in r.
+func (i *interpreter) iterationsEqual(l, r iteration) (bool, error) {
+	if len(l) != len(r) {
+		return false, nil
+	}
+	for _, rAlias := range r {
+		lAlias, ok := l[rAlias.alias]
 		if !ok {
-			return false
+			return false, nil
 		}
-		// TODO: b/301606416 - when equal is implemented, call that logic from here.
-		if !iAlias.obj.Equal(aAlias.obj) {
-			return false
+		eq, err := i.equalOrBothNull(lAlias.obj, rAlias.obj)
+		if err != nil || !eq {
+			return false, err
 		}
 	}
-	return true
+	return true, nil
 }
 
 type alias struct {
@@ -53,8 +55,7 @@ type alias struct {
 }
 
 func (i *interpreter) evalQuery(q *model.Query) (result.Value, error) {
-	// The top level scope holds let clauses. Each query iteration defines a nested scope for the
-	// source and relationship aliases.
+	// Each query iteration defines a nested scope for the source, let and relationship aliases.
 	i.refs.EnterScope()
 	defer i.refs.ExitScope()
 
@@ -62,12 +63,14 @@ func (i *interpreter) evalQuery(q *model.Query) (result.Value, error) {
 	if err != nil {
 		return result.Value{}, err
 	}
+	if len(q.Source) == 1 && isNullList(q.Source[0], sourceObjs[0]) {
+		// Mapping over a null list, for example (null as List<Integer>) X return X * 2, is null.
+		return result.NewWithSources(nil, q, sourceObjs...)
+	}
 
-	sourceObj, err := i.letClause(q.Let)
-	if err != nil {
+	if err := i.letClause(iters, q.Let); err != nil {
 		return result.Value{}, err
 	}
-	sourceObjs = append(sourceObjs, sourceObj...)
 
 	for _, relationship := range q.Relationship {
 		var err error
@@ -105,9 +108,7 @@ func (i *interpreter) evalQuery(q *model.Query) (result.Value, error) {
 		if len(q.Source) == 1 {
 			// If there is no return clause and this was a single source query, unpack the alias.
 			for _, iter := range iters {
-				for _, alias := range iter {
-					finalVals = append(finalVals, alias.obj)
-				}
+				finalVals = append(finalVals, iter[q.Source[0].Alias].obj)
 			}
 		} else {
 			return result.Value{}, errors.New("internal error - multi-source queries must have a return clause, the parser should insert a default one if the user did not write one")
@@ -121,7 +122,7 @@ func (i *interpreter) evalQuery(q *model.Query) (result.Value, error) {
 				return result.Value{}, err
 			}
 		} else {
-			i.sortByColumn(finalVals, q.Sort.ByItems)
+			err := i.sortByItems(finalVals, q.Sort.ByItems)
 			if err != nil {
 				return result.Value{}, err
 			}
@@ -164,6 +165,11 @@ func (i *interpreter) sourceClause(s []*model.AliasedSource) ([]iteration, []res
 			return nil, nil, err
 		}
 		sourceObjs = append(sourceObjs, obj)
+		if isNullList(source, obj) {
+			// A null list has no elements to iterate over.
+			aliases = append(aliases, a)
+			continue
+		}
 		l, err := result.ToSlice(obj)
 		if err == nil {
 			// The source is a list so unpack.
@@ -181,6 +187,13 @@ func (i *interpreter) sourceClause(s []*model.AliasedSource) ([]iteration, []res
 	return cartesianProduct(aliases), sourceObjs, nil
 }
 
+// isNullList returns true if the source is a list that evaluated to null. Null sources that are
+// not lists are iterated over once like any other single value.
+func isNullList(source *model.AliasedSource, obj result.Value) bool {
+	_, ok := source.Source.GetResultType().(*types.List)
+	return ok && result.IsNull(obj)
+}
+
 // cartesianProduct converts [[{A, 4}], [{B, 1}, {B, 2}, {B, 3}]] into the cartesian product
 // [{A: {A, 4}, B: {B, 1}}, {A: {A, 4}, B: {B, 2}}, {A: {A, 4}, B: {B, 3}}].
 func cartesianProduct(aliases [][]alias) []iteration {
@@ -207,21 +220,40 @@ func cartesianProduct(aliases [][]alias) []iteration {
 	return cartIters
 }
 
-func (i *interpreter) letClause(m []*model.LetClause) ([]result.Value, error) {
-	sourceObjs := make([]result.Value, 0, len(m))
-	for _, letClause := range m {
-		obj, err := i.evalExpression(letClause.Expression)
-		if err != nil {
-			return nil, err
+// letClause evaluates the let clauses once per iteration and adds the results to the iteration
+// as aliases, so they can be referenced by the rest of the query. Later let clauses may reference
+// earlier ones.
+func (i *interpreter) letClause(iters []iteration, m []*model.LetClause) error {
+	if len(m) == 0 {
+		return nil
+	}
+	for _, iter := range iters {
+		if err := i.ctx.Err(); err != nil {
+			return err
+		}
+		i.refs.EnterScope()
+		for _, alias := range iter {
+			if err := i.refs.Alias(alias.alias, alias.obj); err != nil {
+				i.refs.ExitScope()
+				return err
+			}
 		}
-		sourceObjs = append(sourceObjs, obj)
 
-		if err := i.refs.Alias(letClause.Identifier, obj); err != nil {
-			return nil, err
+		for _, letClause := range m {
+			obj, err := i.evalExpression(letClause.Expression)
+			if err != nil {
+				i.refs.ExitScope()
+				return err
+			}
+			if err := i.refs.Alias(letClause.Identifier, obj); err != nil {
+				i.refs.ExitScope()
+				return err
+			}
+			iter[letClause.Identifier] = alias{alias: letClause.Identifier, obj: obj}
 		}
+		i.refs.ExitScope()
 	}
-
-	return sourceObjs, nil
+	return nil
 }
 
 func (i *interpreter) relationshipClause(iters []iteration, m model.IRelationshipClause) ([]iteration, result.Value, error) {
@@ -262,6 +294,10 @@ func (i *interpreter) relationshipClause(iters []iteration, m model.IRelationshi
 
 	filteredIters := []iteration{}
 	for _, iter := range iters {
+		if err := i.ctx.Err(); err != nil {
+			return nil, result.Value{}, err
+		}
+		matched := false
 		for _, relIter := range relIters {
 			i.refs.EnterScope()
 			// Define the alias for the relationship.
@@ -283,14 +319,17 @@ func (i *interpreter) relationshipClause(iters []iteration, m model.IRelationshi
 			if !result.IsNull(filter) && !filter.RuntimeType().Equal(types.Boolean) {
 				return nil, result.Value{}, fmt.Errorf("internal error - such that clause of a query must evaluate to a boolean or null, instead got %v", filter.RuntimeType())
 			}
-			if (with && filter.GolangValue() == true) || (!with && filter.GolangValue() == false) {
-				// We found a relationship where such that expression evaluated to true. Save this iter in
-				// filteredIters and break.
-				i.refs.ExitScope()
-				filteredIters = append(filteredIters, iter)
+			i.refs.ExitScope()
+			if filter.GolangValue() == true {
+				// We found a relationship where the such that expression evaluated to true, there is no
+				// need to check the remaining related values.
+				matched = true
 				break
 			}
-			i.refs.ExitScope()
+		}
+		// With keeps the iteration if any related value matched, without keeps it only if none did.
+		if matched == with {
+			filteredIters = append(filteredIters, iter)
 		}
 	}
 	return filteredIters, relSourceObj, nil
@@ -303,6 +342,9 @@ func (i *interpreter) whereClause(iters []iteration, where model.IExpression) ([
 
 	var filteredIters []iteration
 	for _, iter := range iters {
+		if err := i.ctx.Err(); err != nil {
+			return nil, err
+		}
 		i.refs.EnterScope()
 		for _, alias := range iter {
 			if err := i.refs.Alias(alias.alias, alias.obj); err != nil {
@@ -329,7 +371,14 @@ func (i *interpreter) aggregateClause(iters []iteration, aggregateClause *model.
 	var filteredIters []iteration
 	if aggregateClause.Distinct {
 		for _, iter := range iters {
-			filteredIters = appendIfIterDistinct(filteredIters, iter)
+			if err := i.ctx.Err(); err != nil {
+				return nil, err
+			}
+			var err error
+			filteredIters, err = i.appendIfIterDistinct(filteredIters, iter)
+			if err != nil {
+				return nil, err
+			}
 		}
 	} else {
 		filteredIters = iters
@@ -341,6 +390,9 @@ func (i *interpreter) aggregateClause(iters []iteration, aggregateClause *model.
 	}
 
 	for _, iter := range filteredIters {
+		if err := i.ctx.Err(); err != nil {
+			return nil, err
+		}
 		i.refs.EnterScope()
 
 		if err := i.refs.Alias(aggregateClause.Identifier, aggregateObj); err != nil {
@@ -369,6 +421,9 @@ func (i *interpreter) aggregateClause(iters []iteration, aggregateClause *model.
 func (i *interpreter) returnClause(iters []iteration, returnClause *model.ReturnClause) ([]result.Value, error) {
 	returnObjs := make([]result.Value, 0, len(iters))
 	for _, iter := range iters {
+		if err := i.ctx.Err(); err != nil {
+			return nil, err
+		}
 		i.refs.EnterScope()
 		for _, alias := range iter {
 			if err := i.refs.Alias(alias.alias, alias.obj); err != nil {
@@ -380,7 +435,10 @@ func (i *interpreter) returnClause(iters []iteration, returnClause *model.Return
 			return nil, err
 		}
 		if returnClause.Distinct {
-			returnObjs = appendIfDistinct(returnObjs, retObj)
+			returnObjs, err = i.appendIfDistinct(returnObjs, retObj)
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			returnObjs = append(returnObjs, retObj)
 		}
@@ -389,91 +447,181 @@ func (i *interpreter) returnClause(iters []iteration, returnClause *model.Return
 	return returnObjs, nil
 }
 
-func appendIfIterDistinct(distinctIters []iteration, maybeDistinct iteration) []iteration {
+// appendIfIterDistinct appends maybeDistinct to distinctIters unless it is equal to one of them.
+func (i *interpreter) appendIfIterDistinct(distinctIters []iteration, maybeDistinct iteration) ([]iteration, error) {
 	for _, distinct := range distinctIters {
-		if distinct.Equal(maybeDistinct) {
-			return distinctIters
+		eq, err := i.iterationsEqual(distinct, maybeDistinct)
+		if err != nil {
+			return nil, err
+		}
+		if eq {
+			return distinctIters, nil
 		}
 	}
-	return append(distinctIters, maybeDistinct)
+	return append(distinctIters, maybeDistinct), nil
 }
 
-func appendIfDistinct(objs []result.Value, obj result.Value) []result.Value {
+// evalRepeat evaluates the element expression for each element of the source, and then for each new
+// element it returns, until no new elements are found. The result is the distinct elements
+// returned by the element expression, so elements of the source are only included if they are
+// related to another element. Elements are compared with equivalence, so cycles terminate.
+func (i *interpreter) evalRepeat(r *model.Repeat) (result.Value, error) {
+	sourceObj, err := i.evalExpression(r.Source)
+	if err != nil {
+		return result.Value{}, err
+	}
+	if result.IsNull(sourceObj) {
+		return result.New(nil)
+	}
+	queue, err := result.ToSlice(sourceObj)
+	if err != nil {
+		return result.Value{}, err
+	}
+
+	repeated := []result.Value{}
+	for len(queue) > 0 {
+		next, err := i.repeatElement(r, queue[0])
+		if err != nil {
+			return result.Value{}, err
+		}
+		queue = queue[1:]
+		for _, obj := range next {
+			if result.IsNull(obj) {
+				continue
+			}
+			seen, err := i.valueInEquivalentList(obj, repeated)
+			if err != nil {
+				return result.Value{}, err
+			}
+			if !seen {
+				repeated = append(repeated, obj)
+				queue = append(queue, obj)
+			}
+		}
+	}
+	return newListResult(r, repeated)
+}
+
+// repeatElement evaluates the element expression of the repeat for obj, returning the related
+// elements.
+func (i *interpreter) repeatElement(r *model.Repeat, obj result.Value) ([]result.Value, error) {
+	i.refs.EnterScope()
+	defer i.refs.ExitScope()
+	if err := i.refs.Alias(r.Scope, obj); err != nil {
+		return nil, err
+	}
+	elemObj, err := i.evalExpression(r.Element)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsNull(elemObj) {
+		return nil, nil
+	}
+	if l, ok := elemObj.GolangValue().(result.List); ok {
+		return l.Value, nil
+	}
+	return []result.Value{elemObj}, nil
+}
+
+// valueInEquivalentList returns true if obj is equivalent to an element of list. Tuples and
+// resources do not have an equivalent overload, so they are compared with Equal.
+func (i *interpreter) valueInEquivalentList(obj result.Value, list []result.Value) (bool, error) {
+	switch obj.RuntimeType().(type) {
+	case *types.Tuple, *types.Named:
+		return i.valueInDistinctList(obj, list)
+	}
+	for _, elem := range list {
+		equi, err := i.evalEquivalentValue(obj, elem)
+		if err != nil {
+			return false, err
+		}
+		isEquivalent, err := result.ToBool(equi)
+		if err != nil {
+			return false, err
+		}
+		if isEquivalent {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// appendIfDistinct appends obj to objs unless it is equal to one of them, or both are null.
+func (i *interpreter) appendIfDistinct(objs []result.Value, obj result.Value) ([]result.Value, error) {
 	for _, o := range objs {
-		// TODO: b/327612359 - when distinct is implemented, call that logic from here.
-		if o.Equal(obj) {
-			return objs
+		eq, err := i.equalOrBothNull(o, obj)
+		if err != nil {
+			return nil, err
+		}
+		if eq {
+			return objs, nil
 		}
 	}
-	return append(objs, obj)
+	return append(objs, obj), nil
 }
 
 func sortByDirection(objs []result.Value, sbd *model.SortByDirection) error {
-	// Only allow Dates, DateTimes, Integers, Decimals, Longs and Strings for now.
-	// TODO(b/316984809): add sorting support for other types and nulls.
-	var sortFunc func(a, b result.Value) int
-	rt := objs[0].RuntimeType()
-	switch rt {
-	case types.Integer:
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(int32)
-			bv := b.GolangValue().(int32)
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return compareNumeralInt(bv, av)
-			}
-			return compareNumeralInt(av, bv)
-		}
-	case types.Decimal:
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(float64)
-			bv := b.GolangValue().(float64)
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return compareNumeralInt(bv, av)
-			}
-			return compareNumeralInt(av, bv)
-		}
-	case types.Long:
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(int64)
-			bv := b.GolangValue().(int64)
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return compareNumeralInt(bv, av)
-			}
-			return compareNumeralInt(av, bv)
-		}
-	case types.String:
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(string)
-			bv := b.GolangValue().(string)
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return strings.Compare(bv, av)
-			}
-			return strings.Compare(av, bv)
-		}
-	case types.Date:
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(result.Date).Date
-			bv := b.GolangValue().(result.Date).Date
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return bv.Compare(av)
-			}
-			return av.Compare(bv)
+	var sortErr error
+	slices.SortStableFunc(objs, func(a, b result.Value) int {
+		c, err := compareSortValues(a, b, sbd.SortByItem.Direction)
+		if err != nil {
+			sortErr = err
 		}
-	case types.DateTime:
+		return c
+	})
+	return sortErr
+}
+
+// compareSortValues compares two values for sorting in the given direction. Nulls sort before all
+// other values when ascending, and after all other values when descending.
+func compareSortValues(a, b result.Value, dir model.SortDirection) (int, error) {
+	c, err := compareAscending(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if dir == model.DESCENDING {
+		return -c, nil
+	}
+	return c, nil
+}
+
+func compareAscending(a, b result.Value) (int, error) {
+	switch aNull, bNull := result.IsNull(a), result.IsNull(b); {
+	case aNull && bNull:
+		return 0, nil
+	case aNull:
+		return -1, nil
+	case bNull:
+		return 1, nil
+	}
+	if !a.RuntimeType().Equal(b.RuntimeType()) {
+		return 0, fmt.Errorf("sort values must all be the same type, got %v and %v", a.RuntimeType(), b.RuntimeType())
+	}
+	// Only allow Dates, DateTimes, Times, Integers, Decimals, Longs and Strings for now.
+	// TODO(b/316984809): add sorting support for other types.
+	switch av := a.GolangValue().(type) {
+	case int32:
+		bv, err := result.ToInt32(b)
+		if err != nil {
+			return 0, err
+		}
+		return compareNumeralInt(av, bv), nil
+	case int64:
+		return compareNumeralInt(av, b.GolangValue().(int64)), nil
+	case float64:
+		return compareNumeralInt(av, b.GolangValue().(float64)), nil
+	case string:
+		return strings.Compare(av, b.GolangValue().(string)), nil
+	case result.Date:
+		return av.Date.Compare(b.GolangValue().(result.Date).Date), nil
+	case result.DateTime:
 		// TODO: b/301606416 - we should use a precision aware comparison here.
-		sortFunc = func(a, b result.Value) int {
-			av := a.GolangValue().(result.DateTime).Date
-			bv := b.GolangValue().(result.DateTime).Date
-			if sbd.SortByItem.Direction == model.DESCENDING {
-				return bv.Compare(av)
-			}
-			return av.Compare(bv)
-		}
+		return av.Date.Compare(b.GolangValue().(result.DateTime).Date), nil
+	case result.Time:
+		return av.Date.Compare(b.GolangValue().(result.Time).Date), nil
 	default:
-		return fmt.Errorf("sort column of a query by direction must evaluate to a Date, DateTime, Integer, Decimal, or Long, instead got %v", objs[0].RuntimeType())
+		return 0, fmt.Errorf("sort values must evaluate to a Date, DateTime, Time, Integer, Decimal, Long or String, instead got %v", a.RuntimeType())
 	}
-	slices.SortFunc(objs[:], sortFunc)
-	return nil
 }
 
 // compareNumeralInt returns the integer comparison value of two numeric values.
@@ -490,67 +638,96 @@ func compareNumeralInt[t float64 | int64 | int32](left, right t) int {
 	}
 }
 
-func (i *interpreter) sortByColumn(objs []result.Value, sbis []model.ISortByItem) error {
-	// Validate sort column types.
-	for _, sortItems := range sbis {
-		// TODO(b/316984809): Is this validation in advance necessary? What if other values (beyond
-		// objs[0]) have a different runtime type for the property (e.g. if they're a choice type)?
-		// Consider validating types inline during the sort instead.
-		path := sortItems.(*model.SortByColumn).Path
-		propertyType, err := i.modelInfo.PropertyTypeSpecifier(objs[0].RuntimeType(), path)
-		if err != nil {
-			return err
-		}
-		columnVal, err := i.valueProperty(objs[0], path, propertyType)
-		if err != nil {
-			return err
-		}
-		// Strictly only allow DateTimes for now.
-		// TODO(b/316984809): add sorting support for other types.
-		if !columnVal.RuntimeType().Equal(types.DateTime) {
-			return fmt.Errorf("sort column of a query must evaluate to a date time, instead got %v", columnVal.RuntimeType())
+// sortByItems sorts the objects by each of the sort by columns or expressions in turn, so later
+// items are only used to order objects whose earlier items are equal.
+func (i *interpreter) sortByItems(objs []result.Value, sbis []model.ISortByItem) error {
+	// The sort values are computed once for each object, before sorting.
+	type sortRow struct {
+		obj     result.Value
+		columns []result.Value
+	}
+	rows := make([]sortRow, 0, len(objs))
+	for _, obj := range objs {
+		row := sortRow{obj: obj}
+		for _, sbi := range sbis {
+			col, err := i.sortItemValue(obj, sbi)
+			if err != nil {
+				return err
+			}
+			row.columns = append(row.columns, col)
 		}
+		rows = append(rows, row)
 	}
 
-	var sortErr error = nil
-	slices.SortFunc(objs[:], func(a, b result.Value) int {
-		for _, sortItems := range sbis {
-			sortCol := sortItems.(*model.SortByColumn)
-			// Passing the static types here is likely unimportant, but we compute it for completeness.
-			aType, err := i.modelInfo.PropertyTypeSpecifier(a.RuntimeType(), sortCol.Path)
+	var sortErr error
+	slices.SortStableFunc(rows, func(a, b sortRow) int {
+		for idx, sbi := range sbis {
+			c, err := compareSortValues(a.columns[idx], b.columns[idx], sortDirection(sbi))
 			if err != nil {
 				sortErr = err
-				continue
-			}
-			ap, err := i.valueProperty(a, sortCol.Path, aType)
-			if err != nil {
-				sortErr = err
-				continue
-			}
-			bType, err := i.modelInfo.PropertyTypeSpecifier(b.RuntimeType(), sortCol.Path)
-			if err != nil {
-				sortErr = err
-				continue
-			}
-			bp, err := i.valueProperty(b, sortCol.Path, bType)
-			if err != nil {
-				sortErr = err
-				continue
+				return 0
 			}
-			av := ap.GolangValue().(result.DateTime).Date
-			bv := bp.GolangValue().(result.DateTime).Date
-
-			// In the future when we have an implementation of dateTime comparison without precision we should swap to using that.
-			// TODO(b/308012659): Implement dateTime comparison that doesn't take a precision.
-			if av.Equal(bv) {
-				continue
-			} else if sortCol.SortByItem.Direction == model.DESCENDING {
-				return bv.Compare(av)
+			if c != 0 {
+				return c
 			}
-			return av.Compare(bv)
 		}
-		// All columns evaluated to equal so this sort is undefined.
+		// All items evaluated to equal so the original order is kept.
 		return 0
 	})
-	return sortErr
+	if sortErr != nil {
+		return sortErr
+	}
+	for idx, row := range rows {
+		objs[idx] = row.obj
+	}
+	return nil
+}
+
+// sortItemValue computes the value of a sort by column or expression for the object.
+func (i *interpreter) sortItemValue(obj result.Value, sbi model.ISortByItem) (result.Value, error) {
+	switch sbi := sbi.(type) {
+	case *model.SortByColumn:
+		return i.sortColumnValue(obj, sbi.Path)
+	case *model.SortByExpression:
+		i.refs.EnterScope()
+		defer i.refs.ExitScope()
+		if err := i.refs.Alias(sbi.Scope, obj); err != nil {
+			return result.Value{}, err
+		}
+		return i.evalExpression(sbi.Expression)
+	default:
+		return result.Value{}, fmt.Errorf("internal error - unsupported sort by item %T", sbi)
+	}
+}
+
+// sortDirection returns the direction of a sort by column or expression.
+func sortDirection(sbi model.ISortByItem) model.SortDirection {
+	switch sbi := sbi.(type) {
+	case *model.SortByColumn:
+		return sbi.Direction
+	case *model.SortByExpression:
+		return sbi.Direction
+	default:
+		return model.ASCENDING
+	}
+}
+
+// sortColumnValue evaluates the possibly dotted path of a sort by column on the object.
+func (i *interpreter) sortColumnValue(obj result.Value, path string) (result.Value, error) {
+	col := obj
+	for _, property := range strings.Split(path, ".") {
+		if result.IsNull(col) {
+			return col, nil
+		}
+		// Passing the static types here is likely unimportant, but we compute it for completeness.
+		propertyType, err := i.modelInfo.PropertyTypeSpecifier(col.RuntimeType(), property)
+		if err != nil {
+			return result.Value{}, err
+		}
+		col, err = i.valueProperty(col, property, propertyType)
+		if err != nil {
+			return result.Value{}, err
+		}
+	}
+	return col, nil
 }
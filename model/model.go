@@ -16,6 +16,8 @@
 package model
 
 import (
+	"fmt"
+
 	"github.com/google/cql/types"
 	"github.com/kylelemons/godebug/pretty"
 )
@@ -52,20 +54,40 @@ type IElement interface {
 
 // Element is the base for all CQL nodes.
 type Element struct {
-	// TODO(b/298104167): Add common row, column.
 	ResultType types.IType
+	// Locator is the position of the element in the CQL source. It may be nil if the position is
+	// unknown.
+	// TODO(b/298104167): Only ExpressionDefs currently have a Locator set by the parser.
+	Locator *Locator
 }
 
-// Row returns the element's row in the source file.
+// Row returns the element's row in the source file, or 0 if unknown.
 func (t *Element) Row() int {
-	// TODO(b/298104167): Add row information.
-	return 0
+	if t == nil || t.Locator == nil {
+		return 0
+	}
+	return t.Locator.StartLine
 }
 
-// Col returns the element's column in the source file.
+// Col returns the element's column in the source file, or 0 if unknown.
 func (t *Element) Col() int {
-	// TODO(b/298104167): Add column information.
-	return 0
+	if t == nil || t.Locator == nil {
+		return 0
+	}
+	return t.Locator.StartColumn
+}
+
+// Locator is the position of an Element in the CQL source. Lines and columns are 1-based.
+type Locator struct {
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+}
+
+// String returns the Locator in the ELM locator format, startLine:startColumn-endLine:endColumn.
+func (l *Locator) String() string {
+	return fmt.Sprintf("%d:%d-%d:%d", l.StartLine, l.StartColumn, l.EndLine, l.EndColumn)
 }
 
 // GetResultType returns the type of the result which may be nil if unknown or not yet implemented.
@@ -479,6 +501,16 @@ type ReturnClause struct {
 	Distinct   bool
 }
 
+// Repeat is https://cql.hl7.org/04-logicalspecification.html#repeat. Element is evaluated for each
+// element of Source, and then for each new element it returns, until no new elements are found. The
+// current element is available to Element as an AliasRef named Scope.
+type Repeat struct {
+	*Expression
+	Source  IExpression
+	Element IExpression
+	Scope   string
+}
+
 // ISortByItem defines one or more items that a query can be sorted by.
 // Follows format outlined in https://cql.hl7.org/elm/schema/expression.xsd.
 type ISortByItem interface {
@@ -507,6 +539,17 @@ type SortByColumn struct {
 
 func (c *SortByColumn) isSortByItem() {}
 
+// SortByExpression enables sorting by an expression evaluated for each element being sorted. The
+// element is available to Expression as an AliasRef named Scope, and its properties as Property
+// expressions on that AliasRef.
+type SortByExpression struct {
+	*SortByItem
+	Expression IExpression
+	Scope      string
+}
+
+func (c *SortByExpression) isSortByItem() {}
+
 // AliasedSource is a query source with an alias.
 type AliasedSource struct {
 	*Expression
@@ -531,6 +574,11 @@ type Retrieve struct {
 	CodeProperty string
 	// Codes is an expression that returns a list of code values.
 	Codes IExpression
+	// DateProperty is the path of the date element the retrieve is filtered on by DateRange.
+	DateProperty string
+	// DateRange is an expression that returns the interval DateProperty must be during. If nil the
+	// retrieve is not filtered by date.
+	DateRange IExpression
 }
 
 // Case is a conditional case expression https://cql.hl7.org/04-logicalspecification.html#case.
@@ -656,6 +704,21 @@ type Last struct {
 
 var _ IUnaryExpression = &Last{}
 
+// Flatten is https://cql.hl7.org/04-logicalspecification.html#flatten.
+type Flatten struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Flatten{}
+
+// Distinct is https://cql.hl7.org/04-logicalspecification.html#distinct.
+type Distinct struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Distinct{}
+
+// Tail is https://cql.hl7.org/04-logicalspecification.html#tail.
+type Tail struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Tail{}
+
 // Abs is https://cql.hl7.org/04-logicalspecification.html#abs.
 type Abs struct{ *UnaryExpression }
 
@@ -671,6 +734,26 @@ type Ln struct{ *UnaryExpression }
 // Precision  is https://cql.hl7.org/04-logicalspecification.html#precision.
 type Precision struct{ *UnaryExpression }
 
+// DateFrom is https://cql.hl7.org/04-logicalspecification.html#datefrom.
+type DateFrom struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &DateFrom{}
+
+// TimeFrom is https://cql.hl7.org/04-logicalspecification.html#timefrom.
+type TimeFrom struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &TimeFrom{}
+
+// DateTimeComponentFrom is https://cql.hl7.org/04-logicalspecification.html#datetimecomponentfrom.
+type DateTimeComponentFrom struct {
+	*UnaryExpression
+	// Precision is the component to extract. It must be one of year, month, day, hour, minute,
+	// second or millisecond.
+	Precision DateTimePrecision
+}
+
+var _ IUnaryExpression = &DateTimeComponentFrom{}
+
 // SingletonFrom is https://cql.hl7.org/04-logicalspecification.html#singletonfrom.
 type SingletonFrom struct{ *UnaryExpression }
 
@@ -686,6 +769,21 @@ type End struct{ *UnaryExpression }
 
 var _ IUnaryExpression = &End{}
 
+// PointFrom is https://cql.hl7.org/04-logicalspecification.html#pointfrom.
+type PointFrom struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &PointFrom{}
+
+// Width is https://cql.hl7.org/04-logicalspecification.html#width.
+type Width struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Width{}
+
+// Size is https://cql.hl7.org/04-logicalspecification.html#size.
+type Size struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Size{}
+
 // Predecessor ELM expression from https://cql.hl7.org/04-logicalspecification.html#predecessor.
 type Predecessor struct{ *UnaryExpression }
 
@@ -696,6 +794,21 @@ type Successor struct{ *UnaryExpression }
 
 var _ IUnaryExpression = &Successor{}
 
+// Upper is https://cql.hl7.org/04-logicalspecification.html#upper.
+type Upper struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Upper{}
+
+// Lower is https://cql.hl7.org/04-logicalspecification.html#lower.
+type Lower struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Lower{}
+
+// Length is https://cql.hl7.org/04-logicalspecification.html#length.
+type Length struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &Length{}
+
 // IsNull is https://cql.hl7.org/04-logicalspecification.html#isnull.
 type IsNull struct{ *UnaryExpression }
 
@@ -731,6 +844,11 @@ type ToDecimal struct{ *UnaryExpression }
 
 var _ IUnaryExpression = &ToDecimal{}
 
+// ToList ELM expression from https://cql.hl7.org/04-logicalspecification.html#tolist.
+type ToList struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ToList{}
+
 // ToLong ELM expression from https://cql.hl7.org/04-logicalspecification.html#tolong.
 type ToLong struct{ *UnaryExpression }
 
@@ -746,6 +864,11 @@ type ToQuantity struct{ *UnaryExpression }
 
 var _ IUnaryExpression = &ToQuantity{}
 
+// ToRatio ELM expression from https://cql.hl7.org/04-logicalspecification.html#toratio.
+type ToRatio struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ToRatio{}
+
 // ToConcept ELM expression from https://cql.hl7.org/09-b-cqlreference.html#toconcept.
 type ToConcept struct{ *UnaryExpression }
 
@@ -761,6 +884,56 @@ type ToTime struct{ *UnaryExpression }
 
 var _ IUnaryExpression = &ToTime{}
 
+// ConvertsToBoolean ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstoboolean.
+type ConvertsToBoolean struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToBoolean{}
+
+// ConvertsToDate ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstodate.
+type ConvertsToDate struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToDate{}
+
+// ConvertsToDateTime ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstodatetime.
+type ConvertsToDateTime struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToDateTime{}
+
+// ConvertsToDecimal ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstodecimal.
+type ConvertsToDecimal struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToDecimal{}
+
+// ConvertsToInteger ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstointeger.
+type ConvertsToInteger struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToInteger{}
+
+// ConvertsToLong ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstolong.
+type ConvertsToLong struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToLong{}
+
+// ConvertsToQuantity ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstoquantity.
+type ConvertsToQuantity struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToQuantity{}
+
+// ConvertsToRatio ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstoratio.
+type ConvertsToRatio struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToRatio{}
+
+// ConvertsToString ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstostring.
+type ConvertsToString struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToString{}
+
+// ConvertsToTime ELM expression from https://cql.hl7.org/04-logicalspecification.html#convertstotime.
+type ConvertsToTime struct{ *UnaryExpression }
+
+var _ IUnaryExpression = &ConvertsToTime{}
+
 // AllTrue ELM expression from https://cql.hl7.org/04-logicalspecification.html#alltrue.
 // TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
 // UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
@@ -787,7 +960,12 @@ type Avg struct{ *UnaryExpression }
 // TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
 // UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
 // far as we can tell.
-type Count struct{ *UnaryExpression }
+type Count struct {
+	*UnaryExpression
+	// Distinct is true for Count(distinct X), in which case only unique non-null elements are
+	// counted.
+	Distinct bool
+}
 
 var _ IUnaryExpression = &Count{}
 
@@ -815,6 +993,48 @@ type Sum struct{ *UnaryExpression }
 // far as we can tell.
 type Median struct{ *UnaryExpression }
 
+// Mode ELM expression from https://cql.hl7.org/09-b-cqlreference.html#mode
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type Mode struct{ *UnaryExpression }
+
+// PopulationVariance ELM expression from https://cql.hl7.org/09-b-cqlreference.html#populationvariance
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type PopulationVariance struct{ *UnaryExpression }
+
+// PopulationStdDev ELM expression from https://cql.hl7.org/09-b-cqlreference.html#populationstddev
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type PopulationStdDev struct{ *UnaryExpression }
+
+// StdDev ELM expression from https://cql.hl7.org/09-b-cqlreference.html#stddev
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type StdDev struct{ *UnaryExpression }
+
+// Variance ELM expression from https://cql.hl7.org/09-b-cqlreference.html#variance
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type Variance struct{ *UnaryExpression }
+
+// GeometricMean ELM expression from https://cql.hl7.org/09-b-cqlreference.html#geometricmean
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type GeometricMean struct{ *UnaryExpression }
+
+// Product ELM expression from https://cql.hl7.org/09-b-cqlreference.html#product
+// TODO: b/347346351 - In ELM it's modeled as an AggregateExpression, but for now we model it as an
+// UnaryExpression since there is no way to set the AggregateExpression's "path" property for CQL as
+// far as we can tell.
+type Product struct{ *UnaryExpression }
+
 // CalculateAge CQL expression type
 type CalculateAge struct {
 	*UnaryExpression
@@ -935,9 +1155,21 @@ type Modulo struct{ *BinaryExpression }
 // Power ELM Expression https://cql.hl7.org/04-logicalspecification.html#power
 type Power struct{ *BinaryExpression }
 
+// Log ELM Expression https://cql.hl7.org/04-logicalspecification.html#log
+type Log struct{ *BinaryExpression }
+
 // TruncatedDivide ELM Expression https://cql.hl7.org/04-logicalspecification.html#truncateddivide
 type TruncatedDivide struct{ *BinaryExpression }
 
+// IndexOf ELM Expression https://cql.hl7.org/04-logicalspecification.html#indexof
+type IndexOf struct{ *BinaryExpression }
+
+// Skip ELM Expression https://cql.hl7.org/04-logicalspecification.html#skip
+type Skip struct{ *BinaryExpression }
+
+// Take ELM Expression https://cql.hl7.org/04-logicalspecification.html#take
+type Take struct{ *BinaryExpression }
+
 // Except ELM Expression https://cql.hl7.org/04-logicalspecification.html#except
 // Except is a nary expression but we are only supporting two operands.
 type Except struct{ *BinaryExpression }
@@ -950,11 +1182,38 @@ type Intersect struct{ *BinaryExpression }
 // Union is a nary expression but we are only supporting two operands.
 type Union struct{ *BinaryExpression }
 
+// Collapse ELM Expression https://cql.hl7.org/04-logicalspecification.html#collapse
+// The second operand is the per Quantity, which is a null Quantity if per is not specified.
+type Collapse struct{ *BinaryExpression }
+
+// Expand ELM Expression https://cql.hl7.org/04-logicalspecification.html#expand
+// The second operand is the per Quantity, which is a null Quantity if per is not specified.
+type Expand struct{ *BinaryExpression }
+
 // Split ELM Expression https://cql.hl7.org/04-logicalspecification.html#split
 // Split is an OperatorExpression in ELM, but we're modeling it as a BinaryExpression since in CQL
 // it always takes two arguments.
 type Split struct{ *BinaryExpression }
 
+// Matches ELM Expression https://cql.hl7.org/04-logicalspecification.html#matches
+type Matches struct{ *BinaryExpression }
+
+// PositionOf ELM Expression https://cql.hl7.org/04-logicalspecification.html#positionof
+// PositionOf is an OperatorExpression in ELM, but we're modeling it as a BinaryExpression since in
+// CQL it always takes two arguments.
+type PositionOf struct{ *BinaryExpression }
+
+// LastPositionOf ELM Expression https://cql.hl7.org/04-logicalspecification.html#lastpositionof
+// LastPositionOf is an OperatorExpression in ELM, but we're modeling it as a BinaryExpression since
+// in CQL it always takes two arguments.
+type LastPositionOf struct{ *BinaryExpression }
+
+// StartsWith ELM Expression https://cql.hl7.org/04-logicalspecification.html#startswith
+type StartsWith struct{ *BinaryExpression }
+
+// EndsWith ELM Expression https://cql.hl7.org/04-logicalspecification.html#endswith
+type EndsWith struct{ *BinaryExpression }
+
 // Indexer ELM Expression https://cql.hl7.org/04-logicalspecification.html#indexer.
 type Indexer struct{ *BinaryExpression }
 
@@ -983,12 +1242,24 @@ type SameOrAfter BinaryExpressionWithPrecision
 // DifferenceBetween ELM expression from https://cql.hl7.org/04-logicalspecification.html#differencebetween.
 type DifferenceBetween BinaryExpressionWithPrecision
 
+// DurationBetween ELM expression from https://cql.hl7.org/04-logicalspecification.html#durationbetween.
+type DurationBetween BinaryExpressionWithPrecision
+
 // In ELM expression from https://cql.hl7.org/04-logicalspecification.html#in.
 type In BinaryExpressionWithPrecision
 
 // IncludedIn ELM expression from https://cql.hl7.org/04-logicalspecification.html#included-in.
 type IncludedIn BinaryExpressionWithPrecision
 
+// Includes ELM expression from https://cql.hl7.org/04-logicalspecification.html#includes.
+type Includes BinaryExpressionWithPrecision
+
+// ProperIncludedIn ELM expression from https://cql.hl7.org/04-logicalspecification.html#properincludedin.
+type ProperIncludedIn BinaryExpressionWithPrecision
+
+// ProperIncludes ELM expression from https://cql.hl7.org/04-logicalspecification.html#properincludes.
+type ProperIncludes BinaryExpressionWithPrecision
+
 // InCodeSystem is https://cql.hl7.org/09-b-cqlreference.html#in-codesystem.
 // This is not technically 1:1 with the ELM definition. The ELM defines Code, CodeSystem and
 // CodeSystemExpression arguments, the last being seemingly impossible to set for for now we're
@@ -1001,6 +1272,14 @@ type InCodeSystem struct{ *BinaryExpression }
 // treating this as a binary expression.
 type InValueSet struct{ *BinaryExpression }
 
+// AnyInCodeSystem is https://cql.hl7.org/04-logicalspecification.html#anyincodesystem.
+// Like InCodeSystem this is treated as a binary expression of the codes and the CodeSystem.
+type AnyInCodeSystem struct{ *BinaryExpression }
+
+// AnyInValueSet is https://cql.hl7.org/04-logicalspecification.html#anyinvalueset.
+// Like InValueSet this is treated as a binary expression of the codes and the ValueSet.
+type AnyInValueSet struct{ *BinaryExpression }
+
 // Contains ELM expression from https://cql.hl7.org/04-logicalspecification.html#contains.
 type Contains BinaryExpressionWithPrecision
 
@@ -1010,6 +1289,29 @@ type CalculateAgeAt BinaryExpressionWithPrecision
 // Overlaps ELM Expression from https://cql.hl7.org/04-logicalspecification.html#overlaps.
 type Overlaps BinaryExpressionWithPrecision
 
+// OverlapsBefore ELM Expression from https://cql.hl7.org/04-logicalspecification.html#overlapsbefore.
+type OverlapsBefore BinaryExpressionWithPrecision
+
+// OverlapsAfter ELM Expression from https://cql.hl7.org/04-logicalspecification.html#overlapsafter.
+type OverlapsAfter BinaryExpressionWithPrecision
+
+// Meets ELM Expression from https://cql.hl7.org/04-logicalspecification.html#meets.
+type Meets BinaryExpressionWithPrecision
+
+// MeetsBefore ELM Expression from https://cql.hl7.org/04-logicalspecification.html#meetsbefore.
+type MeetsBefore BinaryExpressionWithPrecision
+
+// MeetsAfter ELM Expression from https://cql.hl7.org/04-logicalspecification.html#meetsafter.
+type MeetsAfter BinaryExpressionWithPrecision
+
+// Starts ELM Expression from https://cql.hl7.org/04-logicalspecification.html#starts.
+// The left operand may also be a point, which is treated as a unit interval.
+type Starts BinaryExpressionWithPrecision
+
+// Ends ELM Expression from https://cql.hl7.org/04-logicalspecification.html#ends.
+// The left operand may also be a point, which is treated as a unit interval.
+type Ends BinaryExpressionWithPrecision
+
 // INaryExpression is an interface that Expressions with any number of operands meet.
 type INaryExpression interface {
 	IExpression
@@ -1050,6 +1352,24 @@ type Concatenate struct{ *NaryExpression }
 // it takes either 1 or 2 arguments.
 type Combine struct{ *NaryExpression }
 
+// ReplaceMatches is https://cql.hl7.org/04-logicalspecification.html#replacematches.
+type ReplaceMatches struct{ *NaryExpression }
+
+// Substring is https://cql.hl7.org/04-logicalspecification.html#substring.
+// In ELM Substring is an OperatorExpression, but we're modeling it as a NaryExpression since in CQL
+// it takes either 2 or 3 arguments.
+type Substring struct{ *NaryExpression }
+
+// Slice is https://cql.hl7.org/04-logicalspecification.html#slice.
+// In ELM Slice is an OperatorExpression, but we're modeling it as a NaryExpression since it takes
+// three operands.
+type Slice struct{ *NaryExpression }
+
+// Round is https://cql.hl7.org/04-logicalspecification.html#round.
+// In ELM Round is an OperatorExpression, but we're modeling it as a NaryExpression since in CQL
+// it takes either 1 or 2 arguments.
+type Round struct{ *NaryExpression }
+
 // Date is the functional syntax to create a Date https://cql.hl7.org/09-b-cqlreference.html#date-1.
 type Date struct{ *NaryExpression }
 
@@ -1175,6 +1495,15 @@ func (a *Ln) GetName() string { return "Ln" }
 // GetName returns the name of the system operator.
 func (a *Precision) GetName() string { return "Precision" }
 
+// GetName returns the name of the system operator.
+func (a *DateFrom) GetName() string { return "DateFrom" }
+
+// GetName returns the name of the system operator.
+func (a *TimeFrom) GetName() string { return "TimeFrom" }
+
+// GetName returns the name of the system operator.
+func (a *DateTimeComponentFrom) GetName() string { return "DateTimeComponentFrom" }
+
 // GetName returns the name of the system operator.
 func (a *As) GetName() string { return "As" }
 
@@ -1196,6 +1525,15 @@ func (f *First) GetName() string { return "First" }
 // GetName returns the name of the system operator.
 func (l *Last) GetName() string { return "Last" }
 
+// GetName returns the name of the system operator.
+func (a *Flatten) GetName() string { return "Flatten" }
+
+// GetName returns the name of the system operator.
+func (a *Distinct) GetName() string { return "Distinct" }
+
+// GetName returns the name of the system operator.
+func (a *Tail) GetName() string { return "Tail" }
+
 // GetName returns the name of the system operator.
 func (s *SingletonFrom) GetName() string { return "As" }
 
@@ -1205,6 +1543,15 @@ func (a *Start) GetName() string { return "Start" }
 // GetName returns the name of the system operator.
 func (a *End) GetName() string { return "End" }
 
+// GetName returns the name of the system operator.
+func (a *PointFrom) GetName() string { return "PointFrom" }
+
+// GetName returns the name of the system operator.
+func (a *Width) GetName() string { return "Width" }
+
+// GetName returns the name of the system operator.
+func (a *Size) GetName() string { return "Size" }
+
 // GetName returns the name of the system operator.
 func (a *Predecessor) GetName() string { return "Predecessor" }
 
@@ -1232,6 +1579,9 @@ func (a *ToDate) GetName() string { return "ToDate" }
 // GetName returns the name of the system operator.
 func (a *ToDecimal) GetName() string { return "ToDecimal" }
 
+// GetName returns the name of the system operator.
+func (a *ToList) GetName() string { return "ToList" }
+
 // GetName returns the name of the system operator.
 func (a *ToLong) GetName() string { return "ToLong" }
 
@@ -1241,6 +1591,9 @@ func (a *ToInteger) GetName() string { return "ToInteger" }
 // GetName returns the name of the system operator.
 func (a *ToQuantity) GetName() string { return "ToQuantity" }
 
+// GetName returns the name of the system operator.
+func (a *ToRatio) GetName() string { return "ToRatio" }
+
 // GetName returns the name of the system operator.
 func (a *ToConcept) GetName() string { return "ToConcept" }
 
@@ -1250,6 +1603,36 @@ func (a *ToString) GetName() string { return "ToString" }
 // GetName returns the name of the system operator.
 func (a *ToTime) GetName() string { return "ToTime" }
 
+// GetName returns the name of the system operator.
+func (a *ConvertsToBoolean) GetName() string { return "ConvertsToBoolean" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToDate) GetName() string { return "ConvertsToDate" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToDateTime) GetName() string { return "ConvertsToDateTime" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToDecimal) GetName() string { return "ConvertsToDecimal" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToInteger) GetName() string { return "ConvertsToInteger" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToLong) GetName() string { return "ConvertsToLong" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToQuantity) GetName() string { return "ConvertsToQuantity" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToRatio) GetName() string { return "ConvertsToRatio" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToString) GetName() string { return "ConvertsToString" }
+
+// GetName returns the name of the system operator.
+func (a *ConvertsToTime) GetName() string { return "ConvertsToTime" }
+
 // GetName returns the name of the system operator.
 func (a *CalculateAge) GetName() string { return "CalculateAge" }
 
@@ -1312,6 +1695,9 @@ func (a *Modulo) GetName() string { return "Modulo" }
 // GetName returns the name of the system operator.
 func (a *Power) GetName() string { return "Power" }
 
+// GetName returns the name of the system operator.
+func (a *Log) GetName() string { return "Log" }
+
 // GetName returns the name of the system operator.
 func (a *TruncatedDivide) GetName() string { return "TruncatedDivide" }
 
@@ -1330,18 +1716,36 @@ func (a *SameOrAfter) GetName() string { return "SameOrAfter" }
 // GetName returns the name of the system operator.
 func (a *DifferenceBetween) GetName() string { return "DifferenceBetween" }
 
+// GetName returns the name of the system operator.
+func (a *DurationBetween) GetName() string { return "DurationBetween" }
+
 // GetName returns the name of the system operator.
 func (a *In) GetName() string { return "In" }
 
 // GetName returns the name of the system operator.
 func (a *IncludedIn) GetName() string { return "IncludedIn" }
 
+// GetName returns the name of the system operator.
+func (a *Includes) GetName() string { return "Includes" }
+
+// GetName returns the name of the system operator.
+func (a *ProperIncludedIn) GetName() string { return "ProperIncludedIn" }
+
+// GetName returns the name of the system operator.
+func (a *ProperIncludes) GetName() string { return "ProperIncludes" }
+
 // GetName returns the name of the system operator.
 func (a *InCodeSystem) GetName() string { return "InCodeSystem" }
 
 // GetName returns the name of the system operator.
 func (a *InValueSet) GetName() string { return "InValueSet" }
 
+// GetName returns the name of the system operator.
+func (a *AnyInCodeSystem) GetName() string { return "AnyInCodeSystem" }
+
+// GetName returns the name of the system operator.
+func (a *AnyInValueSet) GetName() string { return "AnyInValueSet" }
+
 // GetName returns the name of the system operator.
 func (a *Contains) GetName() string { return "Contains" }
 
@@ -1351,6 +1755,36 @@ func (a *CalculateAgeAt) GetName() string { return "CalculateAgeAt" }
 // GetName returns the name of the system operator.
 func (a *Overlaps) GetName() string { return "Overlaps" }
 
+// GetName returns the name of the system operator.
+func (a *OverlapsBefore) GetName() string { return "OverlapsBefore" }
+
+// GetName returns the name of the system operator.
+func (a *OverlapsAfter) GetName() string { return "OverlapsAfter" }
+
+// GetName returns the name of the system operator.
+func (a *Meets) GetName() string { return "Meets" }
+
+// GetName returns the name of the system operator.
+func (a *MeetsBefore) GetName() string { return "MeetsBefore" }
+
+// GetName returns the name of the system operator.
+func (a *MeetsAfter) GetName() string { return "MeetsAfter" }
+
+// GetName returns the name of the system operator.
+func (a *Starts) GetName() string { return "Starts" }
+
+// GetName returns the name of the system operator.
+func (a *Ends) GetName() string { return "Ends" }
+
+// GetName returns the name of the system operator.
+func (a *IndexOf) GetName() string { return "IndexOf" }
+
+// GetName returns the name of the system operator.
+func (a *Skip) GetName() string { return "Skip" }
+
+// GetName returns the name of the system operator.
+func (a *Take) GetName() string { return "Take" }
+
 // GetName returns the name of the system operator.
 func (a *Except) GetName() string { return "Except" }
 
@@ -1360,6 +1794,12 @@ func (a *Intersect) GetName() string { return "Intersect" }
 // GetName returns the name of the system operator.
 func (a *Union) GetName() string { return "Union" }
 
+// GetName returns the name of the system operator.
+func (a *Collapse) GetName() string { return "Collapse" }
+
+// GetName returns the name of the system operator.
+func (a *Expand) GetName() string { return "Expand" }
+
 // NARY EXPRESSION GETNAME()
 
 // GetName returns the name of the system operator.
@@ -1410,11 +1850,68 @@ func (a *Sum) GetName() string { return "Sum" }
 // GetName returns the name of the system operator.
 func (a *Split) GetName() string { return "Split" }
 
+// GetName returns the name of the system operator.
+func (a *Matches) GetName() string { return "Matches" }
+
+// GetName returns the name of the system operator.
+func (a *Upper) GetName() string { return "Upper" }
+
+// GetName returns the name of the system operator.
+func (a *Lower) GetName() string { return "Lower" }
+
+// GetName returns the name of the system operator.
+func (a *Length) GetName() string { return "Length" }
+
+// GetName returns the name of the system operator.
+func (a *PositionOf) GetName() string { return "PositionOf" }
+
+// GetName returns the name of the system operator.
+func (a *LastPositionOf) GetName() string { return "LastPositionOf" }
+
+// GetName returns the name of the system operator.
+func (a *StartsWith) GetName() string { return "StartsWith" }
+
+// GetName returns the name of the system operator.
+func (a *EndsWith) GetName() string { return "EndsWith" }
+
 // GetName returns the name of the system operator.
 func (a *Combine) GetName() string { return "Combine" }
 
+// GetName returns the name of the system operator.
+func (a *ReplaceMatches) GetName() string { return "ReplaceMatches" }
+
+// GetName returns the name of the system operator.
+func (a *Substring) GetName() string { return "Substring" }
+
+// GetName returns the name of the system operator.
+func (a *Slice) GetName() string { return "Slice" }
+
+// GetName returns the name of the system operator.
+func (a *Round) GetName() string { return "Round" }
+
 // GetName returns the name of the system operator.
 func (i *Indexer) GetName() string { return "Indexer" }
 
 // GetName returns the name of the system operator.
 func (m *Median) GetName() string { return "Median" }
+
+// GetName returns the name of the system operator.
+func (m *Mode) GetName() string { return "Mode" }
+
+// GetName returns the name of the system operator.
+func (p *PopulationVariance) GetName() string { return "PopulationVariance" }
+
+// GetName returns the name of the system operator.
+func (p *PopulationStdDev) GetName() string { return "PopulationStdDev" }
+
+// GetName returns the name of the system operator.
+func (s *StdDev) GetName() string { return "StdDev" }
+
+// GetName returns the name of the system operator.
+func (v *Variance) GetName() string { return "Variance" }
+
+// GetName returns the name of the system operator.
+func (g *GeometricMean) GetName() string { return "GeometricMean" }
+
+// GetName returns the name of the system operator.
+func (p *Product) GetName() string { return "Product" }
@@ -82,10 +82,16 @@ func (v *visitor) VisitExpression(tree antlr.Tree) model.IExpression {
 		m = v.VisitEqualityExpression(t)
 	case *cql.InequalityExpressionContext:
 		m = v.VisitInequalityExpression(t)
+	case *cql.BetweenExpressionContext:
+		m = v.VisitBetweenExpression(t)
 	case *cql.DifferenceBetweenExpressionContext:
 		m = v.VisitDifferenceBetweenExpression(t)
+	case *cql.DurationBetweenExpressionContext:
+		m = v.VisitDurationBetweenExpression(t)
 	case *cql.InvocationExpressionTermContext:
 		m = v.VisitInvocationExpressionTerm(t)
+	case *cql.ThisInvocationContext:
+		m = v.VisitThisInvocation(t)
 	case *cql.TimingExpressionContext:
 		m = v.VisitTimingExpression(t)
 	case *cql.AndExpressionContext:
@@ -124,6 +130,14 @@ func (v *visitor) VisitExpression(tree antlr.Tree) model.IExpression {
 		m = v.VisitElementExtractorExpressionTerm(t)
 	case *cql.IndexedExpressionTermContext:
 		m = v.VisitIndexedExpressionTermContext(t)
+	case *cql.AggregateExpressionTermContext:
+		m = v.VisitAggregateExpressionTerm(t)
+	case *cql.WidthExpressionTermContext:
+		m = v.VisitWidthExpressionTerm(t)
+	case *cql.SetAggregateExpressionTermContext:
+		m = v.VisitSetAggregateExpressionTerm(t)
+	case *cql.PointExtractorExpressionTermContext:
+		m = v.VisitPointExtractorExpressionTerm(t)
 
 		// All cases that have a single child and recurse to the child are handled below. For example in
 		// the CQL grammar the only child of QueryExpression is Query, so QueryExpression can be handled
@@ -169,23 +183,28 @@ func (v *visitor) VisitParenthesizedTerm(ctx *cql.ParenthesizedTermContext) mode
 }
 
 func (v *visitor) VisitTimeUnitExpressionTerm(ctx *cql.TimeUnitExpressionTermContext) model.IExpression {
-	// parses statements like: "date from expression"
-	// TODO: b/301606416 - Implement time units where left is dateTimePrecision.
+	// parses statements like: "date from expression" or "year from expression".
 	dtc := ctx.GetChild(0).(*cql.DateTimeComponentContext)
+	var op string
 	switch component := dtc.GetChild(0).(type) {
+	case *cql.DateTimePrecisionContext:
+		op = funcNameWithPrecision("DateTimeComponentFrom", stringToPrecision(component.GetText()))
 	case antlr.TerminalNode:
-		dateTimeComponent := component.GetText()
-		switch dateTimeComponent {
+		switch component.GetText() {
 		case "date":
-			return &model.ToDate{
-				UnaryExpression: &model.UnaryExpression{
-					Operand:    v.VisitExpression(ctx.ExpressionTerm()),
-					Expression: model.ResultType(types.Date),
-				},
-			}
+			op = "DateFrom"
+		case "time":
+			op = "TimeFrom"
 		}
 	}
-	return v.badExpression(fmt.Sprintf("unsupported date time component conversion (e.g. X in 'X from expression'). got: %s, only %v supported", dtc.GetText(), "date"), ctx)
+	if op == "" {
+		return v.badExpression(fmt.Sprintf("unsupported date time component conversion (e.g. X in 'X from expression'). got: %s, only %v supported", dtc.GetText(), "date, time, year, month, day, hour, minute, second and millisecond"), ctx)
+	}
+	m, err := v.parseFunction("", op, []antlr.Tree{ctx.ExpressionTerm()}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
 }
 
 func (v *visitor) VisitTupleSelectorTerm(ctx *cql.TupleSelectorTermContext) model.IExpression {
@@ -880,6 +899,9 @@ func (v *visitor) VisitInvocationExpressionTerm(ctx *cql.InvocationExpressionTer
 	case *cql.QualifiedFunctionContext:
 		// Case 3, fluent functions
 		name := v.parseIdentifierOrFuntionIdentifier(r.IdentifierOrFunctionIdentifier())
+		if name == "repeat" {
+			return v.parseRepeat(expr, r.ParamList(), ctx)
+		}
 
 		// Prepend expr as the first argument to the function call.
 		params := []antlr.Tree{expr}
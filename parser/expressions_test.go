@@ -25,6 +25,7 @@ import (
 	"github.com/google/cql/model"
 	"github.com/google/cql/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/lithammer/dedent"
 )
 
@@ -747,13 +748,45 @@ func TestParserExpressions(t *testing.T) {
 		{
 			name: "Time Unit Expression, 'date from'",
 			cql:  dedent.Dedent(`date from @2013-01-01T00:00:00.0`),
-			want: &model.ToDate{
+			want: &model.DateFrom{
 				UnaryExpression: &model.UnaryExpression{
 					Operand:    model.NewLiteral("@2013-01-01T00:00:00.0", types.DateTime),
 					Expression: model.ResultType(types.Date),
 				},
 			},
 		},
+		{
+			name: "Time Unit Expression, 'time from'",
+			cql:  dedent.Dedent(`time from @2013-01-01T00:00:00.0`),
+			want: &model.TimeFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("@2013-01-01T00:00:00.0", types.DateTime),
+					Expression: model.ResultType(types.Time),
+				},
+			},
+		},
+		{
+			name: "Time Unit Expression, 'year from'",
+			cql:  dedent.Dedent(`year from @2013-01-01`),
+			want: &model.DateTimeComponentFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("@2013-01-01", types.Date),
+					Expression: model.ResultType(types.Integer),
+				},
+				Precision: model.YEAR,
+			},
+		},
+		{
+			name: "Time Unit Expression, 'millisecond from'",
+			cql:  dedent.Dedent(`millisecond from @T10:30:00.500`),
+			want: &model.DateTimeComponentFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("@T10:30:00.500", types.Time),
+					Expression: model.ResultType(types.Integer),
+				},
+				Precision: model.MILLISECOND,
+			},
+		},
 		{
 			name: "Tuple Selector",
 			cql:  "Tuple{code: 'foo', id: 4}",
@@ -1051,7 +1084,7 @@ func TestParserExpressions_SingleLibrary(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(test.want, parsedLibs[0]); diff != "" {
+			if diff := cmp.Diff(test.want, parsedLibs[0], cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("Parse diff (-want +got):\n%s", diff)
 			}
 		})
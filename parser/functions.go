@@ -127,9 +127,35 @@ func (v *visitor) VisitFunction(ctx *cql.FunctionContext) model.IExpression {
 			params = append(params, expr)
 		}
 	}
+	// Count(distinct X) is parsed as Count(X) with the Distinct flag set. For other functions
+	// distinct X is an ordinary operand, which is parsed as Distinct(X).
+	distinct := false
+	if name == "Count" && len(params) == 1 {
+		if operand, ok := distinctOperand(params[0]); ok {
+			params[0] = operand
+			distinct = true
+		}
+	}
 	m, err := v.parseFunction("", name, params, false)
 	if err != nil {
 		return v.badExpression(err.Error(), ctx)
 	}
+	if c, ok := m.(*model.Count); ok && distinct {
+		c.Distinct = true
+	}
 	return m
 }
+
+// distinctOperand returns the operand X of a `distinct X` expression, and whether the tree was a
+// `distinct X` expression.
+func distinctOperand(tree antlr.Tree) (antlr.Tree, bool) {
+	term, ok := tree.(*cql.TermExpressionContext)
+	if !ok {
+		return nil, false
+	}
+	agg, ok := term.ExpressionTerm().(*cql.AggregateExpressionTermContext)
+	if !ok || agg.GetChild(0).(antlr.TerminalNode).GetText() != "distinct" {
+		return nil, false
+	}
+	return agg.Expression(), true
+}
@@ -434,8 +434,9 @@ func (v *visitor) VisitExpressionDefinition(ctx *cql.ExpressionDefinitionContext
 
 	// Set the return type of the ExpressionDef to the return type of the inner expression, and set
 	// the ExpressionRef's result type.
+	ed.Element = &model.Element{Locator: locator(ctx)}
 	if ed.Expression.GetResultType() != nil {
-		ed.Element = &model.Element{ResultType: ed.Expression.GetResultType()}
+		ed.Element.ResultType = ed.Expression.GetResultType()
 		expRef.Expression = model.ResultType(ed.Expression.GetResultType())
 	}
 
@@ -453,6 +454,19 @@ func (v *visitor) VisitExpressionDefinition(ctx *cql.ExpressionDefinitionContext
 	return ed
 }
 
+// locator returns the position of the parsed context in the CQL source.
+func locator(ctx antlr.ParserRuleContext) *model.Locator {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	// ANTLR columns are 0-based, while ELM locators are 1-based. The end column is the last
+	// character of the stop token.
+	return &model.Locator{
+		StartLine:   start.GetLine(),
+		StartColumn: start.GetColumn() + 1,
+		EndLine:     stop.GetLine(),
+		EndColumn:   stop.GetColumn() + len(stop.GetText()),
+	}
+}
+
 func (v *visitor) VisitContextDefinition(ctx *cql.ContextDefinitionContext) *model.ExpressionDef {
 	cname := v.VisitIdentifier(ctx.Identifier())
 	var ed *model.ExpressionDef
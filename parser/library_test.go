@@ -25,6 +25,7 @@ import (
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/lithammer/dedent"
 )
 
@@ -181,7 +182,7 @@ func TestMalformedCQLSingleLibrary(t *testing.T) {
 			define "Has coronary heart disease":
 				exists (
 					[Condition] c
-						where c.onset includes start of Interval[@2013-01-01T00:00:00.0, @2014-01-01T00:00:00.0)
+						where c.onset within 3 days of Interval[@2013-01-01T00:00:00.0, @2014-01-01T00:00:00.0)
 				)`),
 			errContains: []string{"unsupported interval operator in timing expression"},
 			errCount:    1,
@@ -207,7 +208,7 @@ func TestMalformedCQLSingleLibrary(t *testing.T) {
 			name: "Invalid Expression",
 			cql: dedent.Dedent(`
 			using FHIR version '4.0.1'
-			define "Param": expand 4
+			define "Param": %foo
 				`),
 			errContains: []string{"unsupported expression"},
 			errCount:    1,
@@ -547,7 +548,7 @@ func TestParserTopologicalSortMultipleLibraries(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(test.want, got); diff != "" {
+			if diff := cmp.Diff(test.want, got, cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("Parsing diff (-want +got):\n%s", diff)
 			}
 		})
@@ -720,7 +721,7 @@ func TestParserTopologicalSortLibrariesIncludeCorrectVersion(t *testing.T) {
 				}
 				return strings.Compare(a.Identifier.Version, b.Identifier.Version)
 			})
-			if diff := cmp.Diff(test.wantTopLevelLibs, parsedLibs); diff != "" {
+			if diff := cmp.Diff(test.wantTopLevelLibs, parsedLibs, cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("%v\nLibraries(%v) parsing diff (-want +got):\n%v", test.wantTopLevelLibs, parsedLibs, diff)
 			}
 		})
@@ -1574,7 +1575,7 @@ func TestParserSingleLibrary(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(test.want, parsedLibs[0]); diff != "" {
+			if diff := cmp.Diff(test.want, parsedLibs[0], cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("%v\nLibraries(%s) parsing diff (-want +got):\n%s", test.desc, test.cql, diff)
 			}
 		})
@@ -1906,7 +1907,7 @@ func TestParserMultipleLibraries(t *testing.T) {
 					break
 				}
 			}
-			if diff := cmp.Diff(test.want, gotLib); diff != "" {
+			if diff := cmp.Diff(test.want, gotLib, cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("Parse diff (-want +got):\n%s", diff)
 			}
 		})
@@ -1978,7 +1979,7 @@ func TestParameters(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse Parameters returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tc.want, got); diff != "" {
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("Parse diff (-want +got):\n%s", diff)
 			}
 		})
@@ -2388,6 +2389,11 @@ func TestRealisticCQL(t *testing.T) {
 														Name:       "Blood pressure",
 														Expression: model.ResultType(types.ValueSet),
 													},
+													DateProperty: "effective",
+													DateRange: &model.ParameterRef{
+														Name:       "Measurement Period",
+														Expression: model.ResultType(&types.Interval{PointType: types.DateTime}),
+													},
 													Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}}),
 												},
 												Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}}),
@@ -2615,9 +2621,36 @@ func TestRealisticCQL(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(test.want, parsedLibs[0]); diff != "" {
+			if diff := cmp.Diff(test.want, parsedLibs[0], cmpopts.IgnoreFields(model.Element{}, "Locator")); diff != "" {
 				t.Errorf("Parsing diff (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestExpressionDefLocator(t *testing.T) {
+	cql := dedent.Dedent(`
+	library Highly.Qualified version '1.0'
+	define Single: 1
+	define MultiLine:
+	  Sum({1, 2})`)
+	want := []*model.Locator{
+		{StartLine: 3, StartColumn: 1, EndLine: 3, EndColumn: 16},
+		{StartLine: 4, StartColumn: 1, EndLine: 5, EndColumn: 13},
+	}
+
+	parsedLibs, err := newFHIRParser(t).Libraries(context.Background(), []string{cql}, Config{})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+	var got []*model.Locator
+	for _, d := range parsedLibs[0].Statements.Defs {
+		got = append(got, d.(*model.ExpressionDef).Locator)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ExpressionDef Locators diff (-want +got):\n%s", diff)
+	}
+	if gotStr := got[1].String(); gotStr != "4:1-5:13" {
+		t.Errorf("Locator.String() = %q, want %q", gotStr, "4:1-5:13")
+	}
+}
@@ -31,7 +31,7 @@ import (
 func (v *visitor) VisitTimingExpression(ctx *cql.TimingExpressionContext) model.IExpression {
 	// TODO(b/298104070): support other interval operator features, and refactor BeforeOrAfterInterval
 	// to its own function.
-	// Need to support the 7 remaining operators in third_party/cql/internal/embeddata/cqframework/Cql.g4
+	// Need to support the 5 remaining operators in third_party/cql/internal/embeddata/cqframework/Cql.g4
 	var fnOperator string
 	var precision model.DateTimePrecision
 	intervalOperator := ctx.GetChild(1)
@@ -76,6 +76,18 @@ func (v *visitor) VisitTimingExpression(ctx *cql.TimingExpressionContext) model.
 	case *cql.IncludedInIntervalOperatorPhraseContext:
 		precision = precisionFromContext(operator)
 		fnOperator = "IncludedIn"
+		if hasTerminalChild(operator, "properly") {
+			fnOperator = "ProperIncludedIn"
+		}
+	case *cql.IncludesIntervalOperatorPhraseContext:
+		if hasTerminalChild(operator, "start") || hasTerminalChild(operator, "end") {
+			return v.badExpression("includes start and includes end are not supported", ctx)
+		}
+		precision = precisionFromContext(operator)
+		fnOperator = "Includes"
+		if hasTerminalChild(operator, "properly") {
+			fnOperator = "ProperIncludes"
+		}
 	case *cql.ConcurrentWithIntervalOperatorPhraseContext:
 		precision = precisionFromContext(operator)
 		// TODO(b/298104070): Support ConcurrentWithIntervalOperatorPhraseContext without 'or'
@@ -94,14 +106,25 @@ func (v *visitor) VisitTimingExpression(ctx *cql.TimingExpressionContext) model.
 	case *cql.OverlapsIntervalOperatorPhraseContext:
 		precision = precisionFromContext(operator)
 		fnOperator = "Overlaps"
-		opText := operator.GetText()
-		containsAfter := strings.Contains(opText, "after")
-		containsBefore := strings.Contains(opText, "before")
-		if containsAfter {
-			return v.badExpression("overlaps after operator is not supported", ctx)
-		} else if containsBefore {
-			return v.badExpression("overlaps before operator is not supported", ctx)
+		if hasTerminalChild(operator, "before") {
+			fnOperator = "OverlapsBefore"
+		} else if hasTerminalChild(operator, "after") {
+			fnOperator = "OverlapsAfter"
+		}
+	case *cql.MeetsIntervalOperatorPhraseContext:
+		precision = precisionFromContext(operator)
+		fnOperator = "Meets"
+		if hasTerminalChild(operator, "before") {
+			fnOperator = "MeetsBefore"
+		} else if hasTerminalChild(operator, "after") {
+			fnOperator = "MeetsAfter"
 		}
+	case *cql.StartsIntervalOperatorPhraseContext:
+		precision = precisionFromContext(operator)
+		fnOperator = "Starts"
+	case *cql.EndsIntervalOperatorPhraseContext:
+		precision = precisionFromContext(operator)
+		fnOperator = "Ends"
 	default:
 		return v.badExpression("unsupported interval operator in timing expression", ctx)
 	}
@@ -119,7 +142,8 @@ func (v *visitor) VisitTimingExpression(ctx *cql.TimingExpressionContext) model.
 	// here already wrapped in an `end` ANTLR node.
 	// Only some intervalOperatorPhrase expressions may optionally start with starts, ends, or occurs:
 	// https://cql.hl7.org/19-l-cqlsyntaxdiagrams.html#intervalOperatorPhrase
-	if n, ok := intervalOperator.GetChild(0).(antlr.TerminalNode); ok {
+	// For the starts and ends operators the terminal is the operator itself, so is skipped.
+	if n, ok := intervalOperator.GetChild(0).(antlr.TerminalNode); ok && !isStartsOrEndsPhrase(intervalOperator) {
 		be, ok := m.(model.IBinaryExpression)
 		if !ok {
 			return v.badExpression("internal error -- timing expression did not produce a BinaryExpression", ctx)
@@ -149,6 +173,26 @@ func (v *visitor) VisitTimingExpression(ctx *cql.TimingExpressionContext) model.
 	return m
 }
 
+// isStartsOrEndsPhrase returns true if the interval operator phrase is the starts or ends operator,
+// rather than a phrase that is prefixed by starts or ends.
+func isStartsOrEndsPhrase(intervalOperator antlr.Tree) bool {
+	switch intervalOperator.(type) {
+	case *cql.StartsIntervalOperatorPhraseContext, *cql.EndsIntervalOperatorPhraseContext:
+		return true
+	}
+	return false
+}
+
+// hasTerminalChild returns true if one of the direct children of ctx is the terminal text.
+func hasTerminalChild(ctx antlr.ParserRuleContext, text string) bool {
+	for _, child := range ctx.GetChildren() {
+		if n, ok := child.(antlr.TerminalNode); ok && n.GetText() == text {
+			return true
+		}
+	}
+	return false
+}
+
 // constructRelativeOffsetModel constructs a custom In model when a relative offset operator exists.
 // We only perform these operations in some cases for the beforeOrAfterIntervalOperatorPhrase all
 // other operators shouldn't set the relativeOffset. In cases where the arguments are not temporal
@@ -427,6 +471,29 @@ func (v *visitor) VisitInequalityExpression(ctx *cql.InequalityExpressionContext
 	return m
 }
 
+// VisitBetweenExpression parses X between Lo and Hi as X >= Lo and X <= Hi, and X properly between
+// Lo and Hi as X > Lo and X < Hi, matching the ELM produced by the reference translator.
+func (v *visitor) VisitBetweenExpression(ctx *cql.BetweenExpressionContext) model.IExpression {
+	lowOp, highOp := "GreaterOrEqual", "LessOrEqual"
+	if ctx.GetChild(1).(antlr.TerminalNode).GetText() == "properly" {
+		lowOp, highOp = "Greater", "Less"
+	}
+	operand := v.VisitExpression(ctx.Expression())
+	low, err := v.resolveFunction("", lowOp, []model.IExpression{operand, v.VisitExpression(ctx.ExpressionTerm(0))}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	high, err := v.resolveFunction("", highOp, []model.IExpression{operand, v.VisitExpression(ctx.ExpressionTerm(1))}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	m, err := v.resolveFunction("", "And", []model.IExpression{low, high}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
 func (v *visitor) VisitBooleanExpressionContext(ctx *cql.BooleanExpressionContext) model.IExpression {
 	not := false
 	var is string
@@ -644,6 +711,19 @@ func (v *visitor) VisitDifferenceBetweenExpression(ctx *cql.DifferenceBetweenExp
 	return m
 }
 
+func (v *visitor) VisitDurationBetweenExpression(ctx *cql.DurationBetweenExpressionContext) model.IExpression {
+	precision := stringToPrecision(pluralToSingularDateTimePrecision(ctx.PluralDateTimePrecision().GetText()))
+	op := "DurationBetween"
+	if precision != "" {
+		op = funcNameWithPrecision(op, precision)
+	}
+	m, err := v.parseFunction("", op, []antlr.Tree{ctx.ExpressionTerm(0), ctx.ExpressionTerm(1)}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
 func (v *visitor) VisitPolarityExpressionTerm(ctx *cql.PolarityExpressionTermContext) model.IExpression {
 	if ctx.GetChild(0).(antlr.TerminalNode).GetText() == "+" {
 		return v.VisitExpression(ctx.ExpressionTerm())
@@ -681,6 +761,70 @@ func (v *visitor) VisitSuccessorExpressionTerm(ctx *cql.SuccessorExpressionTermC
 	return m
 }
 
+func (v *visitor) VisitWidthExpressionTerm(ctx *cql.WidthExpressionTermContext) model.IExpression {
+	m, err := v.parseFunction("", "Width", []antlr.Tree{ctx.ExpressionTerm()}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
+func (v *visitor) VisitPointExtractorExpressionTerm(ctx *cql.PointExtractorExpressionTermContext) model.IExpression {
+	m, err := v.parseFunction("", "PointFrom", []antlr.Tree{ctx.ExpressionTerm()}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
+func (v *visitor) VisitSetAggregateExpressionTerm(ctx *cql.SetAggregateExpressionTermContext) model.IExpression {
+	op := ctx.GetChild(0).(antlr.TerminalNode).GetText()
+	var name string
+	switch op {
+	case "collapse":
+		name = "Collapse"
+	case "expand":
+		name = "Expand"
+	default:
+		return v.badExpression(fmt.Sprintf("unsupported set aggregate expression term %v", op), ctx)
+	}
+
+	operands := []model.IExpression{v.VisitExpression(ctx.Expression(0))}
+	if ctx.DateTimePrecision() != nil {
+		// per day is shorthand for per 1 day.
+		operands = append(operands, &model.Quantity{
+			Value:      1,
+			Unit:       stringToTimeUnit(ctx.DateTimePrecision().GetText()),
+			Expression: model.ResultType(types.Quantity),
+		})
+	} else if ctx.Expression(1) != nil {
+		operands = append(operands, v.VisitExpression(ctx.Expression(1)))
+	}
+	m, err := v.resolveFunction("", name, operands, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
+func (v *visitor) VisitAggregateExpressionTerm(ctx *cql.AggregateExpressionTermContext) model.IExpression {
+	op := ctx.GetChild(0).(antlr.TerminalNode).GetText()
+	var name string
+	switch op {
+	case "distinct":
+		name = "Distinct"
+	case "flatten":
+		name = "Flatten"
+	default:
+		return v.badExpression(fmt.Sprintf("unsupported aggregate expression term %v", op), ctx)
+	}
+	m, err := v.parseFunction("", name, []antlr.Tree{ctx.Expression()}, false)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+	return m
+}
+
 func (v *visitor) VisitIndexedExpressionTermContext(ctx *cql.IndexedExpressionTermContext) model.IExpression {
 	baseExpr := ctx.ExpressionTerm()
 	m, err := v.parseFunction("", "Indexer", []antlr.Tree{baseExpr, ctx.Expression()}, false)
@@ -590,6 +590,34 @@ func TestOperatorExpressions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Duration in Months Between @2014-01-01 and @2014-02-01",
+			cql:  "duration in months between @2014-01-01 and @2014-02-01",
+			want: &model.DurationBetween{
+				Precision: model.MONTH,
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("@2014-01-01", types.Date),
+						model.NewLiteral("@2014-02-01", types.Date),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Days Between DateTimes",
+			cql:  "days between @2014-01-01T10:00:00 and @2014-02-01T10:00:00",
+			want: &model.DurationBetween{
+				Precision: model.DAY,
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("@2014-01-01T10:00:00", types.DateTime),
+						model.NewLiteral("@2014-02-01T10:00:00", types.DateTime),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
 		{
 			name: "1 included in Interval[0, 5] returns in expresssion",
 			cql:  "1 included in Interval[0, 5]",
@@ -750,6 +778,64 @@ func TestOperatorExpressions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Between",
+			cql:  "5 between 1 and 10",
+			want: &model.And{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.GreaterOrEqual{
+							BinaryExpression: &model.BinaryExpression{
+								Operands: []model.IExpression{
+									model.NewLiteral("5", types.Integer),
+									model.NewLiteral("1", types.Integer),
+								},
+								Expression: model.ResultType(types.Boolean),
+							},
+						},
+						&model.LessOrEqual{
+							BinaryExpression: &model.BinaryExpression{
+								Operands: []model.IExpression{
+									model.NewLiteral("5", types.Integer),
+									model.NewLiteral("10", types.Integer),
+								},
+								Expression: model.ResultType(types.Boolean),
+							},
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "Properly Between",
+			cql:  "5 properly between 1 and 10",
+			want: &model.And{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Greater{
+							BinaryExpression: &model.BinaryExpression{
+								Operands: []model.IExpression{
+									model.NewLiteral("5", types.Integer),
+									model.NewLiteral("1", types.Integer),
+								},
+								Expression: model.ResultType(types.Boolean),
+							},
+						},
+						&model.Less{
+							BinaryExpression: &model.BinaryExpression{
+								Operands: []model.IExpression{
+									model.NewLiteral("5", types.Integer),
+									model.NewLiteral("10", types.Integer),
+								},
+								Expression: model.ResultType(types.Boolean),
+							},
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
 		{
 			name: "Equal",
 			cql:  "1 = 1",
@@ -17,6 +17,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
@@ -77,10 +78,25 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 		t.Severity = resolved.WrappedOperands[3]
 		t.Message = resolved.WrappedOperands[4]
 		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Distinct:
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Skip:
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Tail:
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Take:
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Slice:
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
 	case *model.Except:
 		// For Except the left side is the result type.
 		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
 	case *model.Intersect:
+		if iType, ok := resolved.WrappedOperands[0].GetResultType().(*types.Interval); ok {
+			// Both interval operands have the same point type.
+			t.Expression = model.ResultType(iType)
+			break
+		}
 		listTypeLeft := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		listTypeRight := resolved.WrappedOperands[1].GetResultType().(*types.List)
 		listElemType, err := convert.Intersect(listTypeLeft.ElementType, listTypeRight.ElementType)
@@ -90,30 +106,71 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 		t.Expression = model.ResultType(&types.List{ElementType: listElemType})
 	case *model.Avg:
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
-		t.Expression = model.ResultType(listType.ElementType)
+		switch listType.ElementType {
+		case types.Integer, types.Long:
+			// The average of Integer and Long lists is computed as a Decimal to avoid truncation.
+			t.Expression = model.ResultType(types.Decimal)
+		default:
+			t.Expression = model.ResultType(listType.ElementType)
+		}
 	case *model.Max:
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		t.Expression = model.ResultType(listType.ElementType)
 	case *model.Min:
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		t.Expression = model.ResultType(listType.ElementType)
+	case *model.Mode:
+		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
+		t.Expression = model.ResultType(listType.ElementType)
+	case *model.Product:
+		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
+		t.Expression = model.ResultType(listType.ElementType)
 	case *model.Sum:
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		t.Expression = model.ResultType(listType.ElementType)
 	case *model.Union:
+		if iType, ok := resolved.WrappedOperands[0].GetResultType().(*types.Interval); ok {
+			// Both interval operands have the same point type.
+			t.Expression = model.ResultType(iType)
+			break
+		}
 		listTypeLeft := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		listTypeRight := resolved.WrappedOperands[1].GetResultType().(*types.List)
-		listElemType, err := convert.DeDuplicate([]types.IType{listTypeLeft.ElementType, listTypeRight.ElementType})
-		if err != nil {
-			return nil, err
+		// A null or empty list operand has an element type of Any, and contributes no elements so it
+		// should not widen the result type.
+		var listElemType types.IType
+		switch {
+		case listTypeLeft.ElementType == types.Any:
+			listElemType = listTypeRight.ElementType
+		case listTypeRight.ElementType == types.Any:
+			listElemType = listTypeLeft.ElementType
+		default:
+			var err error
+			listElemType, err = convert.DeDuplicate([]types.IType{listTypeLeft.ElementType, listTypeRight.ElementType})
+			if err != nil {
+				return nil, err
+			}
 		}
 		t.Expression = model.ResultType(&types.List{ElementType: listElemType})
+	case *model.Collapse:
+		resolved.WrappedOperands = withPerOperand(resolved.WrappedOperands)
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.Expand:
+		resolved.WrappedOperands = withPerOperand(resolved.WrappedOperands)
+		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
 	case *model.End:
 		pointType := resolved.WrappedOperands[0].GetResultType().(*types.Interval)
 		t.Expression = model.ResultType(pointType.PointType)
 	case *model.Start:
 		pointType := resolved.WrappedOperands[0].GetResultType().(*types.Interval)
 		t.Expression = model.ResultType(pointType.PointType)
+	case *model.PointFrom:
+		pointType := resolved.WrappedOperands[0].GetResultType().(*types.Interval)
+		t.Expression = model.ResultType(pointType.PointType)
+	case *model.Width:
+		t.Expression = model.ResultType(intervalWidthType(resolved.WrappedOperands[0]))
+	case *model.Size:
+		t.Expression = model.ResultType(intervalWidthType(resolved.WrappedOperands[0]))
 	case *model.First:
 		// First(List<T>) T is a special case because the ResultType is not known until invocation.
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
@@ -122,6 +179,10 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 		// Last(List<T>) T is a special case because the ResultType is not known until invocation.
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		t.Expression = model.ResultType(listType.ElementType)
+	case *model.Flatten:
+		// Flatten(List<List<T>>) List<T> takes the element type of the outer list.
+		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
+		t.Expression = model.ResultType(listType.ElementType)
 	case *model.Indexer:
 		switch opType := resolved.WrappedOperands[0].GetResultType().(type) {
 		case types.System:
@@ -138,30 +199,35 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
 	case *model.Successor:
 		t.Expression = model.ResultType(resolved.WrappedOperands[0].GetResultType())
+	case *model.ToList:
+		// ToList(T) List<T> is a special case because the ResultType is not known until invocation.
+		t.Expression = model.ResultType(&types.List{ElementType: resolved.WrappedOperands[0].GetResultType()})
 	case *model.SingletonFrom:
 		// SingletonFrom(List<T>) T is a special case because the ResultType is not known until invocation.
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
 		t.Expression = model.ResultType(listType.ElementType)
 	case *model.CalculateAge:
-		// AgeInYears() is a special case as it takes 0 operands but the model.CalculateAge has 1
-		// operand, the patient's birthday.
-		bday, err := v.patientBirthDateExpression()
-		if err != nil {
-			return nil, err
-		}
+		if len(resolved.WrappedOperands) == 0 {
+			// AgeInYears() is a special case as it takes 0 operands but the model.CalculateAge has 1
+			// operand, the patient's birthday.
+			bday, err := v.patientBirthDateExpression()
+			if err != nil {
+				return nil, err
+			}
 
-		// Currently the FHIR modelinfo Patient Birthday Expression returns System.Date. However, in
-		// case in the future it returns something else, try to convert to System.DateTime to match the
-		// AgeInYears(DateTime) overload.
-		res, err := convert.OperandImplicitConverter(bday.GetResultType(), types.DateTime, bday, v.modelInfo)
-		if err != nil {
-			return nil, err
-		}
-		if !res.Matched {
-			return nil, fmt.Errorf("internal error - could not implicitly convert the Patient Birthday Expression of type %v to %v", bday.GetResultType(), types.DateTime)
-		}
+			// Currently the FHIR modelinfo Patient Birthday Expression returns System.Date. However, in
+			// case in the future it returns something else, try to convert to System.DateTime to match the
+			// AgeInYears(DateTime) overload.
+			res, err := convert.OperandImplicitConverter(bday.GetResultType(), types.DateTime, bday, v.modelInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !res.Matched {
+				return nil, fmt.Errorf("internal error - could not implicitly convert the Patient Birthday Expression of type %v to %v", bday.GetResultType(), types.DateTime)
+			}
 
-		resolved.WrappedOperands = []model.IExpression{res.WrappedOperand}
+			resolved.WrappedOperands = []model.IExpression{res.WrappedOperand}
+		}
 	case *model.CalculateAgeAt:
 		if len(resolved.WrappedOperands) == 1 {
 			// AgeInYearsAt(asOf Date) is a special case as it takes 1 operand but the
@@ -186,7 +252,14 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 		}
 	case *model.Median:
 		listType := resolved.WrappedOperands[0].GetResultType().(*types.List)
-		t.Expression = model.ResultType(listType.ElementType)
+		switch listType.ElementType {
+		case types.Integer, types.Long:
+			// The median of an even length list may fall between two elements, so the median of
+			// Integer and Long lists is a Decimal.
+			t.Expression = model.ResultType(types.Decimal)
+		default:
+			t.Expression = model.ResultType(listType.ElementType)
+		}
 	}
 
 	// Set Operands.
@@ -213,6 +286,33 @@ func (v *visitor) resolveFunction(libraryName, funcName string, operands []model
 	return r, nil
 }
 
+// withPerOperand adds a null Quantity per operand for Collapse and Expand if per was not specified.
+func withPerOperand(operands []model.IExpression) []model.IExpression {
+	if len(operands) == 2 {
+		return operands
+	}
+	per := &model.As{
+		UnaryExpression: &model.UnaryExpression{
+			Operand:    model.NewLiteral("null", types.Any),
+			Expression: model.ResultType(types.Quantity),
+		},
+		AsTypeSpecifier: types.Quantity,
+	}
+	return append(operands, per)
+}
+
+// intervalWidthType returns the result type of Width and Size for the given interval operand. The
+// width of a numeric interval has the point type, while the width of a Date, DateTime or Time
+// interval is a Quantity.
+func intervalWidthType(operand model.IExpression) types.IType {
+	pointType := operand.GetResultType().(*types.Interval).PointType
+	switch pointType {
+	case types.Date, types.DateTime, types.Time:
+		return types.Quantity
+	}
+	return pointType
+}
+
 // loadSystemOperators defines all CQL System Operators in the reference resolver. The operands
 // are not set here, but are instead set when we parse the function invocation in VisitFunction. For
 // some System Operators like Last(List<T>) T we also set the return type in VisitFunction as the
@@ -414,6 +514,19 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "ToRatio",
+			operands: [][]types.IType{
+				{types.Ratio},
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ToRatio{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Ratio),
+					},
+				}
+			},
+		},
 		{
 			name: "ToConcept",
 			operands: [][]types.IType{
@@ -440,7 +553,8 @@ func (p *Parser) loadSystemOperators() error {
 				{types.Date},
 				{types.DateTime},
 				{types.Time},
-				{types.Boolean}},
+				{types.Boolean},
+				{types.Code}},
 			model: func() model.IExpression {
 				return &model.ToString{
 					UnaryExpression: &model.UnaryExpression{
@@ -462,6 +576,148 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "ConvertsToBoolean",
+			operands: [][]types.IType{
+				{types.Decimal},
+				{types.Long},
+				{types.Integer},
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToBoolean{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToDate",
+			operands: [][]types.IType{
+				{types.DateTime},
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToDate{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToDateTime",
+			operands: [][]types.IType{
+				{types.Date},
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToDateTime{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToDecimal",
+			operands: [][]types.IType{
+				{types.Long},
+				{types.Integer},
+				{types.String},
+				{types.Boolean}},
+			model: func() model.IExpression {
+				return &model.ConvertsToDecimal{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToInteger",
+			operands: [][]types.IType{
+				{types.Long},
+				{types.String},
+				{types.Boolean}},
+			model: func() model.IExpression {
+				return &model.ConvertsToInteger{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToLong",
+			operands: [][]types.IType{
+				{types.Integer},
+				{types.String},
+				{types.Boolean}},
+			model: func() model.IExpression {
+				return &model.ConvertsToLong{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToQuantity",
+			operands: [][]types.IType{
+				{types.Decimal},
+				{types.Integer},
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToQuantity{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToRatio",
+			operands: [][]types.IType{
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToRatio{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToString",
+			operands: [][]types.IType{
+				{types.Boolean},
+				{types.Integer},
+				{types.Long},
+				{types.Decimal},
+				{types.Quantity},
+				{types.Ratio},
+				{types.Date},
+				{types.DateTime},
+				{types.Time}},
+			model: func() model.IExpression {
+				return &model.ConvertsToString{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ConvertsToTime",
+			operands: [][]types.IType{
+				{types.String}},
+			model: func() model.IExpression {
+				return &model.ConvertsToTime{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
 		// NULLOGICAL OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#nullological-operators-3
 		{
 			name: "Coalesce",
@@ -713,6 +969,17 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name:     "Log",
+			operands: [][]types.IType{{types.Decimal, types.Decimal}},
+			model: func() model.IExpression {
+				return &model.Log{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
+				}
+			},
+		},
 		{
 			name:     "Negate",
 			operands: [][]types.IType{{types.Integer}},
@@ -748,6 +1015,20 @@ func (p *Parser) loadSystemOperators() error {
 			operands: [][]types.IType{{types.Time}},
 			model:    precisionModel(),
 		},
+		{
+			name: "Round",
+			operands: [][]types.IType{
+				{types.Decimal},
+				{types.Decimal, types.Integer},
+			},
+			model: func() model.IExpression {
+				return &model.Round{
+					NaryExpression: &model.NaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
+				}
+			},
+		},
 		{
 			name:     "Subtract",
 			operands: [][]types.IType{{types.Integer, types.Integer}},
@@ -966,45 +1247,173 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
-		// DATE AND TIME OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#datetime-operators-2
 		{
-			name:     "Add",
-			operands: [][]types.IType{{types.Date, types.Quantity}},
-			model:    addModel(types.Date),
+			name: "Substring",
+			operands: [][]types.IType{
+				{types.String, types.Integer},
+				{types.String, types.Integer, types.Integer},
+			},
+			model: func() model.IExpression {
+				return &model.Substring{
+					NaryExpression: &model.NaryExpression{
+						Expression: model.ResultType(types.String),
+					},
+				}
+			},
 		},
 		{
-			name:     "Add",
-			operands: [][]types.IType{{types.DateTime, types.Quantity}},
-			model:    addModel(types.DateTime),
+			name:     "Upper",
+			operands: [][]types.IType{{types.String}},
+			model: func() model.IExpression {
+				return &model.Upper{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.String),
+					},
+				}
+			},
 		},
 		{
-			name:     "Add",
-			operands: [][]types.IType{{types.Time, types.Quantity}},
-			model:    addModel(types.Time),
+			name:     "Lower",
+			operands: [][]types.IType{{types.String}},
+			model: func() model.IExpression {
+				return &model.Lower{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.String),
+					},
+				}
+			},
 		},
 		{
-			name: "After",
-			// See generatePrecisionTimingOverloads() for more overloads.
+			name: "Length",
 			operands: [][]types.IType{
-				[]types.IType{types.Date, types.Date},
-				[]types.IType{types.DateTime, types.DateTime},
-				[]types.IType{types.Time, types.Time},
+				{types.String},
+				{&types.List{ElementType: types.Any}},
 			},
 			model: func() model.IExpression {
-				return &model.After{
-					BinaryExpression: &model.BinaryExpression{
-						Expression: model.ResultType(types.Boolean),
+				return &model.Length{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Integer),
 					},
 				}
 			},
 		},
 		{
-			name: "Before",
-			// See generatePrecisionTimingOverloads() for more overloads.
+			name: "Matches",
 			operands: [][]types.IType{
-				[]types.IType{types.Date, types.Date},
-				[]types.IType{types.DateTime, types.DateTime},
-				[]types.IType{types.Time, types.Time},
+				{types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.Matches{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "PositionOf",
+			operands: [][]types.IType{
+				{types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.PositionOf{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Integer),
+					},
+				}
+			},
+		},
+		{
+			name: "LastPositionOf",
+			operands: [][]types.IType{
+				{types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.LastPositionOf{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Integer),
+					},
+				}
+			},
+		},
+		{
+			name: "StartsWith",
+			operands: [][]types.IType{
+				{types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.StartsWith{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "EndsWith",
+			operands: [][]types.IType{
+				{types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.EndsWith{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "ReplaceMatches",
+			operands: [][]types.IType{
+				{types.String, types.String, types.String},
+			},
+			model: func() model.IExpression {
+				return &model.ReplaceMatches{
+					NaryExpression: &model.NaryExpression{
+						Expression: model.ResultType(types.String),
+					},
+				}
+			},
+		},
+		// DATE AND TIME OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#datetime-operators-2
+		{
+			name:     "Add",
+			operands: [][]types.IType{{types.Date, types.Quantity}},
+			model:    addModel(types.Date),
+		},
+		{
+			name:     "Add",
+			operands: [][]types.IType{{types.DateTime, types.Quantity}},
+			model:    addModel(types.DateTime),
+		},
+		{
+			name:     "Add",
+			operands: [][]types.IType{{types.Time, types.Quantity}},
+			model:    addModel(types.Time),
+		},
+		{
+			name: "After",
+			// See generatePrecisionTimingOverloads() for more overloads.
+			operands: [][]types.IType{
+				[]types.IType{types.Date, types.Date},
+				[]types.IType{types.DateTime, types.DateTime},
+				[]types.IType{types.Time, types.Time},
+			},
+			model: func() model.IExpression {
+				return &model.After{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "Before",
+			// See generatePrecisionTimingOverloads() for more overloads.
+			operands: [][]types.IType{
+				[]types.IType{types.Date, types.Date},
+				[]types.IType{types.DateTime, types.DateTime},
+				[]types.IType{types.Time, types.Time},
 			},
 			model: func() model.IExpression {
 				return &model.Before{
@@ -1049,6 +1458,28 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name:     "DateFrom",
+			operands: [][]types.IType{{types.DateTime}},
+			model: func() model.IExpression {
+				return &model.DateFrom{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Date),
+					},
+				}
+			},
+		},
+		{
+			name:     "TimeFrom",
+			operands: [][]types.IType{{types.DateTime}},
+			model: func() model.IExpression {
+				return &model.TimeFrom{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Time),
+					},
+				}
+			},
+		},
 		{
 			name:     "Now",
 			operands: [][]types.IType{{}},
@@ -1180,6 +1611,30 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "Collapse",
+			operands: [][]types.IType{
+				{&types.List{ElementType: &types.Interval{PointType: types.Integer}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Integer}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Long}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Long}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Date}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Date}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Time}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Time}}, types.Quantity},
+			},
+			model: func() model.IExpression {
+				return &model.Collapse{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
 		{
 			name: "Contains",
 			// Contains is a macro for the In operator but with the operands reversed.
@@ -1215,6 +1670,39 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "Expand",
+			operands: [][]types.IType{
+				{&types.List{ElementType: &types.Interval{PointType: types.Integer}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Integer}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Long}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Long}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Decimal}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Quantity}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Date}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Date}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.DateTime}}, types.Quantity},
+				{&types.List{ElementType: &types.Interval{PointType: types.Time}}},
+				{&types.List{ElementType: &types.Interval{PointType: types.Time}}, types.Quantity},
+			},
+			model: func() model.IExpression {
+				return &model.Expand{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "Except",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.Except{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
 		{
 			name: "In",
 			operands: [][]types.IType{
@@ -1227,6 +1715,7 @@ func (p *Parser) loadSystemOperators() error {
 				[]types.IType{types.Date, &types.Interval{PointType: types.Date}},
 				[]types.IType{types.DateTime, &types.Interval{PointType: types.DateTime}},
 				[]types.IType{types.Time, &types.Interval{PointType: types.Time}},
+				[]types.IType{types.Code, types.Concept},
 			},
 			model: func() model.IExpression {
 				return &model.In{
@@ -1304,6 +1793,8 @@ func (p *Parser) loadSystemOperators() error {
 				[]types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
 				[]types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
 				[]types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+				// op (left List<T>, right List<T>) Boolean
+				[]types.IType{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}},
 			},
 			model: func() model.IExpression {
 				return &model.IncludedIn{
@@ -1448,11 +1939,103 @@ func (p *Parser) loadSystemOperators() error {
 			model: inModel(model.MILLISECOND),
 		},
 		{
-			name: "Overlaps",
+			name: "Includes",
 			operands: [][]types.IType{
+				// op (left Interval<T>, right Interval<T>) Boolean
+				[]types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+				[]types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+				[]types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+				[]types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+				[]types.IType{&types.Interval{PointType: types.String}, &types.Interval{PointType: types.String}},
 				[]types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
 				[]types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+				[]types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+			},
+			model: func() model.IExpression {
+				return &model.Includes{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		// Includes for point type overloads is a macro for the Contains operator.
+		{
+			name: "Includes",
+			operands: [][]types.IType{
+				// op (left Interval<T>, right T) Boolean
+				[]types.IType{&types.Interval{PointType: types.Integer}, types.Integer},
+				[]types.IType{&types.Interval{PointType: types.Long}, types.Long},
+				[]types.IType{&types.Interval{PointType: types.Decimal}, types.Decimal},
+				[]types.IType{&types.Interval{PointType: types.Quantity}, types.Quantity},
+				[]types.IType{&types.Interval{PointType: types.String}, types.String},
+				[]types.IType{&types.Interval{PointType: types.Date}, types.Date},
+				[]types.IType{&types.Interval{PointType: types.DateTime}, types.DateTime},
+				[]types.IType{&types.Interval{PointType: types.Time}, types.Time},
+			},
+			model: func() model.IExpression {
+				return &model.Contains{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "Intersect",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.Intersect{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "Meets",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.Meets{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "MeetsBefore",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.MeetsBefore{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
 			},
+		},
+		{
+			name:     "MeetsAfter",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.MeetsAfter{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "Starts",
+			operands: startsEndsOverloads,
+			model:    startsModel(model.UNSETDATETIMEPRECISION),
+		},
+		{
+			name:     "Ends",
+			operands: startsEndsOverloads,
+			model:    endsModel(model.UNSETDATETIMEPRECISION),
+		},
+		{
+			name:     "Overlaps",
+			operands: orderedIntervalOverloads,
 			model: func() model.IExpression {
 				return &model.Overlaps{
 					BinaryExpression: &model.BinaryExpression{
@@ -1461,6 +2044,39 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name:     "OverlapsBefore",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.OverlapsBefore{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "OverlapsAfter",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.OverlapsAfter{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "PointFrom",
+			operands: [][]types.IType{
+				{&types.Interval{PointType: types.Any}},
+			},
+			model: func() model.IExpression {
+				return &model.PointFrom{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
 		{
 			name: "SameOrAfter",
 			// See generatePrecisionTimingOverloads() for more overloads.
@@ -1496,7 +2112,62 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "Width",
+			operands: [][]types.IType{
+				{&types.Interval{PointType: types.Integer}},
+				{&types.Interval{PointType: types.Long}},
+				{&types.Interval{PointType: types.Decimal}},
+				{&types.Interval{PointType: types.Quantity}},
+				{&types.Interval{PointType: types.Date}},
+				{&types.Interval{PointType: types.DateTime}},
+				{&types.Interval{PointType: types.Time}},
+				{&types.Interval{PointType: types.Any}},
+			},
+			model: func() model.IExpression {
+				return &model.Width{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name: "Size",
+			operands: [][]types.IType{
+				{&types.Interval{PointType: types.Integer}},
+				{&types.Interval{PointType: types.Long}},
+				{&types.Interval{PointType: types.Decimal}},
+				{&types.Interval{PointType: types.Quantity}},
+				{&types.Interval{PointType: types.Date}},
+				{&types.Interval{PointType: types.DateTime}},
+				{&types.Interval{PointType: types.Time}},
+				{&types.Interval{PointType: types.Any}},
+			},
+			model: func() model.IExpression {
+				return &model.Size{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "Union",
+			operands: orderedIntervalOverloads,
+			model: func() model.IExpression {
+				return &model.Union{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
 		// LIST OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#list-operators-2
+		{
+			name: "Distinct",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Any}}},
+			model: func() model.IExpression {
+				return &model.Distinct{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
 		{
 			name:     "Except",
 			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
@@ -1528,6 +2199,27 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "Flatten",
+			operands: [][]types.IType{
+				{&types.List{ElementType: &types.List{ElementType: types.Any}}}},
+			model: func() model.IExpression {
+				return &model.Flatten{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "IndexOf",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, types.Any}},
+			model: func() model.IExpression {
+				return &model.IndexOf{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Integer),
+					},
+				}
+			},
+		},
 		{
 			name:     "Intersect",
 			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
@@ -1547,6 +2239,48 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name:     "Includes",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
+			model: func() model.IExpression {
+				return &model.Includes{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "ProperIncludedIn",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
+			model: func() model.IExpression {
+				return &model.ProperIncludedIn{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "ProperIncludes",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
+			model: func() model.IExpression {
+				return &model.ProperIncludes{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name:     "ToList",
+			operands: [][]types.IType{{types.Any}},
+			model: func() model.IExpression {
+				return &model.ToList{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
 		{
 			name: "SingletonFrom",
 			operands: [][]types.IType{
@@ -1557,6 +2291,43 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name:     "Skip",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, types.Integer}},
+			model: func() model.IExpression {
+				return &model.Skip{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
+		{
+			name: "Tail",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Any}}},
+			model: func() model.IExpression {
+				return &model.Tail{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "Take",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, types.Integer}},
+			model: func() model.IExpression {
+				return &model.Take{
+					BinaryExpression: &model.BinaryExpression{},
+				}
+			},
+		},
+		{
+			name:     "Slice",
+			operands: [][]types.IType{{&types.List{ElementType: types.Any}, types.Integer, types.Integer}},
+			model: func() model.IExpression {
+				return &model.Slice{
+					NaryExpression: &model.NaryExpression{},
+				}
+			},
+		},
 		{
 			name:     "Union",
 			operands: [][]types.IType{{&types.List{ElementType: types.Any}, &types.List{ElementType: types.Any}}},
@@ -1597,6 +2368,8 @@ func (p *Parser) loadSystemOperators() error {
 			name: "Avg",
 			operands: [][]types.IType{
 				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
 				{&types.List{ElementType: types.Quantity}},
 			},
 			model: func() model.IExpression {
@@ -1613,32 +2386,130 @@ func (p *Parser) loadSystemOperators() error {
 			model: func() model.IExpression {
 				return &model.Count{
 					UnaryExpression: &model.UnaryExpression{
-						Expression: model.ResultType(types.Integer),
+						Expression: model.ResultType(types.Integer),
+					},
+				}
+			},
+		},
+		{
+			name: "GeometricMean",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+			},
+			model: func() model.IExpression {
+				return &model.GeometricMean{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
+				}
+			},
+		},
+		{
+			name: "Max",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Quantity}},
+				{&types.List{ElementType: types.Date}},
+				{&types.List{ElementType: types.DateTime}},
+				{&types.List{ElementType: types.Time}},
+				{&types.List{ElementType: types.String}},
+			},
+			model: func() model.IExpression {
+				return &model.Max{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name: "Min",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Quantity}},
+				{&types.List{ElementType: types.Date}},
+				{&types.List{ElementType: types.DateTime}},
+				{&types.List{ElementType: types.Time}},
+				{&types.List{ElementType: types.String}},
+			},
+			model: func() model.IExpression {
+				return &model.Min{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name: "Mode",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.String}},
+				{&types.List{ElementType: types.Date}},
+				{&types.List{ElementType: types.DateTime}},
+				{&types.List{ElementType: types.Time}},
+			},
+			model: func() model.IExpression {
+				return &model.Mode{
+					UnaryExpression: &model.UnaryExpression{},
+				}
+			},
+		},
+		{
+			name: "PopulationStdDev",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+			},
+			model: func() model.IExpression {
+				return &model.PopulationStdDev{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
+				}
+			},
+		},
+		{
+			name: "PopulationVariance",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+			},
+			model: func() model.IExpression {
+				return &model.PopulationVariance{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Decimal),
 					},
 				}
 			},
 		},
 		{
-			name: "Max",
+			name: "Product",
 			operands: [][]types.IType{
-				{&types.List{ElementType: types.Date}},
-				{&types.List{ElementType: types.DateTime}},
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
 			},
 			model: func() model.IExpression {
-				return &model.Max{
+				return &model.Product{
 					UnaryExpression: &model.UnaryExpression{},
 				}
 			},
 		},
 		{
-			name: "Min",
+			name: "StdDev",
 			operands: [][]types.IType{
-				{&types.List{ElementType: types.Date}},
-				{&types.List{ElementType: types.DateTime}},
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
 			},
 			model: func() model.IExpression {
-				return &model.Min{
-					UnaryExpression: &model.UnaryExpression{},
+				return &model.StdDev{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
 				}
 			},
 		},
@@ -1656,6 +2527,20 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "Variance",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+			},
+			model: func() model.IExpression {
+				return &model.Variance{
+					UnaryExpression: &model.UnaryExpression{
+						Expression: model.ResultType(types.Decimal),
+					},
+				}
+			},
+		},
 		// CLINICAL OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#clinical-operators-3
 		{
 			name:     "AgeInYears",
@@ -1783,14 +2668,13 @@ func (p *Parser) loadSystemOperators() error {
 		{
 			name: "CalculateAgeInHours",
 			operands: [][]types.IType{
-				{types.Date},
-				{types.DateTime}},
+				{types.DateTime},
+			},
 			model: calculateAgeModel(model.HOUR),
 		},
 		{
 			name: "CalculateAgeInMinutes",
 			operands: [][]types.IType{
-				{types.Date},
 				{types.DateTime},
 			},
 			model: calculateAgeModel(model.MINUTE),
@@ -1798,7 +2682,6 @@ func (p *Parser) loadSystemOperators() error {
 		{
 			name: "CalculateAgeInSeconds",
 			operands: [][]types.IType{
-				{types.Date},
 				{types.DateTime},
 			},
 			model: calculateAgeModel(model.SECOND),
@@ -1888,6 +2771,34 @@ func (p *Parser) loadSystemOperators() error {
 				}
 			},
 		},
+		{
+			name: "AnyInCodeSystem",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Code}, types.CodeSystem},
+				{&types.List{ElementType: types.Concept}, types.CodeSystem},
+			},
+			model: func() model.IExpression {
+				return &model.AnyInCodeSystem{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
+		{
+			name: "AnyInValueSet",
+			operands: [][]types.IType{
+				{&types.List{ElementType: types.Code}, types.ValueSet},
+				{&types.List{ElementType: types.Concept}, types.ValueSet},
+			},
+			model: func() model.IExpression {
+				return &model.AnyInValueSet{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+					},
+				}
+			},
+		},
 		{
 			// ERRORS AND MESSAGING - https://cql.hl7.org/09-b-cqlreference.html#errors-and-messaging
 			// The ELM for `Message` is states that all arguments besides the Source are optional.
@@ -1906,6 +2817,8 @@ func (p *Parser) loadSystemOperators() error {
 			name: "Median",
 			operands: [][]types.IType{
 				{&types.List{ElementType: types.Decimal}},
+				{&types.List{ElementType: types.Integer}},
+				{&types.List{ElementType: types.Long}},
 				{&types.List{ElementType: types.Quantity}},
 			},
 			model: func() model.IExpression {
@@ -1932,9 +2845,45 @@ func (p *Parser) loadSystemOperators() error {
 		return err
 	}
 
+	if err := p.generateDurationBetweenOverloads(); err != nil {
+		return err
+	}
+
+	if err := p.generateDateTimeComponentFromOverloads(); err != nil {
+		return err
+	}
+
+	if err := p.generatePrecisionIntervalOverloads(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// orderedIntervalOverloads are the (left Interval<T>, right Interval<T>) overloads for the interval
+// operators that are defined for any ordered point type.
+var orderedIntervalOverloads = [][]types.IType{
+	[]types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
+	[]types.IType{&types.Interval{PointType: types.Long}, &types.Interval{PointType: types.Long}},
+	[]types.IType{&types.Interval{PointType: types.Decimal}, &types.Interval{PointType: types.Decimal}},
+	[]types.IType{&types.Interval{PointType: types.Quantity}, &types.Interval{PointType: types.Quantity}},
+	[]types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+	[]types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+	[]types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+}
+
+// startsEndsOverloads are the overloads of starts and ends. The left operand may be a point, which
+// is treated as a unit interval, so 5 starts Interval[5, 10] is true.
+var startsEndsOverloads = append(slices.Clone(orderedIntervalOverloads),
+	[]types.IType{types.Integer, &types.Interval{PointType: types.Integer}},
+	[]types.IType{types.Long, &types.Interval{PointType: types.Long}},
+	[]types.IType{types.Decimal, &types.Interval{PointType: types.Decimal}},
+	[]types.IType{types.Quantity, &types.Interval{PointType: types.Quantity}},
+	[]types.IType{types.Date, &types.Interval{PointType: types.Date}},
+	[]types.IType{types.DateTime, &types.Interval{PointType: types.DateTime}},
+	[]types.IType{types.Time, &types.Interval{PointType: types.Time}},
+)
+
 var comparableIntervalOverloads = [][]types.IType{
 	// op (left Interval<T>, right Interval<T>) Boolean
 	[]types.IType{&types.Interval{PointType: types.Integer}, &types.Interval{PointType: types.Integer}},
@@ -2019,6 +2968,71 @@ func (p *Parser) generatePrecisionTimingOverloads() error {
 	return nil
 }
 
+// generatePrecisionIntervalOverloads defines the overloads for the meets, overlaps, starts, ends and
+// includes operators with a precision, for example "meets before day of".
+func (p *Parser) generatePrecisionIntervalOverloads() error {
+	overloads := [][]types.IType{
+		[]types.IType{&types.Interval{PointType: types.Date}, &types.Interval{PointType: types.Date}},
+		[]types.IType{&types.Interval{PointType: types.DateTime}, &types.Interval{PointType: types.DateTime}},
+		[]types.IType{&types.Interval{PointType: types.Time}, &types.Interval{PointType: types.Time}},
+	}
+	models := map[string]func(model.DateTimePrecision) func() model.IExpression{
+		"Meets":          meetsModel,
+		"MeetsBefore":    meetsBeforeModel,
+		"MeetsAfter":     meetsAfterModel,
+		"Overlaps":       overlapsModel,
+		"OverlapsBefore": overlapsBeforeModel,
+		"OverlapsAfter":  overlapsAfterModel,
+		"Starts":         startsModel,
+		"Ends":           endsModel,
+		"Includes":       includesModel,
+	}
+
+	for fnName, fnModel := range models {
+		for _, precision := range dateTimePrecisions() {
+			name := funcNameWithPrecision(fnName, precision)
+			for _, overload := range overloads {
+				if err := p.refs.DefineBuiltinFunc(name, overload, fnModel(precision)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Starts and ends also accept a point as the left operand.
+	startsEndsPointOverloads := [][]types.IType{
+		[]types.IType{types.Date, &types.Interval{PointType: types.Date}},
+		[]types.IType{types.DateTime, &types.Interval{PointType: types.DateTime}},
+		[]types.IType{types.Time, &types.Interval{PointType: types.Time}},
+	}
+	for _, fnName := range []string{"Starts", "Ends"} {
+		for _, precision := range dateTimePrecisions() {
+			name := funcNameWithPrecision(fnName, precision)
+			for _, overload := range startsEndsPointOverloads {
+				if err := p.refs.DefineBuiltinFunc(name, overload, models[fnName](precision)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Includes for point type overloads is a macro for the Contains operator.
+	pointOverloads := [][]types.IType{
+		[]types.IType{&types.Interval{PointType: types.Date}, types.Date},
+		[]types.IType{&types.Interval{PointType: types.DateTime}, types.DateTime},
+		[]types.IType{&types.Interval{PointType: types.Time}, types.Time},
+	}
+	for _, precision := range dateTimePrecisions() {
+		name := funcNameWithPrecision("Includes", precision)
+		for _, overload := range pointOverloads {
+			if err := p.refs.DefineBuiltinFunc(name, overload, containsModel(precision)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (p *Parser) generateDifferenceBetweenOverloads() error {
 	overloads := [][]types.IType{
 		[]types.IType{types.Date, types.Date},
@@ -2048,6 +3062,70 @@ func differenceBetweenModel(precision model.DateTimePrecision) func() model.IExp
 	}
 }
 
+func (p *Parser) generateDurationBetweenOverloads() error {
+	overloads := [][]types.IType{
+		[]types.IType{types.Date, types.Date},
+		[]types.IType{types.DateTime, types.DateTime},
+		[]types.IType{types.Time, types.Time},
+	}
+
+	for _, precision := range dateTimePrecisions() {
+		name := funcNameWithPrecision("DurationBetween", precision)
+		for _, overload := range overloads {
+			if err := p.refs.DefineBuiltinFunc(name, overload, durationBetweenModel(precision)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func durationBetweenModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.DurationBetween{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Integer),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+// generateDateTimeComponentFromOverloads generates the overloads for extracting a single
+// component, such as year from or hour from, out of a Date, DateTime or Time.
+func (p *Parser) generateDateTimeComponentFromOverloads() error {
+	for _, precision := range dateTimePrecisions() {
+		var overloads [][]types.IType
+		switch precision {
+		case model.YEAR, model.MONTH, model.DAY:
+			overloads = [][]types.IType{{types.Date}, {types.DateTime}}
+		case model.HOUR, model.MINUTE, model.SECOND, model.MILLISECOND:
+			overloads = [][]types.IType{{types.DateTime}, {types.Time}}
+		default:
+			// There is no week component of a Date, DateTime or Time.
+			continue
+		}
+		name := funcNameWithPrecision("DateTimeComponentFrom", precision)
+		for _, overload := range overloads {
+			if err := p.refs.DefineBuiltinFunc(name, overload, dateTimeComponentFromModel(precision)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func dateTimeComponentFromModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.DateTimeComponentFrom{
+			UnaryExpression: &model.UnaryExpression{
+				Expression: model.ResultType(types.Integer),
+			},
+			Precision: precision,
+		}
+	}
+}
+
 func afterModel(precision model.DateTimePrecision) func() model.IExpression {
 	return func() model.IExpression {
 		return &model.After{
@@ -2092,6 +3170,94 @@ func sameOrBeforeModel(precision model.DateTimePrecision) func() model.IExpressi
 	}
 }
 
+func meetsModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Meets{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func meetsBeforeModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.MeetsBefore{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func meetsAfterModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.MeetsAfter{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func overlapsModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Overlaps{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func overlapsBeforeModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.OverlapsBefore{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func overlapsAfterModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.OverlapsAfter{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func startsModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Starts{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func endsModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Ends{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
 // Returns an expression containing the patient's birth date property, as defined by the model info.
 // For FHIR model info this should return a System Date.
 func (v *visitor) patientBirthDateExpression() (model.IExpression, error) {
@@ -2163,6 +3329,28 @@ func inModel(precision model.DateTimePrecision) func() model.IExpression {
 	}
 }
 
+func containsModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Contains{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
+func includesModel(precision model.DateTimePrecision) func() model.IExpression {
+	return func() model.IExpression {
+		return &model.Includes{
+			BinaryExpression: &model.BinaryExpression{
+				Expression: model.ResultType(types.Boolean),
+			},
+			Precision: precision,
+		}
+	}
+}
+
 func includedInModel(precision model.DateTimePrecision) func() model.IExpression {
 	return func() model.IExpression {
 		return &model.IncludedIn{
@@ -525,6 +525,19 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Arithmetic Log",
+			cql:  "Log(100.0, 10.0)",
+			want: &model.Log{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("100.0", types.Decimal),
+						model.NewLiteral("10.0", types.Decimal),
+					},
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
 		{
 			name: "Arithmetic Precision",
 			cql:  "Precision(@2014)",
@@ -662,6 +675,141 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Round",
+			cql:  "Round(3.14159, 2)",
+			want: &model.Round{
+				NaryExpression: &model.NaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("3.14159", types.Decimal),
+						model.NewLiteral("2", types.Integer),
+					},
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
+		{
+			name: "Substring",
+			cql:  "Substring('abc', 1)",
+			want: &model.Substring{
+				NaryExpression: &model.NaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abc", types.String),
+						model.NewLiteral("1", types.Integer),
+					},
+					Expression: model.ResultType(types.String),
+				},
+			},
+		},
+		{
+			name: "Upper",
+			cql:  "Upper('abc')",
+			want: &model.Upper{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("abc", types.String),
+					Expression: model.ResultType(types.String),
+				},
+			},
+		},
+		{
+			name: "Lower",
+			cql:  "Lower('ABC')",
+			want: &model.Lower{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("ABC", types.String),
+					Expression: model.ResultType(types.String),
+				},
+			},
+		},
+		{
+			name: "Length",
+			cql:  "Length('abc')",
+			want: &model.Length{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("abc", types.String),
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Matches",
+			cql:  "Matches('abc', 'a.c')",
+			want: &model.Matches{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abc", types.String),
+						model.NewLiteral("a.c", types.String),
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "PositionOf",
+			cql:  "PositionOf('b', 'abc')",
+			want: &model.PositionOf{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("b", types.String),
+						model.NewLiteral("abc", types.String),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "LastPositionOf",
+			cql:  "LastPositionOf('b', 'abc')",
+			want: &model.LastPositionOf{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("b", types.String),
+						model.NewLiteral("abc", types.String),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "StartsWith",
+			cql:  "StartsWith('abc', 'a')",
+			want: &model.StartsWith{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abc", types.String),
+						model.NewLiteral("a", types.String),
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "EndsWith",
+			cql:  "EndsWith('abc', 'c')",
+			want: &model.EndsWith{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abc", types.String),
+						model.NewLiteral("c", types.String),
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "ReplaceMatches",
+			cql:  "ReplaceMatches('abc', 'b', 'x')",
+			want: &model.ReplaceMatches{
+				NaryExpression: &model.NaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abc", types.String),
+						model.NewLiteral("b", types.String),
+						model.NewLiteral("x", types.String),
+					},
+					Expression: model.ResultType(types.String),
+				},
+			},
+		},
 		{
 			name: "Combine({'1'})",
 			cql:  "Combine({'1'})",
@@ -812,6 +960,20 @@ func TestBuiltInFunctions(t *testing.T) {
 				Precision: model.YEAR,
 			},
 		},
+		{
+			name: "DurationBetween",
+			cql:  "DurationBetweenHours(@T10:00, @T12:00)",
+			want: &model.DurationBetween{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("@T10:00", types.Time),
+						model.NewLiteral("@T12:00", types.Time),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+				Precision: model.HOUR,
+			},
+		},
 		{
 			name: "Now()",
 			cql:  "Now()",
@@ -1057,50 +1219,47 @@ func TestBuiltInFunctions(t *testing.T) {
 			},
 		},
 		{
-			name: "IncludedInYears interval overload",
-			cql:  "IncludedInYears(Interval[@2015, @2016], Interval[@2010, @2020])",
+			name: "IncludedIn list overload",
+			cql:  "IncludedIn({1, 2}, {1})",
 			want: &model.IncludedIn{
 				BinaryExpression: &model.BinaryExpression{
 					Operands: []model.IExpression{
-						&model.Interval{
-							Low:           model.NewLiteral("@2015", types.Date),
-							High:          model.NewLiteral("@2016", types.Date),
-							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
-							LowInclusive:  true,
-							HighInclusive: true,
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
 						},
-						&model.Interval{
-							Low:           model.NewLiteral("@2010", types.Date),
-							High:          model.NewLiteral("@2020", types.Date),
-							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
-							LowInclusive:  true,
-							HighInclusive: true,
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+							},
 						},
 					},
 					Expression: model.ResultType(types.Boolean),
 				},
-				Precision: model.YEAR,
 			},
 		},
 		{
-			name: "Overlaps with Date",
-			cql:  "Interval[@2010, @2015] overlaps Interval[@2010, @2020]",
-			want: &model.Overlaps{
+			name: "Includes list overload",
+			cql:  "Includes({1, 2}, {1})",
+			want: &model.Includes{
 				BinaryExpression: &model.BinaryExpression{
 					Operands: []model.IExpression{
-						&model.Interval{
-							Low:           model.NewLiteral("@2010", types.Date),
-							High:          model.NewLiteral("@2015", types.Date),
-							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
-							LowInclusive:  true,
-							HighInclusive: true,
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
 						},
-						&model.Interval{
-							Low:           model.NewLiteral("@2010", types.Date),
-							High:          model.NewLiteral("@2020", types.Date),
-							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
-							LowInclusive:  true,
-							HighInclusive: true,
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+							},
 						},
 					},
 					Expression: model.ResultType(types.Boolean),
@@ -1108,70 +1267,42 @@ func TestBuiltInFunctions(t *testing.T) {
 			},
 		},
 		{
-			name: "Start",
-			cql:  "Start(Interval[1, 4])",
-			want: &model.Start{
-				UnaryExpression: &model.UnaryExpression{
-					Operand: &model.Interval{
-						Low:           model.NewLiteral("1", types.Integer),
-						High:          model.NewLiteral("4", types.Integer),
-						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
-						LowInclusive:  true,
-						HighInclusive: true,
-					},
-					Expression: model.ResultType(types.Integer),
-				},
-			},
-		},
-		// LIST OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#list-operators-2
-		{
-			name: "Except",
-			cql:  "Except({1}, {1})",
-			want: &model.Except{
+			name: "ProperIncludedIn",
+			cql:  "ProperIncludedIn({1, 2}, {1})",
+			want: &model.ProperIncludedIn{
 				BinaryExpression: &model.BinaryExpression{
-					Operands: []model.IExpression{&model.List{
-						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-						List: []model.IExpression{
-							model.NewLiteral("1", types.Integer),
-						},
-					},
+					Operands: []model.IExpression{
 						&model.List{
 							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
 							List: []model.IExpression{
 								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
 							},
 						},
-					},
-					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-				},
-			},
-		},
-		{
-			name: "First",
-			cql:  "First({1})",
-			want: &model.First{
-				UnaryExpression: &model.UnaryExpression{
-					Operand: &model.List{
-						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-						List: []model.IExpression{
-							model.NewLiteral("1", types.Integer),
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+							},
 						},
 					},
-					Expression: model.ResultType(types.Integer),
+					Expression: model.ResultType(types.Boolean),
 				},
 			},
 		},
 		{
-			name: "Intersect",
-			cql:  "Intersect({1}, {1})",
-			want: &model.Intersect{
+			name: "ProperIncludes",
+			cql:  "ProperIncludes({1, 2}, {1})",
+			want: &model.ProperIncludes{
 				BinaryExpression: &model.BinaryExpression{
-					Operands: []model.IExpression{&model.List{
-						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-						List: []model.IExpression{
-							model.NewLiteral("1", types.Integer),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
 						},
-					},
 						&model.List{
 							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
 							List: []model.IExpression{
@@ -1179,43 +1310,728 @@ func TestBuiltInFunctions(t *testing.T) {
 							},
 						},
 					},
-					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Expression: model.ResultType(types.Boolean),
 				},
 			},
 		},
 		{
-			name: "Last",
-			cql:  "Last({1})",
-			want: &model.Last{
-				UnaryExpression: &model.UnaryExpression{
-					Operand: &model.List{
-						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-						List: []model.IExpression{
-							model.NewLiteral("1", types.Integer),
+			name: "Includes interval overload",
+			cql:  "Interval[1, 10] includes Interval[2, 5]",
+			want: &model.Includes{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
 						},
-					},
-					Expression: model.ResultType(types.Integer),
-				},
-			},
-		},
-		{
-			name: "SingletonFrom",
-			cql:  "SingletonFrom({1})",
-			want: &model.SingletonFrom{
-				UnaryExpression: &model.UnaryExpression{
-					Operand: &model.List{
-						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
-						List: []model.IExpression{
-							model.NewLiteral("1", types.Integer),
+						&model.Interval{
+							Low:           model.NewLiteral("2", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
 						},
 					},
-					Expression: model.ResultType(types.Integer),
+					Expression: model.ResultType(types.Boolean),
 				},
 			},
 		},
 		{
-			name: "Union",
-			cql:  "Union({1}, {'hi'})",
+			name: "IncludesDays interval overload",
+			cql:  "Interval[@2010-01-01, @2010-01-31] includes day of Interval[@2010-01-05, @2010-01-25]",
+			want: &model.Includes{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2010-01-01", types.Date),
+							High:          model.NewLiteral("@2010-01-31", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2010-01-05", types.Date),
+							High:          model.NewLiteral("@2010-01-25", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.DAY,
+			},
+		},
+		{
+			name: "IncludesDays point overload is converted to In",
+			cql:  "Interval[@2010-01-01, @2010-01-31] includes day of @2010-01-05",
+			want: &model.In{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("@2010-01-05", types.Date),
+						&model.Interval{
+							Low:           model.NewLiteral("@2010-01-01", types.Date),
+							High:          model.NewLiteral("@2010-01-31", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.DAY,
+			},
+		},
+		{
+			name: "IncludedInYears interval overload",
+			cql:  "IncludedInYears(Interval[@2015, @2016], Interval[@2010, @2020])",
+			want: &model.IncludedIn{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2015", types.Date),
+							High:          model.NewLiteral("@2016", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2020", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.YEAR,
+			},
+		},
+		{
+			name: "Overlaps with Date",
+			cql:  "Interval[@2010, @2015] overlaps Interval[@2010, @2020]",
+			want: &model.Overlaps{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2015", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2020", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "OverlapsBefore",
+			cql:  "Interval[1, 5] overlaps before Interval[3, 10]",
+			want: &model.OverlapsBefore{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("3", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "OverlapsAfter with precision",
+			cql:  "Interval[@2010, @2015] overlaps after year of Interval[@2010, @2012]",
+			want: &model.OverlapsAfter{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2015", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2012", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.YEAR,
+			},
+		},
+		{
+			name: "Meets",
+			cql:  "Interval[1, 5] meets Interval[6, 10]",
+			want: &model.Meets{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("6", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "MeetsBefore functional form",
+			cql:  "MeetsBefore(Interval[1, 5], Interval[6, 10])",
+			want: &model.MeetsBefore{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("6", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "MeetsAfter with precision",
+			cql:  "Interval[@2010, @2015] meets after year of Interval[@2005, @2009]",
+			want: &model.MeetsAfter{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2015", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2005", types.Date),
+							High:          model.NewLiteral("@2009", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.YEAR,
+			},
+		},
+		{
+			name: "Starts with precision",
+			cql:  "Interval[@2010, @2015] starts year of Interval[@2010, @2020]",
+			want: &model.Starts{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2015", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("@2010", types.Date),
+							High:          model.NewLiteral("@2020", types.Date),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+				Precision: model.YEAR,
+			},
+		},
+		{
+			name: "Ends with point",
+			cql:  "10 ends Interval[1, 10]",
+			want: &model.Ends{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("10", types.Integer),
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+		},
+		{
+			name: "Collapse",
+			cql:  "collapse { Interval[1, 5] }",
+			want: &model.Collapse{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.Interval{
+									Low:           model.NewLiteral("1", types.Integer),
+									High:          model.NewLiteral("5", types.Integer),
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+									LowInclusive:  true,
+									HighInclusive: true,
+								},
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+						},
+						&model.As{
+							UnaryExpression: &model.UnaryExpression{
+								Operand:    model.NewLiteral("null", types.Any),
+								Expression: model.ResultType(types.Quantity),
+							},
+							AsTypeSpecifier: types.Quantity,
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+				},
+			},
+		},
+		{
+			name: "Collapse functional with per",
+			cql:  "Collapse({ Interval[1, 5] }, 2 '1')",
+			want: &model.Collapse{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.Interval{
+									Low:           model.NewLiteral("1", types.Integer),
+									High:          model.NewLiteral("5", types.Integer),
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+									LowInclusive:  true,
+									HighInclusive: true,
+								},
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+						},
+						&model.Quantity{Value: 2, Unit: "1", Expression: model.ResultType(types.Quantity)},
+					},
+					Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+				},
+			},
+		},
+		{
+			name: "Expand per day",
+			cql:  "expand { Interval[@2018-01-01, @2018-01-04] } per day",
+			want: &model.Expand{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.Interval{
+									Low:           model.NewLiteral("@2018-01-01", types.Date),
+									High:          model.NewLiteral("@2018-01-04", types.Date),
+									Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+									LowInclusive:  true,
+									HighInclusive: true,
+								},
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Date}}),
+						},
+						&model.Quantity{Value: 1, Unit: model.DAYUNIT, Expression: model.ResultType(types.Quantity)},
+					},
+					Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Date}}),
+				},
+			},
+		},
+		{
+			name: "Except interval overload",
+			cql:  "Interval[1, 10] except Interval[4, 15]",
+			want: &model.Except{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("15", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Intersect interval overload",
+			cql:  "Interval[1, 10] intersect Interval[4, 15]",
+			want: &model.Intersect{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("15", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Union interval overload",
+			cql:  "Interval[1, 10] union Interval[4, 15]",
+			want: &model.Union{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("15", types.Integer),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+							LowInclusive:  true,
+							HighInclusive: true,
+						},
+					},
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Start",
+			cql:  "Start(Interval[1, 4])",
+			want: &model.Start{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("4", types.Integer),
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						LowInclusive:  true,
+						HighInclusive: true,
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "PointFrom",
+			cql:  "point from Interval[1, 1]",
+			want: &model.PointFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("1", types.Integer),
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						LowInclusive:  true,
+						HighInclusive: true,
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Width",
+			cql:  "Width(Interval[1, 4])",
+			want: &model.Width{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("4", types.Integer),
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						LowInclusive:  true,
+						HighInclusive: true,
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Width of Date interval",
+			cql:  "Width(Interval[@2020-01-01, @2020-02-01])",
+			want: &model.Width{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("@2020-01-01", types.Date),
+						High:          model.NewLiteral("@2020-02-01", types.Date),
+						Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+						LowInclusive:  true,
+						HighInclusive: true,
+					},
+					Expression: model.ResultType(types.Quantity),
+				},
+			},
+		},
+		{
+			name: "Size",
+			cql:  "Size(Interval[1, 4])",
+			want: &model.Size{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("4", types.Integer),
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						LowInclusive:  true,
+						HighInclusive: true,
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		// LIST OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#list-operators-2
+		{
+			name: "Except",
+			cql:  "Except({1}, {1})",
+			want: &model.Except{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{&model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+							},
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Distinct",
+			cql:  "Distinct({1})",
+			want: &model.Distinct{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "First",
+			cql:  "First({1})",
+			want: &model.First{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Flatten",
+			cql:  "Flatten({{1}})",
+			want: &model.Flatten{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: &types.List{ElementType: types.Integer}}),
+						List: []model.IExpression{
+							&model.List{
+								Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+								List: []model.IExpression{
+									model.NewLiteral("1", types.Integer),
+								},
+							},
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "IndexOf",
+			cql:  "IndexOf({1}, 1)",
+			want: &model.IndexOf{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewList([]string{"1"}, types.Integer),
+						model.NewLiteral("1", types.Integer),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Intersect",
+			cql:  "Intersect({1}, {1})",
+			want: &model.Intersect{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{&model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+							},
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Last",
+			cql:  "Last({1})",
+			want: &model.Last{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "SingletonFrom",
+			cql:  "SingletonFrom({1})",
+			want: &model.SingletonFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "Skip",
+			cql:  "Skip({1}, 1)",
+			want: &model.Skip{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewList([]string{"1"}, types.Integer),
+						model.NewLiteral("1", types.Integer),
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Slice",
+			cql:  "Slice({1}, 0, 1)",
+			want: &model.Slice{
+				NaryExpression: &model.NaryExpression{
+					Operands: []model.IExpression{
+						model.NewList([]string{"1"}, types.Integer),
+						model.NewLiteral("0", types.Integer),
+						model.NewLiteral("1", types.Integer),
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Tail",
+			cql:  "Tail({1})",
+			want: &model.Tail{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1"}, types.Integer),
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Take",
+			cql:  "Take({1}, 1)",
+			want: &model.Take{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewList([]string{"1"}, types.Integer),
+						model.NewLiteral("1", types.Integer),
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
+		{
+			name: "Union",
+			cql:  "Union({1}, {'hi'})",
 			want: &model.Union{
 				BinaryExpression: &model.BinaryExpression{
 					Operands: []model.IExpression{
@@ -1226,6 +2042,22 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Union with empty list",
+			cql:  "Union({1}, {})",
+			want: &model.Union{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewList([]string{"1"}, types.Integer),
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Any}),
+							List:       []model.IExpression{},
+						},
+					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+		},
 		// AGGREGATE FUNCTIONS - https://cql.hl7.org/09-b-cqlreference.html#aggregate-functions
 		{
 			name: "Median Decimal",
@@ -1264,6 +2096,42 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Count distinct",
+			cql:  "Count(distinct {1, 2, 3})",
+			want: &model.Count{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "3"}, types.Integer),
+					Expression: model.ResultType(types.Integer),
+				},
+				Distinct: true,
+			},
+		},
+		{
+			name: "Distinct operand outside of Count",
+			cql:  "Sum(distinct {1, 1})",
+			want: &model.Sum{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.Distinct{
+						UnaryExpression: &model.UnaryExpression{
+							Operand:    model.NewList([]string{"1", "1"}, types.Integer),
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						},
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+		},
+		{
+			name: "GeometricMean",
+			cql:  "GeometricMean({1, 2, 4})",
+			want: &model.GeometricMean{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "4"}, types.Integer),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
 		{
 			name: "Max",
 			cql:  "Max({@2010, @2011, @2012})",
@@ -1284,6 +2152,56 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Mode",
+			cql:  "Mode({'a', 'b', 'b'})",
+			want: &model.Mode{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"a", "b", "b"}, types.String),
+					Expression: model.ResultType(types.String),
+				},
+			},
+		},
+		{
+			name: "PopulationStdDev",
+			cql:  "PopulationStdDev({1, 2, 3})",
+			want: &model.PopulationStdDev{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "3"}, types.Integer),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
+		{
+			name: "PopulationVariance",
+			cql:  "PopulationVariance({1, 2, 3})",
+			want: &model.PopulationVariance{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "3"}, types.Integer),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
+		{
+			name: "Product",
+			cql:  "Product({1L, 2L, 3L})",
+			want: &model.Product{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1L", "2L", "3L"}, types.Long),
+					Expression: model.ResultType(types.Long),
+				},
+			},
+		},
+		{
+			name: "StdDev",
+			cql:  "StdDev({1.0, 2.0, 3.0})",
+			want: &model.StdDev{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1.0", "2.0", "3.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
 		{
 			name: "Sum",
 			cql:  "Sum({1, 2, 3})",
@@ -1294,6 +2212,16 @@ func TestBuiltInFunctions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Variance",
+			cql:  "Variance({1.0, 2.0, 3.0})",
+			want: &model.Variance{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1.0", "2.0", "3.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+		},
 		// CLINICAL OPERATORS - https://cql.hl7.org/09-b-cqlreference.html#clinical-operators-3
 		{
 			name: "AgeInYears",
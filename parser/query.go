@@ -26,6 +26,22 @@ import (
 )
 
 func (v *visitor) VisitQuery(ctx *cql.QueryContext) model.IExpression {
+	m := v.parseQueryClauses(ctx)
+	q, ok := m.(*model.Query)
+	if !ok {
+		return m
+	}
+	// The sort clause orders the results of the query, so it is parsed once the query aliases are
+	// out of scope.
+	q, err := v.parseSortClause(ctx.SortClause(), q)
+	if err != nil {
+		return v.badExpression(err.Error(), ctx.SortClause())
+	}
+	return q
+}
+
+// parseQueryClauses parses every clause of the query except for the sort clause.
+func (v *visitor) parseQueryClauses(ctx *cql.QueryContext) model.IExpression {
 	// Top level scope for the main query source aliases.
 	v.refs.EnterScope()
 	defer v.refs.ExitScope()
@@ -55,11 +71,6 @@ func (v *visitor) VisitQuery(ctx *cql.QueryContext) model.IExpression {
 		return v.badExpression(err.Error(), ctx.WhereClause())
 	}
 
-	q, err = v.parseSortClause(ctx.SortClause(), q)
-	if err != nil {
-		return v.badExpression(err.Error(), ctx.SortClause())
-	}
-
 	q, err = v.parseAggregateClause(ctx.AggregateClause(), q)
 	if err != nil {
 		return v.badExpression(err.Error(), ctx.AggregateClause())
@@ -194,12 +205,97 @@ func (v *visitor) parseWhereClause(wc cql.IWhereClauseContext, q *model.Query) (
 		return nil, fmt.Errorf("result of a where clause must be implicitly convertible to a boolean, could not convert %v to boolean", wExp.GetResultType())
 	}
 	q.Where = res.WrappedOperand
+	setRetrieveDateFilter(q)
 	return q, nil
 }
 
+// setRetrieveDateFilter sets the DateProperty and DateRange of the retrieve in a single source
+// query if the where clause filters the source by a date during an interval, for example
+// [Encounter] E where E.period during "Measurement Period". This lets the retriever filter by date
+// in the data source. The where clause is kept, so the query returns the same result whether or
+// not the retriever filters by date.
+func setRetrieveDateFilter(q *model.Query) {
+	if len(q.Source) != 1 {
+		return
+	}
+	r, ok := q.Source[0].Source.(*model.Retrieve)
+	if !ok {
+		return
+	}
+	if path, dateRange, ok := retrieveDateFilter(q.Where, q.Source[0].Alias); ok {
+		r.DateProperty = path
+		r.DateRange = dateRange
+	}
+}
+
+// retrieveDateFilter returns the date path and range of a condition of the form
+// alias.path during interval, searching through conditions combined with and. Conditions with a
+// precision such as during day of are not used, since they match more than the interval.
+func retrieveDateFilter(cond model.IExpression, alias string) (string, model.IExpression, bool) {
+	var b *model.BinaryExpressionWithPrecision
+	switch c := cond.(type) {
+	case *model.And:
+		if path, dateRange, ok := retrieveDateFilter(c.Left(), alias); ok {
+			return path, dateRange, true
+		}
+		return retrieveDateFilter(c.Right(), alias)
+	case *model.In:
+		b = (*model.BinaryExpressionWithPrecision)(c)
+	case *model.IncludedIn:
+		b = (*model.BinaryExpressionWithPrecision)(c)
+	default:
+		return "", nil, false
+	}
+	if b.Precision != "" {
+		return "", nil, false
+	}
+	if _, ok := b.Right().GetResultType().(*types.Interval); !ok || !isAliasIndependent(b.Right()) {
+		return "", nil, false
+	}
+	path, ok := aliasPropertyPath(b.Left(), alias)
+	if !ok {
+		return "", nil, false
+	}
+	return path, b.Right(), true
+}
+
+// aliasPropertyPath returns the path of a property on the alias, looking through FHIRHelpers
+// conversions and casts of the property.
+func aliasPropertyPath(e model.IExpression, alias string) (string, bool) {
+	switch e := e.(type) {
+	case *model.FunctionRef:
+		if e.LibraryName != "FHIRHelpers" || len(e.Operands) != 1 {
+			return "", false
+		}
+		return aliasPropertyPath(e.Operands[0], alias)
+	case *model.As:
+		return aliasPropertyPath(e.Operand, alias)
+	case *model.Property:
+		if a, ok := e.Source.(*model.AliasRef); ok {
+			return e.Path, a.Name == alias
+		}
+		p, ok := aliasPropertyPath(e.Source, alias)
+		return p + "." + e.Path, ok
+	default:
+		return "", false
+	}
+}
+
+// isAliasIndependent returns true for the expressions that can be evaluated outside of the query,
+// such as references to parameters and definitions.
+func isAliasIndependent(e model.IExpression) bool {
+	switch e := e.(type) {
+	case *model.Literal, *model.ParameterRef, *model.ExpressionRef:
+		return true
+	case *model.Interval:
+		return isAliasIndependent(e.Low) && isAliasIndependent(e.High)
+	default:
+		return false
+	}
+}
+
 func (v *visitor) parseSortClause(sc cql.ISortClauseContext, q *model.Query) (*model.Query, error) {
 	// TODO(b/316961394): Add check for sortability for CQL query sort columns.
-	// TODO(b/317008490): Implement sort by expression.
 	if sc == nil {
 		return q, nil
 	}
@@ -217,20 +313,39 @@ func (v *visitor) parseSortClause(sc cql.ISortClauseContext, q *model.Query) (*m
 				},
 			},
 		}
-	} else if sbi, found := maybeGetChildNode[*cql.SortByItemContext](sc.GetChildren(), nil); found {
-		sortDir, err := parseSortDirection(sbi.SortDirection().GetText())
-		if err != nil {
-			return nil, err
-		}
+	} else if sbis := sc.AllSortByItem(); len(sbis) > 0 {
+		for _, sbi := range sbis {
+			// The sort direction of a sort by item is optional and defaults to ascending.
+			sortDir := model.ASCENDING
+			if sbi.SortDirection() != nil {
+				var err error
+				sortDir, err = parseSortDirection(sbi.SortDirection().GetText())
+				if err != nil {
+					return nil, err
+				}
+			}
 
-		// TODO(b/317402356): Add static type checking for column paths.
-		sortByItems = []model.ISortByItem{
-			&model.SortByColumn{
+			if isSortColumnPath(sbi.ExpressionTerm()) {
+				// TODO(b/317402356): Add static type checking for column paths.
+				sortByItems = append(sortByItems, &model.SortByColumn{
+					SortByItem: &model.SortByItem{
+						Direction: sortDir,
+					},
+					Path: sbi.ExpressionTerm().GetText(),
+				})
+				continue
+			}
+			expr, err := v.parseSortExpression(sbi.ExpressionTerm(), q)
+			if err != nil {
+				return nil, err
+			}
+			sortByItems = append(sortByItems, &model.SortByExpression{
 				SortByItem: &model.SortByItem{
 					Direction: sortDir,
 				},
-				Path: sbi.ExpressionTerm().GetText(),
-			},
+				Expression: expr,
+				Scope:      thisAlias,
+			})
 		}
 	} else {
 		return nil, errors.New("item or direction to sort by was not found")
@@ -240,6 +355,90 @@ func (v *visitor) parseSortClause(sc cql.ISortClauseContext, q *model.Query) (*m
 	return q, nil
 }
 
+// isSortColumnPath returns true if the sort by item is a property, or a dotted path of properties,
+// of the elements being sorted.
+func isSortColumnPath(term cql.IExpressionTermContext) bool {
+	switch t := term.(type) {
+	case *cql.TermExpressionTermContext:
+		inv, ok := t.Term().(*cql.InvocationTermContext)
+		if !ok {
+			return false
+		}
+		_, ok = inv.Invocation().(*cql.MemberInvocationContext)
+		return ok
+	case *cql.InvocationExpressionTermContext:
+		_, ok := t.QualifiedInvocation().(*cql.QualifiedMemberInvocationContext)
+		return ok && isSortColumnPath(t.ExpressionTerm())
+	default:
+		return false
+	}
+}
+
+// parseSortExpression parses a sort by item that is not a property path. The element being sorted
+// is available as $this, and its properties by their names unless the name is already defined.
+// Query aliases are not in scope, since the sort is applied to the results of the query.
+func (v *visitor) parseSortExpression(term cql.IExpressionTermContext, q *model.Query) (model.IExpression, error) {
+	elemType := q.GetResultType()
+	if l, ok := elemType.(*types.List); ok {
+		elemType = l.ElementType
+	}
+
+	v.refs.EnterScope()
+	defer v.refs.ExitScope()
+	this := func() model.IExpression {
+		return &model.AliasRef{Name: thisAlias, Expression: model.ResultType(elemType)}
+	}
+	if err := v.refs.Alias(thisAlias, this); err != nil {
+		return nil, err
+	}
+	properties, err := v.elementProperties(elemType)
+	if err != nil {
+		return nil, err
+	}
+	for name, pType := range properties {
+		f := func() model.IExpression {
+			return &model.Property{Source: this(), Path: name, Expression: model.ResultType(pType)}
+		}
+		// Definitions and included libraries take precedence over properties of the same name.
+		if err := v.refs.Alias(name, f); err != nil {
+			continue
+		}
+	}
+	return v.VisitExpression(term), nil
+}
+
+// elementProperties returns the properties of a Tuple or of a named type and its base types.
+func (v *visitor) elementProperties(t types.IType) (map[string]types.IType, error) {
+	switch et := t.(type) {
+	case *types.Tuple:
+		return et.ElementTypes, nil
+	case *types.Named:
+		baseTypes, err := v.modelInfo.BaseTypes(et)
+		if err != nil {
+			return nil, err
+		}
+		properties := make(map[string]types.IType)
+		for _, bt := range append([]types.IType{et}, baseTypes...) {
+			named, ok := bt.(*types.Named)
+			if !ok {
+				continue
+			}
+			info, err := v.modelInfo.NamedTypeInfo(named)
+			if err != nil {
+				return nil, err
+			}
+			for name, pType := range info.Properties {
+				if _, ok := properties[name]; !ok {
+					properties[name] = pType
+				}
+			}
+		}
+		return properties, nil
+	default:
+		return nil, nil
+	}
+}
+
 func parseSortDirection(s string) (model.SortDirection, error) {
 	switch s {
 	case "ascending", "asc":
@@ -384,3 +583,54 @@ func (v *visitor) parseAliasedQuerySource(aqs cql.IAliasedQuerySourceContext) (*
 	}
 	return aqsModel, nil
 }
+
+// thisAlias is the alias for the current element in the element expression of a repeat, and for the
+// element being sorted in a sort by expression.
+const thisAlias = "$this"
+
+// parseRepeat parses source.repeat(element). Element refers to the current element as $this and
+// returns either a single related element or a list of them.
+func (v *visitor) parseRepeat(source cql.IExpressionTermContext, params cql.IParamListContext, ctx antlr.ParserRuleContext) model.IExpression {
+	if params == nil || len(params.AllExpression()) != 1 {
+		return v.badExpression("repeat takes a single element expression", ctx)
+	}
+	sourceModel := v.VisitExpression(source)
+	listType, ok := sourceModel.GetResultType().(*types.List)
+	if !ok {
+		return v.badExpression(fmt.Sprintf("repeat source must be a list, got %v", sourceModel.GetResultType()), ctx)
+	}
+
+	v.refs.EnterScope()
+	defer v.refs.ExitScope()
+	f := func() model.IExpression {
+		return &model.AliasRef{Name: thisAlias, Expression: model.ResultType(listType.ElementType)}
+	}
+	if err := v.refs.Alias(thisAlias, f); err != nil {
+		return v.badExpression(err.Error(), ctx)
+	}
+
+	element := v.VisitExpression(params.Expression(0))
+	elementType := element.GetResultType()
+	if l, ok := elementType.(*types.List); ok {
+		elementType = l.ElementType
+	}
+	if elementType != types.Any && !elementType.Equal(listType.ElementType) {
+		return v.badExpression(fmt.Sprintf("repeat element must return %v or %v, got %v", listType.ElementType, listType, element.GetResultType()), ctx)
+	}
+
+	return &model.Repeat{
+		Source:     sourceModel,
+		Element:    element,
+		Scope:      thisAlias,
+		Expression: model.ResultType(listType),
+	}
+}
+
+// VisitThisInvocation parses $this, the current element of a repeat.
+func (v *visitor) VisitThisInvocation(ctx *cql.ThisInvocationContext) model.IExpression {
+	f, err := v.refs.ResolveLocal(thisAlias)
+	if err != nil {
+		return v.badExpression("$this can only be used in the element expression of a repeat or a sort by expression", ctx)
+	}
+	return f()
+}
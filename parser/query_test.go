@@ -355,6 +355,80 @@ func TestQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Query with Sort On Multiple Columns",
+			cql: dedent.Dedent(`
+			define TESTRESULT:
+				[Observation: "Blood pressure"] bp
+				sort by effective desc, id`),
+			want: &model.Query{
+				Expression: &model.Expression{
+					Element: &model.Element{ResultType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}}},
+				},
+				Source: []*model.AliasedSource{
+					{
+						Alias: "bp",
+						Source: &model.Retrieve{
+							Expression:   model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}}),
+							DataType:     "{http://hl7.org/fhir}Observation",
+							TemplateID:   "http://hl7.org/fhir/StructureDefinition/Observation",
+							CodeProperty: "code",
+							Codes:        &model.ValuesetRef{Name: "Blood pressure", Expression: model.ResultType(types.ValueSet)},
+						},
+						Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}}),
+					},
+				},
+				Sort: &model.SortClause{
+					ByItems: []model.ISortByItem{
+						&model.SortByColumn{
+							SortByItem: &model.SortByItem{Direction: model.DESCENDING},
+							Path:       "effective",
+						},
+						&model.SortByColumn{
+							SortByItem: &model.SortByItem{Direction: model.ASCENDING},
+							Path:       "id",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Query with Sort By Expression",
+			cql:  "define TESTRESULT: ({2, 1}) N sort by $this + 1 desc",
+			want: &model.Query{
+				Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				Source: []*model.AliasedSource{
+					{
+						Alias: "N",
+						Source: &model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("1", types.Integer),
+							},
+						},
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					},
+				},
+				Sort: &model.SortClause{
+					ByItems: []model.ISortByItem{
+						&model.SortByExpression{
+							SortByItem: &model.SortByItem{Direction: model.DESCENDING},
+							Expression: &model.Add{
+								BinaryExpression: &model.BinaryExpression{
+									Operands: []model.IExpression{
+										&model.AliasRef{Name: "$this", Expression: model.ResultType(types.Integer)},
+										model.NewLiteral("1", types.Integer),
+									},
+									Expression: model.ResultType(types.Integer),
+								},
+							},
+							Scope: "$this",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Aggregate",
 			cql:  "define TESTRESULT: ({1, 2, 3}) N aggregate R starting 1: R * N",
@@ -574,6 +648,83 @@ func TestQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Query with Where during interval sets Retrieve date filter",
+			cql:  `define TESTRESULT: [Encounter] E where E.period during Interval[@2020-01-01T, @2021-01-01T]`,
+			want: &model.Query{
+				Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}}),
+				Source: []*model.AliasedSource{
+					{
+						Alias:      "E",
+						Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}}),
+						Source: &model.Retrieve{
+							DataType:     "{http://hl7.org/fhir}Encounter",
+							TemplateID:   "http://hl7.org/fhir/StructureDefinition/Encounter",
+							CodeProperty: "type",
+							DateProperty: "period",
+							DateRange: &model.Interval{
+								Low:           model.NewLiteral("@2020-01-01T", types.DateTime),
+								High:          model.NewLiteral("@2021-01-01T", types.DateTime),
+								LowInclusive:  true,
+								HighInclusive: true,
+								Expression:    model.ResultType(&types.Interval{PointType: types.DateTime}),
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}}),
+						},
+					},
+				},
+				Where: &model.IncludedIn{
+					BinaryExpression: &model.BinaryExpression{
+						Expression: model.ResultType(types.Boolean),
+						Operands: []model.IExpression{
+							&model.FunctionRef{
+								Expression:  model.ResultType(&types.Interval{PointType: types.DateTime}),
+								Name:        "ToInterval",
+								LibraryName: "FHIRHelpers",
+								Operands: []model.IExpression{
+									&model.Property{
+										Source:     &model.AliasRef{Name: "E", Expression: model.ResultType(&types.Named{TypeName: "FHIR.Encounter"})},
+										Path:       "period",
+										Expression: model.ResultType(&types.Named{TypeName: "FHIR.Period"}),
+									},
+								},
+							},
+							&model.Interval{
+								Low:           model.NewLiteral("@2020-01-01T", types.DateTime),
+								High:          model.NewLiteral("@2021-01-01T", types.DateTime),
+								LowInclusive:  true,
+								HighInclusive: true,
+								Expression:    model.ResultType(&types.Interval{PointType: types.DateTime}),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Repeat",
+			cql:  "define TESTRESULT: {1, 2}.repeat($this + 1)",
+			want: &model.Repeat{
+				Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				Source: &model.List{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					List: []model.IExpression{
+						model.NewLiteral("1", types.Integer),
+						model.NewLiteral("2", types.Integer),
+					},
+				},
+				Element: &model.Add{
+					BinaryExpression: &model.BinaryExpression{
+						Operands: []model.IExpression{
+							&model.AliasRef{Name: "$this", Expression: model.ResultType(types.Integer)},
+							model.NewLiteral("1", types.Integer),
+						},
+						Expression: model.ResultType(types.Integer),
+					},
+				},
+				Scope: "$this",
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -625,6 +776,30 @@ func TestQuery_Errors(t *testing.T) {
 			errContains: []string{"alias P already exists"},
 			errCount:    1,
 		},
+		{
+			name:        "Repeat element of the wrong type",
+			cql:         "{1, 2}.repeat('a')",
+			errContains: []string{"repeat element must return System.Integer or List<System.Integer>, got System.String"},
+			errCount:    1,
+		},
+		{
+			name:        "Repeat source is not a list",
+			cql:         "1.repeat($this)",
+			errContains: []string{"repeat source must be a list, got System.Integer"},
+			errCount:    1,
+		},
+		{
+			name:        "Sort by expression cannot reference query aliases",
+			cql:         "({Tuple{a: 1}}) X sort by X.a + 1",
+			errContains: []string{"could not resolve the local reference to X"},
+			errCount:    1,
+		},
+		{
+			name:        "$this outside of repeat",
+			cql:         "$this",
+			errContains: []string{"$this can only be used in the element expression of a repeat or a sort by expression"},
+			errCount:    1,
+		},
 	}
 
 	for _, test := range tests {
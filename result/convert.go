@@ -24,6 +24,20 @@ import (
 // ErrCannotConvert is an error that is returned when a conversion cannot be performed.
 var ErrCannotConvert = errors.New("internal error - cannot convert")
 
+// ErrUncertain is an error that is returned when an uncertain Integer is converted to a golang value
+// that cannot represent the uncertainty. Only the comparison and arithmetic operators are defined
+// for uncertain Integers, other operators return this error.
+var ErrUncertain = errors.New("cannot convert uncertain Integer")
+
+// cannotConvertError returns the error for a failed conversion of v to target. Uncertain Integers
+// return an ErrUncertain error so that callers can tell them apart from values of the wrong type.
+func cannotConvertError(v Value, target string) error {
+	if u, ok := v.GolangValue().(Uncertainty); ok {
+		return fmt.Errorf("%w [%v, %v] to %v", ErrUncertain, u.Low, u.High, target)
+	}
+	return fmt.Errorf("%w %v to %v", ErrCannotConvert, v.RuntimeType(), target)
+}
+
 // IsNull returns true if the provided Value is a null.
 func IsNull(v Value) bool {
 	return v.GolangValue() == nil
@@ -33,7 +47,7 @@ func IsNull(v Value) bool {
 func ToBool(v Value) (bool, error) {
 	b, ok := v.GolangValue().(bool)
 	if !ok {
-		return false, fmt.Errorf("%w %v to a boolean", ErrCannotConvert, v.RuntimeType())
+		return false, cannotConvertError(v, "a boolean")
 	}
 	return b, nil
 }
@@ -42,7 +56,7 @@ func ToBool(v Value) (bool, error) {
 func ToString(v Value) (string, error) {
 	s, ok := v.GolangValue().(string)
 	if !ok {
-		return "", fmt.Errorf("%w %v to a string", ErrCannotConvert, v.RuntimeType())
+		return "", cannotConvertError(v, "a string")
 	}
 	return s, nil
 }
@@ -51,7 +65,7 @@ func ToString(v Value) (string, error) {
 func ToInt32(v Value) (int32, error) {
 	i, ok := v.GolangValue().(int32)
 	if !ok {
-		return 0, fmt.Errorf("%w %v to a int32", ErrCannotConvert, v.RuntimeType())
+		return 0, cannotConvertError(v, "a int32")
 	}
 	return i, nil
 }
@@ -60,7 +74,7 @@ func ToInt32(v Value) (int32, error) {
 func ToInt64(o Value) (int64, error) {
 	l, ok := o.GolangValue().(int64)
 	if !ok {
-		return 0, fmt.Errorf("%w %v to a int64", ErrCannotConvert, o.RuntimeType())
+		return 0, cannotConvertError(o, "a int64")
 	}
 	return l, nil
 }
@@ -69,7 +83,7 @@ func ToInt64(o Value) (int64, error) {
 func ToFloat64(v Value) (float64, error) {
 	d, ok := v.GolangValue().(float64)
 	if !ok {
-		return 0, fmt.Errorf("%w %v to a float64", ErrCannotConvert, v.RuntimeType())
+		return 0, cannotConvertError(v, "a float64")
 	}
 	return d, nil
 }
@@ -78,7 +92,7 @@ func ToFloat64(v Value) (float64, error) {
 func ToQuantity(v Value) (Quantity, error) {
 	i, ok := v.GolangValue().(Quantity)
 	if !ok {
-		return Quantity{}, fmt.Errorf("%w %v to a Quantity", ErrCannotConvert, v.RuntimeType())
+		return Quantity{}, cannotConvertError(v, "a Quantity")
 	}
 	return i, nil
 }
@@ -87,7 +101,7 @@ func ToQuantity(v Value) (Quantity, error) {
 func ToRatio(v Value) (Ratio, error) {
 	r, ok := v.GolangValue().(Ratio)
 	if !ok {
-		return Ratio{}, fmt.Errorf("%w %v to a Ratio", ErrCannotConvert, v.RuntimeType())
+		return Ratio{}, cannotConvertError(v, "a Ratio")
 	}
 	return r, nil
 }
@@ -104,7 +118,7 @@ func ToDateTime(v Value) (DateTime, error) {
 	case Time:
 		return DateTime(t), nil
 	default:
-		return DateTime{}, fmt.Errorf("%w %v to a DateTime", ErrCannotConvert, v.RuntimeType())
+		return DateTime{}, cannotConvertError(v, "a DateTime")
 	}
 }
 
@@ -112,25 +126,49 @@ func ToDateTime(v Value) (DateTime, error) {
 func ToInterval(v Value) (Interval, error) {
 	i, ok := v.GolangValue().(Interval)
 	if !ok {
-		return Interval{}, fmt.Errorf("%w %v to a Interval", ErrCannotConvert, v.RuntimeType())
+		return Interval{}, cannotConvertError(v, "a Interval")
 	}
 	return i, nil
 }
 
-// ToSlice takes a CQL Slice and returns the underlying golang value, a []Value.
+// ToUncertainty takes an uncertain CQL Integer and returns the underlying golang value, an
+// Uncertainty.
+func ToUncertainty(v Value) (Uncertainty, error) {
+	u, ok := v.GolangValue().(Uncertainty)
+	if !ok {
+		return Uncertainty{}, cannotConvertError(v, "a Uncertainty")
+	}
+	return u, nil
+}
+
+// ToSlice takes a CQL List and returns the underlying golang value, a []Value. The slice of an
+// empty List has length zero but may be nil. A null Value is not a List, so ToSlice returns an
+// ErrCannotConvert error for null. Use ToNullableSlice where a null List must be distinguished from
+// an empty List.
 func ToSlice(v Value) ([]Value, error) {
 	l, ok := v.GolangValue().(List)
 	if !ok {
-		return nil, fmt.Errorf("%w %v to a []Value", ErrCannotConvert, v.RuntimeType())
+		return nil, cannotConvertError(v, "a []Value")
 	}
 	return l.Value, nil
 }
 
+// ToNullableSlice is like ToSlice but permits null. For a null Value it returns isNull true and a
+// nil slice, for an empty List it returns isNull false and a slice of length zero. Values that are
+// neither null nor a List return an ErrCannotConvert error.
+func ToNullableSlice(v Value) (l []Value, isNull bool, err error) {
+	if IsNull(v) {
+		return nil, true, nil
+	}
+	l, err = ToSlice(v)
+	return l, false, err
+}
+
 // ToTuple takes a CQL Tuple and returns the underlying golang value, a map[string]Value.
 func ToTuple(v Value) (map[string]Value, error) {
 	t, ok := v.GolangValue().(Tuple)
 	if !ok {
-		return nil, fmt.Errorf("%w %v to a map[string]Value", ErrCannotConvert, v.RuntimeType())
+		return nil, cannotConvertError(v, "a map[string]Value")
 	}
 	return t.Value, nil
 }
@@ -141,7 +179,7 @@ func ToTuple(v Value) (map[string]Value, error) {
 func ToProto(v Value) (proto.Message, error) {
 	t, ok := v.GolangValue().(Named)
 	if !ok {
-		return nil, fmt.Errorf("%w %v to a proto.Message", ErrCannotConvert, v.RuntimeType())
+		return nil, cannotConvertError(v, "a proto.Message")
 	}
 	return t.Value, nil
 }
@@ -150,7 +188,7 @@ func ToProto(v Value) (proto.Message, error) {
 func ToCodeSystem(o Value) (CodeSystem, error) {
 	i, ok := o.GolangValue().(CodeSystem)
 	if !ok {
-		return CodeSystem{}, fmt.Errorf("%w %v to a CodeSystem", ErrCannotConvert, o.RuntimeType())
+		return CodeSystem{}, cannotConvertError(o, "a CodeSystem")
 	}
 	return i, nil
 }
@@ -159,7 +197,7 @@ func ToCodeSystem(o Value) (CodeSystem, error) {
 func ToValueSet(o Value) (ValueSet, error) {
 	i, ok := o.GolangValue().(ValueSet)
 	if !ok {
-		return ValueSet{}, fmt.Errorf("%w %v to a ValueSet", ErrCannotConvert, o.RuntimeType())
+		return ValueSet{}, cannotConvertError(o, "a ValueSet")
 	}
 	return i, nil
 }
@@ -168,7 +206,7 @@ func ToValueSet(o Value) (ValueSet, error) {
 func ToConcept(v Value) (Concept, error) {
 	c, ok := v.GolangValue().(Concept)
 	if !ok {
-		return Concept{}, fmt.Errorf("%w %v to a Concept", ErrCannotConvert, v.RuntimeType())
+		return Concept{}, cannotConvertError(v, "a Concept")
 	}
 	return c, nil
 }
@@ -177,7 +215,7 @@ func ToConcept(v Value) (Concept, error) {
 func ToCode(v Value) (Code, error) {
 	i, ok := v.GolangValue().(Code)
 	if !ok {
-		return Code{}, fmt.Errorf("%w %v to a Code", ErrCannotConvert, v.RuntimeType())
+		return Code{}, cannotConvertError(v, "a Code")
 	}
 	return i, nil
 }
@@ -239,14 +239,77 @@ func TestToSlice(t *testing.T) {
 }
 
 func TestToSliceError(t *testing.T) {
-	input := newOrFatal(t, 4.0)
-	want := "cannot convert"
-	_, err := ToSlice(input)
-	if err == nil {
-		t.Fatalf("ToSlice(%v) succeeded, want error", input)
+	tests := []struct {
+		name  string
+		input Value
+	}{
+		{
+			name:  "Decimal",
+			input: newOrFatal(t, 4.0),
+		},
+		{
+			name:  "Null",
+			input: newOrFatal(t, nil),
+		},
 	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ToSlice(test.input)
+			if err == nil {
+				t.Fatalf("ToSlice(%v) succeeded, want error", test.input)
+			}
+			if !errors.Is(err, ErrCannotConvert) {
+				t.Errorf("ToSlice() got error %v want %v", err, ErrCannotConvert)
+			}
+		})
+	}
+}
+
+func TestToNullableSlice(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      Value
+		want       []Value
+		wantIsNull bool
+	}{
+		{
+			name:  "List",
+			input: newOrFatal(t, List{Value: []Value{newOrFatal(t, 4)}}),
+			want:  []Value{newOrFatal(t, 4)},
+		},
+		{
+			name:  "Empty List",
+			input: newOrFatal(t, List{Value: []Value{}}),
+			want:  []Value{},
+		},
+		{
+			name:       "Null",
+			input:      newOrFatal(t, nil),
+			want:       nil,
+			wantIsNull: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, gotIsNull, err := ToNullableSlice(test.input)
+			if err != nil {
+				t.Fatalf("ToNullableSlice(%v) failed: %v", test.input, err)
+			}
+			if gotIsNull != test.wantIsNull {
+				t.Errorf("ToNullableSlice(%v) returned isNull %v, want %v", test.input, gotIsNull, test.wantIsNull)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ToNullableSlice(%v) returned diff (-want +got):\n%s", test.input, diff)
+			}
+		})
+	}
+}
+
+func TestToNullableSliceError(t *testing.T) {
+	input := newOrFatal(t, 4.0)
+	_, _, err := ToNullableSlice(input)
 	if !errors.Is(err, ErrCannotConvert) {
-		t.Errorf("ToSlice() got error %v want %v", err, want)
+		t.Errorf("ToNullableSlice(%v) got error %v want %v", input, err, ErrCannotConvert)
 	}
 }
 
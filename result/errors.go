@@ -0,0 +1,92 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"fmt"
+
+	"github.com/google/cql/model"
+	"github.com/google/cql/types"
+)
+
+// The errors below are returned by CQL operators during evaluation. They are wrapped in an
+// EngineError, so callers can match on them with errors.As.
+
+// UnsupportedTypeError is returned when an operator is evaluated with an operand of a type it
+// does not support.
+type UnsupportedTypeError struct {
+	// Operator is the name of the CQL operator, for example Sum.
+	Operator string
+	// Type is the runtime type of the unsupported operand.
+	Type types.IType
+}
+
+func (e UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("%v does not support operands of type %v", e.Operator, e.Type)
+}
+
+// IncompatibleUnitError is returned when an operator requires Quantities to be converted to the
+// same unit, but the units could not be converted.
+type IncompatibleUnitError struct {
+	// Operator is the name of the CQL operator, for example Sum.
+	Operator string
+	// Unit and OtherUnit are the units that could not be converted.
+	Unit      model.Unit
+	OtherUnit model.Unit
+	// Err is the underlying conversion error, if any.
+	Err error
+}
+
+func (e IncompatibleUnitError) Error() string {
+	msg := fmt.Sprintf("%v got Quantity values with different units that could not be converted, got %q and %q", e.Operator, e.Unit, e.OtherUnit)
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e IncompatibleUnitError) Unwrap() error {
+	return e.Err
+}
+
+// OverflowError is returned when the result of an operator does not fit in the range of its
+// result type.
+type OverflowError struct {
+	// Operator is the name of the CQL operator, for example Product.
+	Operator string
+	// Type is the type whose range was exceeded, for example Integer.
+	Type types.IType
+}
+
+func (e OverflowError) Error() string {
+	return fmt.Sprintf("%v overflowed the %v range", e.Operator, e.Type)
+}
+
+// DomainError is returned when an operand has a supported type, but a value for which the
+// operator is undefined.
+type DomainError struct {
+	// Operator is the name of the CQL operator, for example GeometricMean.
+	Operator string
+	// Type is the runtime type of the operand.
+	Type types.IType
+	// Reason describes the values the operator is undefined for, for example negative values.
+	Reason string
+	// Value is the value the operator is undefined for.
+	Value any
+}
+
+func (e DomainError) Error() string {
+	return fmt.Sprintf("%v(%v) is undefined for %v, got %v", e.Operator, e.Type, e.Reason, e.Value)
+}
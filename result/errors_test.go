@@ -0,0 +1,77 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/cql/types"
+)
+
+func TestErrors_As(t *testing.T) {
+	errConvert := errors.New("incompatible units")
+	tests := []struct {
+		name        string
+		err         error
+		wantErrAs   any
+		wantMessage string
+	}{
+		{
+			name:        "UnsupportedTypeError",
+			err:         UnsupportedTypeError{Operator: "Sum", Type: &types.List{ElementType: types.String}},
+			wantErrAs:   &UnsupportedTypeError{},
+			wantMessage: "Sum does not support operands of type List<System.String>",
+		},
+		{
+			name:        "IncompatibleUnitError",
+			err:         IncompatibleUnitError{Operator: "Sum", Unit: "g", OtherUnit: "m", Err: errConvert},
+			wantErrAs:   &IncompatibleUnitError{},
+			wantMessage: `Sum got Quantity values with different units that could not be converted, got "g" and "m": incompatible units`,
+		},
+		{
+			name:        "OverflowError",
+			err:         OverflowError{Operator: "Product", Type: types.Integer},
+			wantErrAs:   &OverflowError{},
+			wantMessage: "Product overflowed the System.Integer range",
+		},
+		{
+			name:        "DomainError",
+			err:         DomainError{Operator: "GeometricMean", Type: &types.List{ElementType: types.Decimal}, Reason: "negative values", Value: -1.5},
+			wantErrAs:   &DomainError{},
+			wantMessage: "GeometricMean(List<System.Decimal>) is undefined for negative values, got -1.5",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wrapped := NewEngineError("TESTLIB", ErrEvaluationError, fmt.Errorf("define %q: %w", "TESTRESULT", tc.err))
+			if !errors.As(wrapped, tc.wantErrAs) {
+				t.Errorf("errors.As(%v, %T) = false, want true", wrapped, tc.wantErrAs)
+			}
+			if got := tc.err.Error(); got != tc.wantMessage {
+				t.Errorf("Error() = %q, want %q", got, tc.wantMessage)
+			}
+		})
+	}
+}
+
+func TestIncompatibleUnitError_Unwrap(t *testing.T) {
+	errConvert := errors.New("incompatible units")
+	err := IncompatibleUnitError{Operator: "Avg", Unit: "g", OtherUnit: "m", Err: errConvert}
+	if !errors.Is(err, errConvert) {
+		t.Errorf("errors.Is(%v, %v) = false, want true", err, errConvert)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/cql/internal/datehelpers"
+	"github.com/google/cql/model"
+	"github.com/google/cql/types"
+)
+
+// NewFromJSON converts the JSON produced by Value.MarshalJSON back into a Value. The source
+// expression and source values are not serialized so they are not restored. Named values are not
+// supported since their runtime types cannot be resolved without the data model. Dates and
+// DateTimes without an offset are parsed in UTC.
+func NewFromJSON(data []byte) (Value, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		// CQL nulls are always serialized with a type, a bare JSON null means the value was absent.
+		return Value{}, fmt.Errorf("tried to unmarshal a JSON null, CQL null values must have an @type")
+	}
+	if len(data) > 0 && data[0] == '[' {
+		// Lists don't embed the type.
+		return listFromJSON(data)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return Value{}, err
+	}
+	rawType, ok := fields["@type"]
+	if !ok {
+		// Tuples don't embed the type.
+		return tupleFromJSON(fields)
+	}
+	var typeName string
+	if err := json.Unmarshal(rawType, &typeName); err != nil {
+		return Value{}, err
+	}
+	if v, ok := fields["value"]; ok && string(v) == "null" {
+		return New(nil)
+	}
+
+	switch typeName {
+	case types.Boolean.String():
+		return simpleFromJSON[bool](fields)
+	case types.String.String():
+		return simpleFromJSON[string](fields)
+	case types.Integer.String():
+		if _, ok := fields["low"]; ok {
+			// Uncertain Integers are serialized with a low and high instead of a value.
+			var u struct {
+				Low  int32 `json:"low"`
+				High int32 `json:"high"`
+			}
+			if err := json.Unmarshal(data, &u); err != nil {
+				return Value{}, err
+			}
+			return New(Uncertainty{Low: u.Low, High: u.High})
+		}
+		return simpleFromJSON[int32](fields)
+	case types.Long.String():
+		return simpleFromJSON[int64](fields)
+	case types.Decimal.String():
+		return simpleFromJSON[float64](fields)
+	case types.Date.String():
+		d, p, err := dateTimeFromJSON(fields, datehelpers.ParseDate)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(Date{Date: d, Precision: p})
+	case types.DateTime.String():
+		d, p, err := dateTimeFromJSON(fields, datehelpers.ParseDateTime)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(DateTime{Date: d, Precision: p})
+	case types.Time.String():
+		// Times are serialized without the leading @.
+		parseTime := func(s string, loc *time.Location) (time.Time, model.DateTimePrecision, error) {
+			return datehelpers.ParseTime("@"+s, loc)
+		}
+		t, p, err := dateTimeFromJSON(fields, parseTime)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(Time{Date: t, Precision: p})
+	case types.Quantity.String():
+		q, err := quantityFromJSON(data)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(q)
+	case types.Ratio.String():
+		n, err := quantityFromJSON(fields["numerator"])
+		if err != nil {
+			return Value{}, err
+		}
+		d, err := quantityFromJSON(fields["denominator"])
+		if err != nil {
+			return Value{}, err
+		}
+		return New(Ratio{Numerator: n, Denominator: d})
+	case types.Code.String():
+		c, err := codeFromJSON(data)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(c)
+	case types.Concept.String():
+		return conceptFromJSON(fields)
+	case types.ValueSet.String():
+		var vs struct {
+			ID          string       `json:"id"`
+			Version     string       `json:"version"`
+			CodeSystems []CodeSystem `json:"codesystems"`
+		}
+		if err := json.Unmarshal(data, &vs); err != nil {
+			return Value{}, err
+		}
+		return New(ValueSet{ID: vs.ID, Version: vs.Version, CodeSystems: vs.CodeSystems})
+	case types.CodeSystem.String():
+		var cs struct {
+			ID      string `json:"id"`
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(data, &cs); err != nil {
+			return Value{}, err
+		}
+		return New(CodeSystem{ID: cs.ID, Version: cs.Version})
+	}
+
+	if strings.HasPrefix(typeName, "Interval<") && strings.HasSuffix(typeName, ">") {
+		return intervalFromJSON(fields, strings.TrimSuffix(strings.TrimPrefix(typeName, "Interval<"), ">"))
+	}
+	return Value{}, fmt.Errorf("tried to unmarshal unsupported type %v, %w", typeName, errUnsupportedType)
+}
+
+func simpleFromJSON[T bool | string | int32 | int64 | float64](fields map[string]json.RawMessage) (Value, error) {
+	var v T
+	if err := json.Unmarshal(fields["value"], &v); err != nil {
+		return Value{}, err
+	}
+	return New(v)
+}
+
+func dateTimeFromJSON(fields map[string]json.RawMessage, parse func(string, *time.Location) (time.Time, model.DateTimePrecision, error)) (time.Time, model.DateTimePrecision, error) {
+	var s string
+	if err := json.Unmarshal(fields["value"], &s); err != nil {
+		return time.Time{}, model.UNSETDATETIMEPRECISION, err
+	}
+	return parse(s, time.UTC)
+}
+
+func quantityFromJSON(data json.RawMessage) (Quantity, error) {
+	var q struct {
+		Value float64 `json:"value"`
+		Unit  string  `json:"unit"`
+	}
+	if err := json.Unmarshal(data, &q); err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: q.Value, Unit: model.Unit(q.Unit)}, nil
+}
+
+func codeFromJSON(data json.RawMessage) (Code, error) {
+	var c struct {
+		Code    string `json:"code"`
+		Display string `json:"display"`
+		System  string `json:"system"`
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Code{}, err
+	}
+	return Code{Code: c.Code, Display: c.Display, System: c.System, Version: c.Version}, nil
+}
+
+func conceptFromJSON(fields map[string]json.RawMessage) (Value, error) {
+	concept := Concept{}
+	if display, ok := fields["display"]; ok {
+		if err := json.Unmarshal(display, &concept.Display); err != nil {
+			return Value{}, err
+		}
+	}
+	var rawCodes []json.RawMessage
+	if codes, ok := fields["codes"]; ok {
+		if err := json.Unmarshal(codes, &rawCodes); err != nil {
+			return Value{}, err
+		}
+	}
+	for _, rawCode := range rawCodes {
+		c, err := codeFromJSON(rawCode)
+		if err != nil {
+			return Value{}, err
+		}
+		concept.Codes = append(concept.Codes, &c)
+	}
+	return New(concept)
+}
+
+func intervalFromJSON(fields map[string]json.RawMessage, pointTypeName string) (Value, error) {
+	pointType := types.ToSystem(pointTypeName)
+	if pointType == types.Unset {
+		return Value{}, fmt.Errorf("tried to unmarshal interval with unsupported point type %v, %w", pointTypeName, errUnsupportedType)
+	}
+	low, err := NewFromJSON(fields["low"])
+	if err != nil {
+		return Value{}, err
+	}
+	high, err := NewFromJSON(fields["high"])
+	if err != nil {
+		return Value{}, err
+	}
+	interval := Interval{Low: low, High: high, StaticType: &types.Interval{PointType: pointType}}
+	if err := json.Unmarshal(fields["lowClosed"], &interval.LowInclusive); err != nil {
+		return Value{}, err
+	}
+	if err := json.Unmarshal(fields["highClosed"], &interval.HighInclusive); err != nil {
+		return Value{}, err
+	}
+	return New(interval)
+}
+
+func listFromJSON(data []byte) (Value, error) {
+	var rawElems []json.RawMessage
+	if err := json.Unmarshal(data, &rawElems); err != nil {
+		return Value{}, err
+	}
+	l := List{Value: make([]Value, 0, len(rawElems))}
+	for _, rawElem := range rawElems {
+		elem, err := NewFromJSON(rawElem)
+		if err != nil {
+			return Value{}, err
+		}
+		l.Value = append(l.Value, elem)
+	}
+	// The static type is not serialized, so it is inferred from the elements.
+	l.StaticType = &types.List{ElementType: types.Any}
+	if inferred, ok := inferListType(l.Value, l.StaticType).(*types.List); ok {
+		l.StaticType = inferred
+	}
+	return New(l)
+}
+
+func tupleFromJSON(fields map[string]json.RawMessage) (Value, error) {
+	vals := make(map[string]Value, len(fields))
+	elemTypes := make(map[string]types.IType, len(fields))
+	for k, rawElem := range fields {
+		elem, err := NewFromJSON(rawElem)
+		if err != nil {
+			return Value{}, err
+		}
+		vals[k] = elem
+		elemTypes[k] = elem.RuntimeType()
+	}
+	return New(Tuple{Value: vals, RuntimeType: &types.Tuple{ElementTypes: elemTypes}})
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/cql/model"
+	"github.com/google/cql/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewFromJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		value Value
+	}{
+		{
+			name:  "Null",
+			value: newOrFatal(t, nil),
+		},
+		{
+			name:  "Boolean",
+			value: newOrFatal(t, true),
+		},
+		{
+			name:  "String",
+			value: newOrFatal(t, "hello"),
+		},
+		{
+			name:  "Integer",
+			value: newOrFatal(t, 1),
+		},
+		{
+			name:  "Long",
+			value: newOrFatal(t, int64(1)),
+		},
+		{
+			name:  "Uncertainty",
+			value: newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+		},
+		{
+			name:  "Decimal",
+			value: newOrFatal(t, 4.5),
+		},
+		{
+			name:  "Quantity",
+			value: newOrFatal(t, Quantity{Value: 1.5, Unit: model.YEARUNIT}),
+		},
+		{
+			name:  "Ratio",
+			value: newOrFatal(t, Ratio{Numerator: Quantity{Value: 1, Unit: "mg"}, Denominator: Quantity{Value: 2, Unit: "mL"}}),
+		},
+		{
+			name:  "Code",
+			value: newOrFatal(t, Code{Code: "foo", System: "bar", Display: "the foo", Version: "1.0"}),
+		},
+		{
+			name:  "Concept",
+			value: newOrFatal(t, Concept{Codes: []*Code{{Code: "foo", System: "bar"}, {Code: "baz", System: "bar"}}, Display: "concept"}),
+		},
+		{
+			name:  "ValueSet",
+			value: newOrFatal(t, ValueSet{ID: "https://example.com/vs", Version: "1.0", CodeSystems: []CodeSystem{{ID: "https://example.com/cs", Version: "2.0"}}}),
+		},
+		{
+			name:  "CodeSystem",
+			value: newOrFatal(t, CodeSystem{ID: "https://example.com/cs", Version: "2.0"}),
+		},
+		{
+			name:  "Date",
+			value: newOrFatal(t, Date{Date: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), Precision: model.DAY}),
+		},
+		{
+			name:  "DateTime",
+			value: newOrFatal(t, DateTime{Date: time.Date(2024, time.March, 31, 1, 20, 30, 1e8, time.UTC), Precision: model.MILLISECOND}),
+		},
+		{
+			name:  "Time",
+			value: newOrFatal(t, Time{Date: time.Date(0, time.January, 1, 1, 20, 30, 0, time.UTC), Precision: model.SECOND}),
+		},
+		{
+			name: "Interval",
+			value: newOrFatal(t, Interval{
+				Low:           newOrFatal(t, 10),
+				High:          newOrFatal(t, 20),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Interval with null low",
+			value: newOrFatal(t, Interval{
+				Low:           newOrFatal(t, nil),
+				High:          newOrFatal(t, 20),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name:  "List",
+			value: newOrFatal(t, List{Value: []Value{newOrFatal(t, 3), newOrFatal(t, nil)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:  "Empty list",
+			value: newOrFatal(t, List{Value: []Value{}, StaticType: &types.List{ElementType: types.Any}}),
+		},
+		{
+			name: "Nested list",
+			value: newOrFatal(t, List{
+				Value: []Value{
+					newOrFatal(t, List{Value: []Value{newOrFatal(t, "a")}, StaticType: &types.List{ElementType: types.String}}),
+				},
+				StaticType: &types.List{ElementType: &types.List{ElementType: types.String}},
+			}),
+		},
+		{
+			name: "Tuple",
+			value: newOrFatal(t, Tuple{
+				Value:       map[string]Value{"Apple": newOrFatal(t, 10), "Banana": newOrFatal(t, "yellow")},
+				RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{"Apple": types.Integer, "Banana": types.String}},
+			}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			marshalled, err := tc.value.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON() returned unexpected error: %v", err)
+			}
+			got, err := NewFromJSON(marshalled)
+			if err != nil {
+				t.Fatalf("NewFromJSON(%s) returned unexpected error: %v", marshalled, err)
+			}
+			if diff := cmp.Diff(tc.value, got); diff != "" {
+				t.Errorf("NewFromJSON(%s) returned unexpected diff (-want +got):\n%s", marshalled, diff)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON_NullDistinctFromAbsent(t *testing.T) {
+	withNull := newOrFatal(t, Tuple{
+		Value:       map[string]Value{"Apple": newOrFatal(t, nil)},
+		RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{"Apple": types.Any}},
+	})
+	withoutElement := newOrFatal(t, Tuple{
+		Value:       map[string]Value{},
+		RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{}},
+	})
+
+	nullJSON, err := withNull.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned unexpected error: %v", err)
+	}
+	absentJSON, err := withoutElement.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(`{"Apple":{"@type":"System.Any","value":null}}`, string(nullJSON)); diff != "" {
+		t.Errorf("MarshalJSON() of tuple with null element returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(`{}`, string(absentJSON)); diff != "" {
+		t.Errorf("MarshalJSON() of tuple without element returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	got, err := NewFromJSON(nullJSON)
+	if err != nil {
+		t.Fatalf("NewFromJSON(%s) returned unexpected error: %v", nullJSON, err)
+	}
+	elem, ok := got.GolangValue().(Tuple).Value["Apple"]
+	if !ok || !IsNull(elem) {
+		t.Errorf("NewFromJSON(%s) = %v, want tuple with a null Apple element", nullJSON, got)
+	}
+}
+
+func TestNewFromJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		errContains string
+	}{
+		{
+			name:        "Bare JSON null",
+			json:        `null`,
+			errContains: "must have an @type",
+		},
+		{
+			name:        "Unsupported type",
+			json:        `{"@type":"FHIR.Patient","value":{"active":{"value":true}}}`,
+			errContains: "unsupported type",
+		},
+		{
+			name:        "Mismatched value",
+			json:        `{"@type":"System.Integer","value":"one"}`,
+			errContains: "cannot unmarshal",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewFromJSON([]byte(tc.json))
+			if err == nil {
+				t.Fatalf("NewFromJSON(%s) succeeded, want error", tc.json)
+			}
+			if !strings.Contains(err.Error(), tc.errContains) {
+				t.Errorf("NewFromJSON(%s) returned error %v, want error containing %q", tc.json, err, tc.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/cql/model"
+	"github.com/google/cql/types"
+	d4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/datatypes_go_proto"
+	r4parameterspb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/parameters_go_proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+const ucumSystem = "http://unitsofmeasure.org"
+
+// calendarToUCUM maps CQL calendar duration units to their UCUM codes.
+var calendarToUCUM = map[model.Unit]string{
+	model.YEARUNIT:        "a",
+	model.MONTHUNIT:       "mo",
+	model.WEEKUNIT:        "wk",
+	model.DAYUNIT:         "d",
+	model.HOURUNIT:        "h",
+	model.MINUTEUNIT:      "min",
+	model.SECONDUNIT:      "s",
+	model.MILLISECONDUNIT: "ms",
+}
+
+// ToFHIRParameters converts a map of expression definition name to result into a FHIR R4
+// Parameters resource, with one parameter per result ordered by definition name. CQL types are
+// mapped to their FHIR equivalents:
+//
+//   - Boolean, String, Integer, Decimal, Date, DateTime and Time map to the FHIR primitives.
+//     Longs map to decimal since R4 has no 64 bit integer.
+//   - Quantity, Ratio, Code and Concept map to Quantity, Ratio, Coding and CodeableConcept.
+//   - Intervals of Date or DateTime map to Period, and intervals of numbers or Quantities map to
+//     Range. Whether the interval bounds are closed is not preserved.
+//   - Lists map to a repeated parameter with the same name, an empty list produces no parameters.
+//     Nested lists and Tuples map to a parameter with parts.
+//   - FHIR resources are set as the parameter resource, other FHIR data types as the value.
+//   - Null maps to a parameter with the value omitted.
+func ToFHIRParameters(results map[string]Value) (*r4parameterspb.Parameters, error) {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	params := &r4parameterspb.Parameters{}
+	for _, name := range names {
+		l, ok := results[name].GolangValue().(List)
+		if !ok {
+			param, err := toFHIRParameter(name, results[name])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			params.Parameter = append(params.Parameter, param)
+			continue
+		}
+		for _, elem := range l.Value {
+			param, err := toFHIRParameter(name, elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			params.Parameter = append(params.Parameter, param)
+		}
+	}
+	return params, nil
+}
+
+func toFHIRParameter(name string, v Value) (*r4parameterspb.Parameters_Parameter, error) {
+	param := &r4parameterspb.Parameters_Parameter{Name: &d4pb.String{Value: name}}
+	switch t := v.GolangValue().(type) {
+	case nil:
+		return param, nil
+	case List:
+		for _, elem := range t.Value {
+			part, err := toFHIRParameter(name, elem)
+			if err != nil {
+				return nil, err
+			}
+			param.Part = append(param.Part, part)
+		}
+		return param, nil
+	case Tuple:
+		keys := make([]string, 0, len(t.Value))
+		for k := range t.Value {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			part, err := toFHIRParameter(k, t.Value[k])
+			if err != nil {
+				return nil, err
+			}
+			param.Part = append(param.Part, part)
+		}
+		return param, nil
+	case Named:
+		if vx, ok := parameterValueX(t.Value); ok {
+			param.Value = vx
+			return param, nil
+		}
+		// Named values that are not FHIR data types are resources.
+		resource, err := anypb.New(t.Value)
+		if err != nil {
+			return nil, err
+		}
+		param.Resource = resource
+		return param, nil
+	}
+
+	fhirValue, err := toFHIRDataType(v)
+	if err != nil {
+		return nil, err
+	}
+	vx, ok := parameterValueX(fhirValue)
+	if !ok {
+		return nil, fmt.Errorf("internal error - %T is not a valid FHIR parameter value", fhirValue)
+	}
+	param.Value = vx
+	return param, nil
+}
+
+// parameterValueX wraps the FHIR data type in the Parameters value[x] choice field with the same
+// message type, returning false if there is no such field.
+func parameterValueX(msg proto.Message) (*r4parameterspb.Parameters_Parameter_ValueX, bool) {
+	vx := &r4parameterspb.Parameters_Parameter_ValueX{}
+	msgName := msg.ProtoReflect().Descriptor().FullName()
+	fields := vx.ProtoReflect().Descriptor().Oneofs().ByName("choice").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.Message() != nil && f.Message().FullName() == msgName {
+			vx.ProtoReflect().Set(f, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+			return vx, true
+		}
+	}
+	return nil, false
+}
+
+// toFHIRDataType converts a non-null CQL System value to the equivalent FHIR data type.
+func toFHIRDataType(v Value) (proto.Message, error) {
+	switch t := v.GolangValue().(type) {
+	case bool:
+		return &d4pb.Boolean{Value: t}, nil
+	case string:
+		return &d4pb.String{Value: t}, nil
+	case int32:
+		return &d4pb.Integer{Value: t}, nil
+	case int64:
+		return &d4pb.Decimal{Value: strconv.FormatInt(t, 10)}, nil
+	case float64:
+		return fhirDecimal(t), nil
+	case Date:
+		return fhirDate(t), nil
+	case DateTime:
+		return fhirDateTime(t), nil
+	case Time:
+		return fhirTime(t), nil
+	case Quantity:
+		return fhirQuantity(t), nil
+	case Ratio:
+		return &d4pb.Ratio{Numerator: fhirQuantity(t.Numerator), Denominator: fhirQuantity(t.Denominator)}, nil
+	case Code:
+		return fhirCoding(t), nil
+	case Concept:
+		cc := &d4pb.CodeableConcept{}
+		for _, c := range t.Codes {
+			if c != nil {
+				cc.Coding = append(cc.Coding, fhirCoding(*c))
+			}
+		}
+		if t.Display != "" {
+			cc.Text = &d4pb.String{Value: t.Display}
+		}
+		return cc, nil
+	case Interval:
+		return fhirInterval(t)
+	case Uncertainty:
+		// FHIR has no uncertain Integer, so the possible values are converted to a Range.
+		return fhirInterval(t.interval())
+	default:
+		return nil, fmt.Errorf("converting %v to a FHIR parameter %w", v.RuntimeType(), errUnsupportedType)
+	}
+}
+
+func fhirDecimal(f float64) *d4pb.Decimal {
+	return &d4pb.Decimal{Value: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+func fhirTimezone(t time.Time) string {
+	if t.Location() == time.UTC {
+		return "UTC"
+	}
+	return t.Format("-07:00")
+}
+
+func fhirDate(d Date) *d4pb.Date {
+	p := d4pb.Date_DAY
+	switch d.Precision {
+	case model.YEAR:
+		p = d4pb.Date_YEAR
+	case model.MONTH:
+		p = d4pb.Date_MONTH
+	}
+	return &d4pb.Date{ValueUs: d.Date.UnixMicro(), Timezone: fhirTimezone(d.Date), Precision: p}
+}
+
+func fhirDateTime(d DateTime) *d4pb.DateTime {
+	// FHIR DateTimes do not have hour or minute precision so they are output with second precision.
+	p := d4pb.DateTime_SECOND
+	switch d.Precision {
+	case model.YEAR:
+		p = d4pb.DateTime_YEAR
+	case model.MONTH:
+		p = d4pb.DateTime_MONTH
+	case model.DAY:
+		p = d4pb.DateTime_DAY
+	case model.MILLISECOND:
+		p = d4pb.DateTime_MILLISECOND
+	}
+	return &d4pb.DateTime{ValueUs: d.Date.UnixMicro(), Timezone: fhirTimezone(d.Date), Precision: p}
+}
+
+func fhirTime(t Time) *d4pb.Time {
+	// FHIR Times do not have hour or minute precision so they are output with second precision.
+	p := d4pb.Time_SECOND
+	if t.Precision == model.MILLISECOND {
+		p = d4pb.Time_MILLISECOND
+	}
+	midnight := time.Date(t.Date.Year(), t.Date.Month(), t.Date.Day(), 0, 0, 0, 0, t.Date.Location())
+	return &d4pb.Time{ValueUs: t.Date.Sub(midnight).Microseconds(), Precision: p}
+}
+
+func fhirQuantity(q Quantity) *d4pb.Quantity {
+	fq := &d4pb.Quantity{Value: fhirDecimal(q.Value)}
+	if q.Unit == model.UNSETUNIT {
+		return fq
+	}
+	code, ok := calendarToUCUM[q.Unit]
+	if !ok {
+		code = string(q.Unit)
+	}
+	fq.Unit = &d4pb.String{Value: string(q.Unit)}
+	fq.System = &d4pb.Uri{Value: ucumSystem}
+	fq.Code = &d4pb.Code{Value: code}
+	return fq
+}
+
+func fhirCoding(c Code) *d4pb.Coding {
+	coding := &d4pb.Coding{Code: &d4pb.Code{Value: c.Code}}
+	if c.System != "" {
+		coding.System = &d4pb.Uri{Value: c.System}
+	}
+	if c.Version != "" {
+		coding.Version = &d4pb.String{Value: c.Version}
+	}
+	if c.Display != "" {
+		coding.Display = &d4pb.String{Value: c.Display}
+	}
+	return coding
+}
+
+// fhirInterval converts Date and DateTime intervals to a Period and numeric and Quantity intervals
+// to a Range. Null bounds are omitted.
+func fhirInterval(i Interval) (proto.Message, error) {
+	pointType := i.StaticType.PointType
+	if it, ok := inferIntervalType(i).(*types.Interval); ok {
+		pointType = it.PointType
+	}
+	// FHIR Periods and Ranges are closed, so open bounds are replaced by their successor or
+	// predecessor.
+	low, err := closedBound(i.Low, i.LowInclusive, 1)
+	if err != nil {
+		return nil, err
+	}
+	high, err := closedBound(i.High, i.HighInclusive, -1)
+	if err != nil {
+		return nil, err
+	}
+	switch pointType {
+	case types.Date, types.DateTime:
+		period := &d4pb.Period{}
+		if period.Start, err = periodBound(low); err != nil {
+			return nil, err
+		}
+		if period.End, err = periodBound(high); err != nil {
+			return nil, err
+		}
+		return period, nil
+	case types.Integer, types.Long, types.Decimal, types.Quantity:
+		r := &d4pb.Range{}
+		if r.Low, err = rangeBound(low); err != nil {
+			return nil, err
+		}
+		if r.High, err = rangeBound(high); err != nil {
+			return nil, err
+		}
+		return r, nil
+	default:
+		return nil, fmt.Errorf("converting %v to a FHIR parameter %w", i.StaticType, errUnsupportedType)
+	}
+}
+
+// closedBound returns the closed equivalent of an interval bound, moving an open bound by step
+// points. Only bounds of discrete point types can be closed, other open bounds return an error.
+func closedBound(v Value, inclusive bool, step int) (Value, error) {
+	if inclusive || IsNull(v) {
+		return v, nil
+	}
+	operator := "Successor"
+	if step < 0 {
+		operator = "Predecessor"
+	}
+	switch t := v.GolangValue().(type) {
+	case int32:
+		b := int64(t) + int64(step)
+		if b < math.MinInt32 || b > math.MaxInt32 {
+			return Value{}, OverflowError{Operator: operator, Type: types.Integer}
+		}
+		return New(int32(b))
+	case int64:
+		if (step > 0 && t == math.MaxInt64) || (step < 0 && t == math.MinInt64) {
+			return Value{}, OverflowError{Operator: operator, Type: types.Long}
+		}
+		return New(t + int64(step))
+	case Date:
+		d, err := addPrecision(t.Date, t.Precision, step)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(Date{Date: d, Precision: t.Precision})
+	case DateTime:
+		d, err := addPrecision(t.Date, t.Precision, step)
+		if err != nil {
+			return Value{}, err
+		}
+		return New(DateTime{Date: d, Precision: t.Precision})
+	default:
+		return Value{}, fmt.Errorf("converting an interval with an open %v bound to a FHIR parameter %w", v.RuntimeType(), errUnsupportedType)
+	}
+}
+
+// addPrecision adds n units of precision p to t.
+func addPrecision(t time.Time, p model.DateTimePrecision, n int) (time.Time, error) {
+	switch p {
+	case model.YEAR:
+		return t.AddDate(n, 0, 0), nil
+	case model.MONTH:
+		return t.AddDate(0, n, 0), nil
+	case model.DAY:
+		return t.AddDate(0, 0, n), nil
+	case model.HOUR:
+		return t.Add(time.Duration(n) * time.Hour), nil
+	case model.MINUTE:
+		return t.Add(time.Duration(n) * time.Minute), nil
+	case model.SECOND:
+		return t.Add(time.Duration(n) * time.Second), nil
+	case model.MILLISECOND:
+		return t.Add(time.Duration(n) * time.Millisecond), nil
+	default:
+		return time.Time{}, fmt.Errorf("internal error - unsupported precision %v for an interval bound", p)
+	}
+}
+
+func periodBound(v Value) (*d4pb.DateTime, error) {
+	switch t := v.GolangValue().(type) {
+	case nil:
+		return nil, nil
+	case Date:
+		d := fhirDate(t)
+		return &d4pb.DateTime{ValueUs: d.ValueUs, Timezone: d.Timezone, Precision: d4pb.DateTime_Precision(d.Precision)}, nil
+	case DateTime:
+		return fhirDateTime(t), nil
+	default:
+		return nil, fmt.Errorf("internal error - unexpected Period bound %v", v.RuntimeType())
+	}
+}
+
+func rangeBound(v Value) (*d4pb.SimpleQuantity, error) {
+	var q *d4pb.Quantity
+	switch t := v.GolangValue().(type) {
+	case nil:
+		return nil, nil
+	case int32:
+		q = fhirQuantity(Quantity{Value: float64(t)})
+	case int64:
+		q = &d4pb.Quantity{Value: &d4pb.Decimal{Value: strconv.FormatInt(t, 10)}}
+	case float64:
+		q = fhirQuantity(Quantity{Value: t})
+	case Quantity:
+		q = fhirQuantity(t)
+	default:
+		return nil, fmt.Errorf("internal error - unexpected Range bound %v", v.RuntimeType())
+	}
+	return &d4pb.SimpleQuantity{Value: q.Value, Unit: q.Unit, System: q.System, Code: q.Code}, nil
+}
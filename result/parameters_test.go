@@ -0,0 +1,435 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/cql/model"
+	"github.com/google/cql/types"
+	d4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/datatypes_go_proto"
+	r4parameterspb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/parameters_go_proto"
+	r4patientpb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/patient_go_proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestToFHIRParameters(t *testing.T) {
+	patient := &r4patientpb.Patient{Active: &d4pb.Boolean{Value: true}}
+	patientAny, err := anypb.New(patient)
+	if err != nil {
+		t.Fatalf("anypb.New() returned unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		results map[string]Value
+		want    []*r4parameterspb.Parameters_Parameter
+	}{
+		{
+			name:    "Null omits value",
+			results: map[string]Value{"A": newOrFatal(t, nil)},
+			want:    []*r4parameterspb.Parameters_Parameter{{Name: fhirString("A")}},
+		},
+		{
+			name:    "Boolean",
+			results: map[string]Value{"A": newOrFatal(t, true)},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Boolean{Boolean: &d4pb.Boolean{Value: true}}}),
+			},
+		},
+		{
+			name:    "String",
+			results: map[string]Value{"A": newOrFatal(t, "hello")},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_StringValue{StringValue: fhirString("hello")}}),
+			},
+		},
+		{
+			name:    "Integer",
+			results: map[string]Value{"A": newOrFatal(t, 4)},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Integer{Integer: &d4pb.Integer{Value: 4}}}),
+			},
+		},
+		{
+			name:    "Long maps to decimal",
+			results: map[string]Value{"A": newOrFatal(t, int64(9000000000))},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Decimal{Decimal: &d4pb.Decimal{Value: "9000000000"}}}),
+			},
+		},
+		{
+			name:    "Decimal",
+			results: map[string]Value{"A": newOrFatal(t, 1.25)},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Decimal{Decimal: &d4pb.Decimal{Value: "1.25"}}}),
+			},
+		},
+		{
+			name:    "Date",
+			results: map[string]Value{"A": newOrFatal(t, Date{Date: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), Precision: model.MONTH})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Date{
+					Date: &d4pb.Date{ValueUs: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.Date_MONTH},
+				}}),
+			},
+		},
+		{
+			name:    "DateTime",
+			results: map[string]Value{"A": newOrFatal(t, DateTime{Date: time.Date(2024, time.March, 31, 1, 20, 30, 0, time.FixedZone("", 4*60*60)), Precision: model.SECOND})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_DateTime{
+					DateTime: &d4pb.DateTime{ValueUs: time.Date(2024, time.March, 31, 1, 20, 30, 0, time.FixedZone("", 4*60*60)).UnixMicro(), Timezone: "+04:00", Precision: d4pb.DateTime_SECOND},
+				}}),
+			},
+		},
+		{
+			name:    "Time",
+			results: map[string]Value{"A": newOrFatal(t, Time{Date: time.Date(0, time.January, 1, 1, 20, 30, 1e8, time.UTC), Precision: model.MILLISECOND})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Time{
+					Time: &d4pb.Time{ValueUs: (time.Hour + 20*time.Minute + 30*time.Second + 100*time.Millisecond).Microseconds(), Precision: d4pb.Time_MILLISECOND},
+				}}),
+			},
+		},
+		{
+			name:    "Quantity",
+			results: map[string]Value{"A": newOrFatal(t, Quantity{Value: 5, Unit: "mg"})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Quantity{Quantity: ucumQuantity("5", "mg", "mg")}}),
+			},
+		},
+		{
+			name:    "Quantity with calendar unit maps to UCUM code",
+			results: map[string]Value{"A": newOrFatal(t, Quantity{Value: 2, Unit: model.YEARUNIT})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Quantity{Quantity: ucumQuantity("2", "year", "a")}}),
+			},
+		},
+		{
+			name:    "Ratio",
+			results: map[string]Value{"A": newOrFatal(t, Ratio{Numerator: Quantity{Value: 1, Unit: "mg"}, Denominator: Quantity{Value: 2, Unit: "mL"}})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Ratio{Ratio: &d4pb.Ratio{
+					Numerator:   ucumQuantity("1", "mg", "mg"),
+					Denominator: ucumQuantity("2", "mL", "mL"),
+				}}}),
+			},
+		},
+		{
+			name:    "Code maps to Coding",
+			results: map[string]Value{"A": newOrFatal(t, Code{Code: "foo", System: "https://example.com/cs", Version: "1.0", Display: "the foo"})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Coding{Coding: &d4pb.Coding{
+					Code:    &d4pb.Code{Value: "foo"},
+					System:  &d4pb.Uri{Value: "https://example.com/cs"},
+					Version: fhirString("1.0"),
+					Display: fhirString("the foo"),
+				}}}),
+			},
+		},
+		{
+			name:    "Concept maps to CodeableConcept",
+			results: map[string]Value{"A": newOrFatal(t, Concept{Codes: []*Code{{Code: "foo", System: "https://example.com/cs"}}, Display: "concept"})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_CodeableConcept{CodeableConcept: &d4pb.CodeableConcept{
+					Coding: []*d4pb.Coding{{Code: &d4pb.Code{Value: "foo"}, System: &d4pb.Uri{Value: "https://example.com/cs"}}},
+					Text:   fhirString("concept"),
+				}}}),
+			},
+		},
+		{
+			name: "DateTime Interval maps to Period",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, DateTime{Date: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), Precision: model.DAY}),
+				High:          newOrFatal(t, nil),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.DateTime},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Period{Period: &d4pb.Period{
+					Start: &d4pb.DateTime{ValueUs: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.DateTime_DAY},
+				}}}),
+			},
+		},
+		{
+			name: "Date Interval maps to Period",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, Date{Date: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Precision: model.YEAR}),
+				High:          newOrFatal(t, Date{Date: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), Precision: model.DAY}),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Date},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Period{Period: &d4pb.Period{
+					Start: &d4pb.DateTime{ValueUs: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.DateTime_YEAR},
+					End:   &d4pb.DateTime{ValueUs: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.DateTime_DAY},
+				}}}),
+			},
+		},
+		{
+			name: "Quantity Interval maps to Range",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, Quantity{Value: 1, Unit: "mg"}),
+				High:          newOrFatal(t, Quantity{Value: 5, Unit: "mg"}),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Quantity},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Range{Range: &d4pb.Range{
+					Low:  &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "1"}, Unit: fhirString("mg"), System: &d4pb.Uri{Value: ucumSystem}, Code: &d4pb.Code{Value: "mg"}},
+					High: &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "5"}, Unit: fhirString("mg"), System: &d4pb.Uri{Value: ucumSystem}, Code: &d4pb.Code{Value: "mg"}},
+				}}}),
+			},
+		},
+		{
+			name: "Integer Interval maps to Range",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Range{Range: &d4pb.Range{
+					Low:  &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "1"}},
+					High: &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "10"}},
+				}}}),
+			},
+		},
+		{
+			name: "Open Integer Interval maps to closed Range",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  false,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Range{Range: &d4pb.Range{
+					Low:  &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "2"}},
+					High: &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "9"}},
+				}}}),
+			},
+		},
+		{
+			name: "Open Long Interval maps to closed Range",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, int64(1)),
+				High:          newOrFatal(t, int64(10)),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Long},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Range{Range: &d4pb.Range{
+					Low:  &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "1"}},
+					High: &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "9"}},
+				}}}),
+			},
+		},
+		{
+			name: "Open Date Interval maps to closed Period",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, Date{Date: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Precision: model.MONTH}),
+				High:          newOrFatal(t, Date{Date: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), Precision: model.DAY}),
+				LowInclusive:  false,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Date},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Period{Period: &d4pb.Period{
+					Start: &d4pb.DateTime{ValueUs: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.DateTime_MONTH},
+					End:   &d4pb.DateTime{ValueUs: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC).UnixMicro(), Timezone: "UTC", Precision: d4pb.DateTime_DAY},
+				}}}),
+			},
+		},
+		{
+			name:    "Uncertainty maps to Range",
+			results: map[string]Value{"A": newOrFatal(t, Uncertainty{Low: 1, High: 23})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Range{Range: &d4pb.Range{
+					Low:  &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "1"}},
+					High: &d4pb.SimpleQuantity{Value: &d4pb.Decimal{Value: "23"}},
+				}}}),
+			},
+		},
+		{
+			name: "List maps to repeated parameters",
+			results: map[string]Value{"A": newOrFatal(t, List{
+				Value:      []Value{newOrFatal(t, 1), newOrFatal(t, nil)},
+				StaticType: &types.List{ElementType: types.Integer},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Integer{Integer: &d4pb.Integer{Value: 1}}}),
+				{Name: fhirString("A")},
+			},
+		},
+		{
+			name:    "Empty list produces no parameters",
+			results: map[string]Value{"A": newOrFatal(t, List{Value: []Value{}, StaticType: &types.List{ElementType: types.Integer}})},
+			want:    nil,
+		},
+		{
+			name: "Nested list maps to parts",
+			results: map[string]Value{"A": newOrFatal(t, List{
+				Value: []Value{
+					newOrFatal(t, List{Value: []Value{newOrFatal(t, 1), newOrFatal(t, 2)}, StaticType: &types.List{ElementType: types.Integer}}),
+					newOrFatal(t, List{Value: []Value{newOrFatal(t, 3)}, StaticType: &types.List{ElementType: types.Integer}}),
+				},
+				StaticType: &types.List{ElementType: &types.List{ElementType: types.Integer}},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				{
+					Name: fhirString("A"),
+					Part: []*r4parameterspb.Parameters_Parameter{
+						withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Integer{Integer: &d4pb.Integer{Value: 1}}}),
+						withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Integer{Integer: &d4pb.Integer{Value: 2}}}),
+					},
+				},
+				{
+					Name: fhirString("A"),
+					Part: []*r4parameterspb.Parameters_Parameter{
+						withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Integer{Integer: &d4pb.Integer{Value: 3}}}),
+					},
+				},
+			},
+		},
+		{
+			name: "Tuple maps to parts",
+			results: map[string]Value{"A": newOrFatal(t, Tuple{
+				Value:       map[string]Value{"Banana": newOrFatal(t, nil), "Apple": newOrFatal(t, "red")},
+				RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{"Apple": types.String, "Banana": types.Any}},
+			})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				{
+					Name: fhirString("A"),
+					Part: []*r4parameterspb.Parameters_Parameter{
+						withValue("Apple", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_StringValue{StringValue: fhirString("red")}}),
+						{Name: fhirString("Banana")},
+					},
+				},
+			},
+		},
+		{
+			name:    "FHIR data type is set as the value",
+			results: map[string]Value{"A": newOrFatal(t, Named{Value: &d4pb.Boolean{Value: true}, RuntimeType: &types.Named{TypeName: "FHIR.boolean"}})},
+			want: []*r4parameterspb.Parameters_Parameter{
+				withValue("A", &r4parameterspb.Parameters_Parameter_ValueX{Choice: &r4parameterspb.Parameters_Parameter_ValueX_Boolean{Boolean: &d4pb.Boolean{Value: true}}}),
+			},
+		},
+		{
+			name:    "FHIR resource is set as the resource",
+			results: map[string]Value{"A": newOrFatal(t, Named{Value: patient, RuntimeType: &types.Named{TypeName: "FHIR.Patient"}})},
+			want:    []*r4parameterspb.Parameters_Parameter{{Name: fhirString("A"), Resource: patientAny}},
+		},
+		{
+			name: "Parameters are ordered by name",
+			results: map[string]Value{
+				"B": newOrFatal(t, nil),
+				"A": newOrFatal(t, nil),
+			},
+			want: []*r4parameterspb.Parameters_Parameter{{Name: fhirString("A")}, {Name: fhirString("B")}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ToFHIRParameters(tc.results)
+			if err != nil {
+				t.Fatalf("ToFHIRParameters() returned unexpected error: %v", err)
+			}
+			want := &r4parameterspb.Parameters{Parameter: tc.want}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("ToFHIRParameters() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToFHIRParameters_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		results map[string]Value
+	}{
+		{
+			name:    "ValueSet",
+			results: map[string]Value{"A": newOrFatal(t, ValueSet{ID: "https://example.com/vs"})},
+		},
+		{
+			name: "String Interval",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, "a"),
+				High:          newOrFatal(t, "b"),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.String},
+			})},
+		},
+		{
+			name: "Open Decimal Interval",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, 1.5),
+				High:          newOrFatal(t, 2.5),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Decimal},
+			})},
+		},
+		{
+			name: "Open Quantity Interval",
+			results: map[string]Value{"A": newOrFatal(t, Interval{
+				Low:           newOrFatal(t, Quantity{Value: 1, Unit: "mg"}),
+				High:          newOrFatal(t, Quantity{Value: 5, Unit: "mg"}),
+				LowInclusive:  false,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Quantity},
+			})},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ToFHIRParameters(tc.results)
+			if !errors.Is(err, errUnsupportedType) {
+				t.Errorf("ToFHIRParameters() returned error %v, want %v", err, errUnsupportedType)
+			}
+		})
+	}
+}
+
+func fhirString(s string) *d4pb.String {
+	return &d4pb.String{Value: s}
+}
+
+func withValue(name string, vx *r4parameterspb.Parameters_Parameter_ValueX) *r4parameterspb.Parameters_Parameter {
+	return &r4parameterspb.Parameters_Parameter{Name: fhirString(name), Value: vx}
+}
+
+func ucumQuantity(value, unit, code string) *d4pb.Quantity {
+	return &d4pb.Quantity{
+		Value:  &d4pb.Decimal{Value: value},
+		Unit:   fhirString(unit),
+		System: &d4pb.Uri{Value: ucumSystem},
+		Code:   &d4pb.Code{Value: code},
+	}
+}
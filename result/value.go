@@ -56,6 +56,7 @@ type Value struct {
 // CQL DateTime returns Golang DateTime struct
 // CQL Time returns Golang Time struct
 // CQL Interval returns Golang Interval struct
+// CQL Integer uncertainty returns Golang Uncertainty struct
 // CQL List returns Golang List struct
 // CQL Tuple returns Golang Tuple struct
 // CQL Named (a type defined in the data model) returns Golang Proto struct
@@ -116,6 +117,7 @@ type customJSONMarshaler interface {
 // MarshalJSON returns the value as a JSON string.
 // Uses CQL-Serialization spec as a template:
 // https://github.com/cqframework/clinical_quality_language/wiki/CQL-Serialization
+// NewFromJSON converts the JSON back into a Value.
 func (v Value) MarshalJSON() ([]byte, error) {
 	rt, err := v.RuntimeType().MarshalJSON()
 	if err != nil {
@@ -214,6 +216,12 @@ func (v Value) Proto() (*crpb.Value, error) {
 			return nil, err
 		}
 		pbValue.Value = &crpb.Value_IntervalValue{IntervalValue: pb}
+	case Uncertainty:
+		pb, err := t.Proto()
+		if err != nil {
+			return nil, err
+		}
+		pbValue.Value = &crpb.Value_IntervalValue{IntervalValue: pb}
 	case List:
 		pb, err := t.Proto()
 		if err != nil {
@@ -277,6 +285,12 @@ func (v Value) Equal(a Value) bool {
 			return false
 		}
 		return t.Equal(vInterval)
+	case Uncertainty:
+		vUncertainty, ok := a.GolangValue().(Uncertainty)
+		if !ok {
+			return false
+		}
+		return t == vUncertainty
 	case List:
 		vList, ok := a.GolangValue().(List)
 		if !ok {
@@ -329,6 +343,8 @@ var errUnsupportedType = errors.New("unsupported type")
 // Golang DateTime struct converts to CQL DateTime
 // Golang Time struct converts to CQL Time
 // Golang Interval struct converts to CQL Interval
+// Golang Uncertainty struct converts to a CQL Integer uncertainty, or a CQL Integer if Low and High
+// are the same
 // Golang []Value converts to CQL List
 // Golang map[string]Value converts to CQL Tuple
 // Golang proto.Message (a type defined in the data model) converts to CQL Named
@@ -388,6 +404,14 @@ func New(val any) (Value, error) {
 	case Interval:
 		// RuntimeType is not set here because it is inferred at RuntimeType() is called.
 		return Value{goValue: v}, nil
+	case Uncertainty:
+		if v.Low > v.High {
+			return Value{}, fmt.Errorf("uncertainty low %v must not be greater than high %v", v.Low, v.High)
+		}
+		if v.Low == v.High {
+			return Value{runtimeType: types.Integer, goValue: v.Low}, nil
+		}
+		return Value{runtimeType: types.Integer, goValue: v}, nil
 	case List:
 		// RuntimeType is not set here because it is inferred when RuntimeType() is called.
 		return Value{goValue: v}, nil
@@ -883,6 +907,47 @@ func (i Interval) marshalJSON(t json.RawMessage) ([]byte, error) {
 	})
 }
 
+// Uncertainty is the Golang representation of an uncertain CQL Integer, the closed range of
+// possible results of an operation on imprecise inputs. For example, months between @2020 and
+// @2021 is somewhere from 1 to 23 months. Uncertainties have a runtime type of Integer, and
+// operators that accept Integers propagate them as described in
+// https://cql.hl7.org/05-languagesemantics.html#imprecision-and-uncertainty.
+//
+// Uncertainties are not part of the result proto, so Proto() returns the equivalent closed
+// Interval<Integer>.
+type Uncertainty struct {
+	Low  int32
+	High int32
+}
+
+// Proto converts Uncertainty to a closed Interval<Integer> proto.
+func (u Uncertainty) Proto() (*crpb.Interval, error) {
+	return u.interval().Proto()
+}
+
+// interval returns the closed Interval<Integer> of the possible values of u.
+func (u Uncertainty) interval() Interval {
+	return Interval{
+		Low:           Value{runtimeType: types.Integer, goValue: u.Low},
+		High:          Value{runtimeType: types.Integer, goValue: u.High},
+		LowInclusive:  true,
+		HighInclusive: true,
+		StaticType:    &types.Interval{PointType: types.Integer},
+	}
+}
+
+func (u Uncertainty) marshalJSON(t json.RawMessage) ([]byte, error) {
+	return json.Marshal(struct {
+		Type json.RawMessage `json:"@type"`
+		Low  int32           `json:"low"`
+		High int32           `json:"high"`
+	}{
+		Type: t,
+		Low:  u.Low,
+		High: u.High,
+	})
+}
+
 // List is the Golang representation of a CQL List.
 type List struct {
 	Value []Value
@@ -560,6 +560,24 @@ func TestEqual(t *testing.T) {
 			b:         newOrFatal(t, 10),
 			wantEqual: false,
 		},
+		{
+			name:      "equal Uncertainties",
+			a:         newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			b:         newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			wantEqual: true,
+		},
+		{
+			name:      "unequal Uncertainties",
+			a:         newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			b:         newOrFatal(t, Uncertainty{Low: 1, High: 24}),
+			wantEqual: false,
+		},
+		{
+			name:      "Uncertainty not equal to Integer",
+			a:         newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			b:         newOrFatal(t, 1),
+			wantEqual: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -611,6 +629,16 @@ func TestNew(t *testing.T) {
 			input: int64(1),
 			want:  Value{goValue: int64(1), runtimeType: types.Long},
 		},
+		{
+			name:  "uncertainty",
+			input: Uncertainty{Low: 1, High: 23},
+			want:  Value{goValue: Uncertainty{Low: 1, High: 23}, runtimeType: types.Integer},
+		},
+		{
+			name:  "uncertainty with equal low and high is an integer",
+			input: Uncertainty{Low: 5, High: 5},
+			want:  Value{goValue: int32(5), runtimeType: types.Integer},
+		},
 		{
 			name:  "decimal",
 			input: 1.1,
@@ -782,6 +810,11 @@ func TestNew_Error(t *testing.T) {
 			},
 			wantErr: "Time must be Year 0000, Month 01, Day 01",
 		},
+		{
+			name:    "Uncertainty low greater than high",
+			input:   Uncertainty{Low: 5, High: 4},
+			wantErr: "must not be greater than high",
+		},
 		{
 			name:    "CodeSystem missing ID",
 			input:   CodeSystem{},
@@ -1160,6 +1193,11 @@ func TestMarshalJSON(t *testing.T) {
 			}),
 			want: `{"@type":"Interval\u003cSystem.Integer\u003e","low":{"@type":"System.Integer","value":10},"high":{"@type":"System.Integer","value":20},"lowClosed":true,"highClosed":true}`,
 		},
+		{
+			name:         "Uncertainty",
+			unmarshalled: newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			want:         `{"@type":"System.Integer","low":1,"high":23}`,
+		},
 		{
 			name: "Tuple",
 			unmarshalled: newOrFatal(t, Tuple{
@@ -1253,6 +1291,11 @@ func TestRuntimeType(t *testing.T) {
 			),
 			wantRuntimeType: &types.Interval{PointType: types.Integer},
 		},
+		{
+			name:            "Uncertainty",
+			input:           newOrFatal(t, Uncertainty{Low: 1, High: 23}),
+			wantRuntimeType: types.Integer,
+		},
 	}
 
 	for _, tc := range cases {
@@ -20,6 +20,7 @@ package retriever
 import (
 	"context"
 
+	"github.com/google/cql/result"
 	r4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
 )
 
@@ -29,3 +30,42 @@ type Retriever interface {
 	// Retrieve returns all FHIR resources of type fhirResourceType for the patient.
 	Retrieve(ctx context.Context, fhirResourceType string) ([]*r4pb.ContainedResource, error)
 }
+
+// Filter restricts the resources returned by a FilteredRetriever. A nil field does not filter.
+type Filter struct {
+	Codes *CodeFilter
+	Dates *DateFilter
+}
+
+// CodeFilter restricts a retrieve to the resources whose code is in a ValueSet, for example
+// [Observation: "Glucose"].
+type CodeFilter struct {
+	// CodeProperty is the path of the code element on the resource, for example "code".
+	CodeProperty string
+	// ValueSetURL is the url of the ValueSet the code must be in.
+	ValueSetURL string
+	// ValueSetVersion is the version of the ValueSet. If empty the latest version should be used.
+	ValueSetVersion string
+}
+
+// DateFilter restricts a retrieve to the resources with a date during an interval, for example
+// [Encounter] E where E.period during "Measurement Period".
+type DateFilter struct {
+	// DateProperty is the path of the date element on the resource, for example "period".
+	DateProperty string
+	// DateRange is the Interval<Date> or Interval<DateTime> the date must be during.
+	DateRange result.Interval
+}
+
+// FilteredRetriever is an optional interface a Retriever can implement to filter resources in the
+// data source. If the Retriever implements FilteredRetriever the CQL engine calls RetrieveFiltered
+// for retrieves with a code or date filter. The CQL engine does not filter the returned resources
+// by code itself, otherwise it calls Retrieve and filters the resources using the terminology
+// provider. Date filters come from the where clause of a query, which the CQL engine still applies,
+// so a FilteredRetriever may ignore them or return extra resources.
+type FilteredRetriever interface {
+	Retriever
+	// RetrieveFiltered returns the FHIR resources of type fhirResourceType for the patient that match
+	// the filter.
+	RetrieveFiltered(ctx context.Context, fhirResourceType string, filter Filter) ([]*r4pb.ContainedResource, error)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminology
+
+import (
+	"fmt"
+)
+
+// ValueSetKey identifies a ValueSet expansion by the ValueSet URL and version.
+type ValueSetKey struct {
+	URL     string
+	Version string
+}
+
+// ExpansionProvider is a terminology provider backed by pre-expanded ValueSets held in memory, so
+// CQL can be evaluated offline without a terminology server. ValueSet membership checks and
+// expansions are answered from the registered expansions first. Anything else, including CodeSystem
+// membership checks, is forwarded to the fallback Provider.
+type ExpansionProvider struct {
+	valueSets       map[resourceKey]fhirValueSet
+	latestValueSets map[string]fhirValueSet
+	fallback        Provider
+}
+
+// NewExpansionProvider returns a terminology provider for the ValueSet expansions, a map from
+// ValueSet to the codes it contains. ValueSets that are not registered are looked up in fallback,
+// which may be nil if all needed ValueSets are registered. If a ValueSet is requested without a
+// version the latest registered version is used, based on a simple version string comparison.
+func NewExpansionProvider(expansions map[ValueSetKey][]Code, fallback Provider) *ExpansionProvider {
+	e := &ExpansionProvider{
+		valueSets:       make(map[resourceKey]fhirValueSet),
+		latestValueSets: make(map[string]fhirValueSet),
+		fallback:        fallback,
+	}
+	for k, codes := range expansions {
+		fr := fhirResource{ResourceType: valueSet, URL: k.URL, Version: k.Version, Expansion: &expansion{}}
+		for _, c := range codes {
+			fr.Expansion.Codes = append(fr.Expansion.Codes, &c)
+		}
+		vs := buildFHIRValueSet(fr)
+		e.valueSets[fr.key()] = vs
+		if latest, ok := e.latestValueSets[k.URL]; !ok || k.Version > latest.Version {
+			e.latestValueSets[k.URL] = vs
+		}
+	}
+	return e
+}
+
+func (e *ExpansionProvider) findValueSet(valueSetURL, valueSetVersion string) (fhirValueSet, bool) {
+	if valueSetVersion == "" {
+		vs, ok := e.latestValueSets[valueSetURL]
+		return vs, ok
+	}
+	vs, ok := e.valueSets[resourceKey{valueSetURL, valueSetVersion}]
+	return vs, ok
+}
+
+// AnyInValueSet returns true if any code is contained within the registered expansion of the
+// ValueSet, otherwise false. Code.Display is ignored when making this determination. If the ValueSet
+// is not registered the fallback Provider is used.
+// https://cql.hl7.org/09-b-cqlreference.html#in-valueset
+func (e *ExpansionProvider) AnyInValueSet(codes []Code, valueSetURL, valueSetVersion string) (bool, error) {
+	if e == nil {
+		return false, ErrNotInitialized
+	}
+	vs, ok := e.findValueSet(valueSetURL, valueSetVersion)
+	if !ok {
+		if e.fallback == nil {
+			return false, e.notRegisteredError(valueSetURL, valueSetVersion)
+		}
+		return e.fallback.AnyInValueSet(codes, valueSetURL, valueSetVersion)
+	}
+
+	for _, c := range codes {
+		foundCode := vs.code(c.key())
+		if foundCode != nil && c.versionMatches(foundCode.Version) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AnyInCodeSystem forwards CodeSystem membership checks to the fallback Provider, since only
+// ValueSet expansions are registered.
+// https://cql.hl7.org/09-b-cqlreference.html#in-code-system
+func (e *ExpansionProvider) AnyInCodeSystem(codes []Code, codeSystemURL, codeSystemVersion string) (bool, error) {
+	if e == nil {
+		return false, ErrNotInitialized
+	}
+	if e.fallback == nil {
+		return false, fmt.Errorf("could not find CodeSystem{%s, %s}, only ValueSet expansions are registered %w", codeSystemURL, codeSystemVersion, ErrResourceNotLoaded)
+	}
+	return e.fallback.AnyInCodeSystem(codes, codeSystemURL, codeSystemVersion)
+}
+
+// ExpandValueSet returns the registered expansion of the ValueSet. If the ValueSet is not
+// registered the fallback Provider is used.
+func (e *ExpansionProvider) ExpandValueSet(valueSetURL, valueSetVersion string) ([]*Code, error) {
+	if e == nil {
+		return nil, ErrNotInitialized
+	}
+	vs, ok := e.findValueSet(valueSetURL, valueSetVersion)
+	if !ok {
+		if e.fallback == nil {
+			return nil, e.notRegisteredError(valueSetURL, valueSetVersion)
+		}
+		return e.fallback.ExpandValueSet(valueSetURL, valueSetVersion)
+	}
+	return vs.codes(), nil
+}
+
+func (e *ExpansionProvider) notRegisteredError(valueSetURL, valueSetVersion string) error {
+	return fmt.Errorf("no expansion is registered for ValueSet{%s, %s} and there is no fallback terminology provider %w", valueSetURL, valueSetVersion, ErrResourceNotLoaded)
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminology_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/cql/terminology"
+	"github.com/google/go-cmp/cmp"
+)
+
+var testExpansions = map[terminology.ValueSetKey][]terminology.Code{
+	{URL: "https://test/expansion", Version: "1.0.0"}: {
+		{System: "system1", Code: "1"},
+		{System: "system1", Code: "2"},
+	},
+	{URL: "https://test/expansion", Version: "2.0.0"}: {
+		{System: "system1", Code: "3"},
+	},
+}
+
+func TestExpansionProvider_In(t *testing.T) {
+	cases := []struct {
+		name    string
+		URL     string
+		Version string
+		codes   []terminology.Code
+		want    bool
+	}{
+		{
+			name:    "Code in expansion",
+			URL:     "https://test/expansion",
+			Version: "1.0.0",
+			codes:   []terminology.Code{{System: "system1", Code: "2"}},
+			want:    true,
+		},
+		{
+			name:    "Code not in expansion",
+			URL:     "https://test/expansion",
+			Version: "1.0.0",
+			codes:   []terminology.Code{{System: "system1", Code: "3"}},
+			want:    false,
+		},
+		{
+			name:    "Code in other system not in expansion",
+			URL:     "https://test/expansion",
+			Version: "1.0.0",
+			codes:   []terminology.Code{{System: "system2", Code: "1"}},
+			want:    false,
+		},
+		{
+			name:  "Empty version uses latest expansion",
+			URL:   "https://test/expansion",
+			codes: []terminology.Code{{System: "system1", Code: "3"}},
+			want:  true,
+		},
+		{
+			name:    "Unregistered ValueSet uses fallback",
+			URL:     "https://test/file1",
+			Version: "1.0.0",
+			codes:   []terminology.Code{{System: "system1", Code: "1"}},
+			want:    true,
+		},
+	}
+
+	fallback, err := terminology.NewInMemoryFHIRProvider(testJSONResources)
+	if err != nil {
+		t.Fatalf("NewInMemoryFHIRProvider(%v) unexpected error: %v", testJSONResources, err)
+	}
+	ep := terminology.NewExpansionProvider(testExpansions, fallback)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ep.AnyInValueSet(tc.codes, tc.URL, tc.Version)
+			if err != nil {
+				t.Fatalf("AnyInValueSet(%v, %v, %v) unexpected error: %v", tc.codes, tc.URL, tc.Version, err)
+			}
+			if got != tc.want {
+				t.Errorf("AnyInValueSet(%v, %v, %v) = %v, want %v", tc.codes, tc.URL, tc.Version, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExpansionProvider_Expand(t *testing.T) {
+	ep := terminology.NewExpansionProvider(testExpansions, nil)
+	got, err := ep.ExpandValueSet("https://test/expansion", "1.0.0")
+	if err != nil {
+		t.Fatalf("ExpandValueSet() unexpected error: %v", err)
+	}
+	want := []*terminology.Code{{System: "system1", Code: "1"}, {System: "system1", Code: "2"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ExpandValueSet() returned diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpansionProvider_Errors(t *testing.T) {
+	ep := terminology.NewExpansionProvider(testExpansions, nil)
+	codes := []terminology.Code{{System: "system1", Code: "1"}}
+
+	if _, err := ep.AnyInValueSet(codes, "https://test/unregistered", ""); !errors.Is(err, terminology.ErrResourceNotLoaded) {
+		t.Errorf("AnyInValueSet() on unregistered ValueSet got unexpected error. got: %v, want: %v", err, terminology.ErrResourceNotLoaded)
+	}
+	if _, err := ep.AnyInValueSet(codes, "https://test/expansion", "3.0.0"); !errors.Is(err, terminology.ErrResourceNotLoaded) {
+		t.Errorf("AnyInValueSet() on unregistered version got unexpected error. got: %v, want: %v", err, terminology.ErrResourceNotLoaded)
+	}
+	if _, err := ep.ExpandValueSet("https://test/unregistered", ""); !errors.Is(err, terminology.ErrResourceNotLoaded) {
+		t.Errorf("ExpandValueSet() on unregistered ValueSet got unexpected error. got: %v, want: %v", err, terminology.ErrResourceNotLoaded)
+	}
+	if _, err := ep.AnyInCodeSystem(codes, "https://test/codesystem", ""); !errors.Is(err, terminology.ErrResourceNotLoaded) {
+		t.Errorf("AnyInCodeSystem() without fallback got unexpected error. got: %v, want: %v", err, terminology.ErrResourceNotLoaded)
+	}
+
+	var nilProvider *terminology.ExpansionProvider
+	if _, err := nilProvider.AnyInValueSet(codes, "", ""); !errors.Is(err, terminology.ErrNotInitialized) {
+		t.Errorf("AnyInValueSet() on nil provider got unexpected error. got: %v, want: %v", err, terminology.ErrNotInitialized)
+	}
+}
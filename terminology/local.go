@@ -183,9 +183,10 @@ func (l *LocalFHIRProvider) findValueSet(valueSetURL, valueSetVersion string) (f
 }
 
 // AnyInValueSet returns true if any code is contained within the specified Valueset, otherwise
-// false. Code.Display is ignored when making this determination. If the valueSetVersion is an empty
-// string, this will use the 'latest' value set version based on a simple version string comparison.
-// If the resource type of the found resource does not line up return a error
+// false. Code.Display is ignored when making this determination. If a Code has a Version it must
+// match the version of the code in the ValueSet expansion, if the expansion specifies one. If the
+// valueSetVersion is an empty string, this will use the 'latest' value set version based on a
+// simple version string comparison. If the resource type of the found resource does not line up return a error
 // https://cql.hl7.org/09-b-cqlreference.html#in-valueset
 func (l *LocalFHIRProvider) AnyInValueSet(codes []Code, valuesetURL, valuesetVersion string) (bool, error) {
 	if l == nil {
@@ -203,7 +204,7 @@ func (l *LocalFHIRProvider) AnyInValueSet(codes []Code, valuesetURL, valuesetVer
 
 	for _, c := range codes {
 		foundCode := r.code(c.key())
-		if foundCode != nil {
+		if foundCode != nil && c.versionMatches(foundCode.Version) {
 			return true, nil
 		}
 	}
@@ -212,8 +213,8 @@ func (l *LocalFHIRProvider) AnyInValueSet(codes []Code, valuesetURL, valuesetVer
 }
 
 // AnyInCodeSystem returns true if any code is contained within the specified CodeSystem, otherwise
-// false. Code.Display is ignored when making this determination. If the CodeSystemVersion is an
-// empty string, this will use the 'latest' resource version based on a simple version string
+// false. Code.Display is ignored when making this determination. If a Code has a Version it must
+// match the version of the CodeSystem. If the CodeSystemVersion is an empty string, this will use the 'latest' resource version based on a simple version string
 // comparison. If the resource type of the found resource does not line up return a error
 // https://cql.hl7.org/09-b-cqlreference.html#in-code-system
 func (l *LocalFHIRProvider) AnyInCodeSystem(codes []Code, codeSystemURL, codeSystemVersion string) (bool, error) {
@@ -232,7 +233,7 @@ func (l *LocalFHIRProvider) AnyInCodeSystem(codes []Code, codeSystemURL, codeSys
 
 	for _, c := range codes {
 		// Retrieve the code from the FHIR resource.
-		if code := r.code(c.key()); code != nil {
+		if code := r.code(c.key()); code != nil && c.versionMatches(r.Version) {
 			return true, nil
 		}
 	}
@@ -143,6 +143,21 @@ var testJSONResources = []string{`
 			]
 			}
 	`,
+	// ValueSet whose expansion pins code system versions.
+	`
+			{
+				"resourceType": "ValueSet",
+				"id": "https://test/versioned",
+				"url": "https://test/versioned",
+				"version": "1.0.0",
+				"expansion": {
+					"contains": [
+						{ "system": "system1", "code": "1", "version": "2024" },
+						{ "system": "system1", "code": "2" }
+					]
+				}
+			}
+	`,
 	// empty valueset
 	`
 			{
@@ -310,6 +325,19 @@ func testResourceInCodeSystem(t *testing.T, lf *terminology.LocalFHIRProvider) {
 			Codes:   []terminology.Code{{Code: "1", System: "https://test/file4"}},
 			wantIn:  true,
 		},
+		{
+			name:    "Code with matching version in CodeSystem https://test/file3",
+			URL:     "https://test/file3",
+			Version: "3.0.0",
+			Codes:   []terminology.Code{{Code: "snfl", System: "https://test/file3", Version: "3.0.0"}},
+			wantIn:  true,
+		},
+		{
+			name:   "Code with mismatched version not in CodeSystem https://test/file3 latest",
+			URL:    "https://test/file3",
+			Codes:  []terminology.Code{{Code: "snfl", System: "https://test/file3", Version: "1.0.0"}},
+			wantIn: false,
+		},
 		{
 			name:   "Code not in Empty CodeSystem https://test/emptyCS",
 			URL:    "https://test/emptyCS",
@@ -388,6 +416,30 @@ func testResourceInValueSet(t *testing.T, lf *terminology.LocalFHIRProvider) {
 			Codes:   []terminology.Code{{System: "system1", Code: "1"}},
 			wantIn:  true,
 		},
+		{
+			name:   "Code with matching version in ValueSet https://test/versioned",
+			URL:    "https://test/versioned",
+			Codes:  []terminology.Code{{System: "system1", Code: "1", Version: "2024"}},
+			wantIn: true,
+		},
+		{
+			name:   "Code with mismatched version not in ValueSet https://test/versioned",
+			URL:    "https://test/versioned",
+			Codes:  []terminology.Code{{System: "system1", Code: "1", Version: "2023"}},
+			wantIn: false,
+		},
+		{
+			name:   "Unversioned code in ValueSet https://test/versioned",
+			URL:    "https://test/versioned",
+			Codes:  []terminology.Code{{System: "system1", Code: "1"}},
+			wantIn: true,
+		},
+		{
+			name:   "Versioned code in ValueSet https://test/versioned without an expansion version",
+			URL:    "https://test/versioned",
+			Codes:  []terminology.Code{{System: "system1", Code: "2", Version: "2023"}},
+			wantIn: true,
+		},
 		{
 			name:   "Code not in Empty ValueSet https://test/emptyVS",
 			URL:    "https://test/emptyVS",
@@ -486,11 +538,11 @@ func TestInMemoryFHIR_InError(t *testing.T) {
 func TestLocalFHIR_NotInitialized(t *testing.T) {
 	var tp *terminology.LocalFHIRProvider
 
-	if _, err := tp.AnyInCodeSystem([]terminology.Code{{"", "", ""}}, "", ""); !errors.Is(err, terminology.ErrNotInitialized) {
+	if _, err := tp.AnyInCodeSystem([]terminology.Code{{}}, "", ""); !errors.Is(err, terminology.ErrNotInitialized) {
 		t.Errorf("In() on nil provider got unexpected error. got: %v, want: %v", err, terminology.ErrNotInitialized)
 	}
 
-	if _, err := tp.AnyInValueSet([]terminology.Code{{"", "", ""}}, "", ""); !errors.Is(err, terminology.ErrNotInitialized) {
+	if _, err := tp.AnyInValueSet([]terminology.Code{{}}, "", ""); !errors.Is(err, terminology.ErrNotInitialized) {
 		t.Errorf("In() on nil provider got unexpected error. got: %v, want: %v", err, terminology.ErrNotInitialized)
 	}
 	if _, err := tp.ExpandValueSet("", ""); !errors.Is(err, terminology.ErrNotInitialized) {
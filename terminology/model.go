@@ -20,6 +20,8 @@ type Code struct {
 	Code string `json:"code"`
 	// System is the coding system id.
 	System string `json:"system"`
+	// Version is the optional version of the coding system.
+	Version string `json:"version"`
 	// Display is an optional display string that represents this code.
 	Display string `json:"display"`
 }
@@ -29,6 +31,12 @@ func (c *Code) key() codeKey {
 	return codeKey{Value: c.Code, System: c.System}
 }
 
+// versionMatches returns true if this Code's version is compatible with the given code system
+// version. An empty version on either side matches any version.
+func (c *Code) versionMatches(version string) bool {
+	return c.Version == "" || version == "" || c.Version == version
+}
+
 // codeKey contains code value and coding system information that uniquely identifies a Code.
 type codeKey struct {
 	// Value is the code value of this code.
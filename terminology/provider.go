@@ -14,11 +14,16 @@
 
 package terminology
 
-// Provider contains standard APIs to work with healthcare terminologies.
+// Provider contains standard APIs to work with healthcare terminologies. The interpreter calls the
+// Provider for terminology membership checks such as `code in ValueSet`, `InValueSet()` and
+// retrieves filtered by a ValueSet, so implementations can be backed by a local expansion cache or
+// a remote FHIR terminology server.
 type Provider interface {
 	// In for CodeSystem and ValueSet returns true if any Code in a list is contained within the
 	// specified resource, otherwise false.
-	// Code.Display should be ignored when making this determination.
+	// Code.Display should be ignored when making this determination. If a Code has a Version it
+	// should only match a code of the same code system version. An empty resource version means the
+	// latest version known to the Provider.
 	AnyInCodeSystem(c []Code, codeSystemURL, codeSystemVersion string) (bool, error)
 	AnyInValueSet(c []Code, valueSetURL, valueSetVersion string) (bool, error)
 	// ExpandValueSet expands a ValueSet and returns all codes in that resource.
@@ -16,15 +16,20 @@ package enginetests
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/cql/interpreter"
 	"github.com/google/cql/model"
 	"github.com/google/cql/parser"
 	"github.com/google/cql/result"
+	"github.com/google/cql/retriever"
 	"github.com/google/cql/types"
 	d4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/datatypes_go_proto"
+	r4pb "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/lithammer/dedent"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -82,6 +87,257 @@ func TestExpressions(t *testing.T) {
 	}
 }
 
+func TestMessage(t *testing.T) {
+	type emitted struct {
+		Severity model.MessageSeverity
+		Code     string
+		Message  string
+		Source   result.Value
+	}
+	tests := []struct {
+		name                string
+		cql                 string
+		wantResult          result.Value
+		wantMessages        []emitted
+		wantEvalErrContains string
+	}{
+		{
+			name:       "Trace",
+			cql:        "Message(1.2, true, 'Code 100', 'Trace', 'Test Message')",
+			wantResult: newOrFatal(t, 1.2),
+			wantMessages: []emitted{
+				{Severity: model.TRACE, Code: "Code 100", Message: "Test Message", Source: newOrFatal(t, 1.2)},
+			},
+		},
+		{
+			name:       "Message",
+			cql:        "Message('a', true, 'Code 100', 'Message', 'Test Message')",
+			wantResult: newOrFatal(t, "a"),
+			wantMessages: []emitted{
+				{Severity: model.MESSAGE, Code: "Code 100", Message: "Test Message", Source: newOrFatal(t, "a")},
+			},
+		},
+		{
+			name:       "Warning",
+			cql:        "Message(4, 4 > 3, 'Code 100', 'Warning', 'Test Message')",
+			wantResult: newOrFatal(t, 4),
+			wantMessages: []emitted{
+				{Severity: model.WARNING, Code: "Code 100", Message: "Test Message", Source: newOrFatal(t, 4)},
+			},
+		},
+		{
+			name: "Source list passes through untouched",
+			cql:  "Message({1, 2}, true, 'Code 100', 'Message', 'Test Message')",
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{newOrFatal(t, 1), newOrFatal(t, 2)},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+			wantMessages: []emitted{
+				{
+					Severity: model.MESSAGE,
+					Code:     "Code 100",
+					Message:  "Test Message",
+					Source: newOrFatal(t, result.List{
+						Value:      []result.Value{newOrFatal(t, 1), newOrFatal(t, 2)},
+						StaticType: &types.List{ElementType: types.Integer},
+					}),
+				},
+			},
+		},
+		{
+			name:       "Null source",
+			cql:        "Message(null as Integer, true, 'Code 100', 'Warning', 'Test Message')",
+			wantResult: newOrFatal(t, nil),
+			wantMessages: []emitted{
+				{Severity: model.WARNING, Code: "Code 100", Message: "Test Message", Source: newOrFatal(t, nil)},
+			},
+		},
+		{
+			name:       "False condition does not emit",
+			cql:        "Message(1.2, false, 'Code 100', 'Error', 'Test Message')",
+			wantResult: newOrFatal(t, 1.2),
+		},
+		{
+			name:       "Null condition does not emit",
+			cql:        "Message(1.2, null, 'Code 100', 'Error', 'Test Message')",
+			wantResult: newOrFatal(t, 1.2),
+		},
+		{
+			name:                "Error halts evaluation",
+			cql:                 "Message(1.2, true, 'Code 100', 'Error', 'Test Message')",
+			wantMessages:        []emitted{{Severity: model.ERROR, Code: "Code 100", Message: "Test Message", Source: newOrFatal(t, 1.2)}},
+			wantEvalErrContains: "Message with severity of type `Error` was called with message: Error Code 100: Test Message",
+		},
+		{
+			name:                "Invalid severity",
+			cql:                 "Message(1.2, true, 'Code 100', 'Severe', 'Test Message')",
+			wantEvalErrContains: "invalid message severity Severe",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			var gotMessages []emitted
+			config := defaultInterpreterConfig(t, p)
+			config.MessageHandler = func(severity model.MessageSeverity, code, message string, source result.Value) {
+				gotMessages = append(gotMessages, emitted{Severity: severity, Code: code, Message: message, Source: source})
+			}
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if tc.wantEvalErrContains != "" {
+				if err == nil {
+					t.Fatalf("Eval succeeded, wanted error containing %q", tc.wantEvalErrContains)
+				}
+				if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+					t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Eval returned unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+					t.Errorf("Eval diff (-want +got)\n%v", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.wantMessages, gotMessages, protocmp.Transform()); diff != "" {
+				t.Errorf("Emitted messages diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestEval_ContextCanceled(t *testing.T) {
+	// Each query evaluates a Message with severity Message for every element of a large list, the
+	// message handler cancels the context part way through.
+	const cancelAfter = 10
+	tests := []struct {
+		name string
+		cql  string
+	}{
+		{
+			name: "Where clause",
+			cql:  "(expand { Interval[1, 100000] }) X where Message(true, true, 'tick', 'Message', 'tick') return X",
+		},
+		{
+			name: "Return clause",
+			cql:  "(expand { Interval[1, 100000] }) X return Message(X, true, 'tick', 'Message', 'tick')",
+		},
+		{
+			name: "Aggregate clause",
+			cql:  "(expand { Interval[1, 100000] }) X aggregate S starting 0: Message(S + 1, true, 'tick', 'Message', 'tick')",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			gotMessages := 0
+			config := defaultInterpreterConfig(t, p)
+			config.MessageHandler = func(model.MessageSeverity, string, string, result.Value) {
+				gotMessages++
+				if gotMessages == cancelAfter {
+					cancel()
+				}
+			}
+			_, err = interpreter.Eval(ctx, parsedLibs, config)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Eval returned error %v, want %v", err, context.Canceled)
+			}
+			if gotMessages != cancelAfter {
+				t.Errorf("Eval emitted %d messages, want evaluation to stop after %d", gotMessages, cancelAfter)
+			}
+		})
+	}
+}
+
+func TestEval_ContextDeadlineExceeded(t *testing.T) {
+	p := newFHIRParser(t)
+	parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, "Count(expand { Interval[1, 100000] })"), parser.Config{})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = interpreter.Eval(ctx, parsedLibs, defaultInterpreterConfig(t, p))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Eval returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestExpressionDefsEvaluatedOnce(t *testing.T) {
+	// Each definition emits a message with its name as the code when it is evaluated.
+	helpersLib := dedent.Dedent(`
+		library Helpers version '1'
+		define private Inner: Message(1, true, 'Inner', 'Message', 'evaluated')
+		define Shared: Message(Inner, true, 'Shared', 'Message', 'evaluated')
+		define function Double(x Integer): Message(x * 2, true, 'Double', 'Message', 'evaluated')`)
+	testLib := dedent.Dedent(`
+		library TESTLIB version '1.0.0'
+		include Helpers version '1' called helpers
+		define Local: Message(2, true, 'Local', 'Message', 'evaluated')
+		define A: Local + helpers.Shared
+		define B: Local + helpers.Shared + A
+		define TESTRESULT: helpers.Double(Local) + helpers.Double(Local) + B`)
+
+	tests := []struct {
+		name                   string
+		disableDefinitionCache bool
+		wantEvaluations        map[string]int
+	}{
+		{
+			// Definitions are evaluated once and the result is reused by every reference, functions
+			// are evaluated on every call.
+			name:            "Cached",
+			wantEvaluations: map[string]int{"Inner": 1, "Shared": 1, "Local": 1, "Double": 2},
+		},
+		{
+			// Definitions are evaluated once when their library is evaluated and again on every
+			// reference.
+			name:                   "Cache disabled",
+			disableDefinitionCache: true,
+			wantEvaluations:        map[string]int{"Inner": 7, "Shared": 6, "Local": 8, "Double": 2},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), []string{helpersLib, testLib}, parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			gotEvaluations := map[string]int{}
+			config := defaultInterpreterConfig(t, p)
+			config.DisableDefinitionCache = tc.disableDefinitionCache
+			config.MessageHandler = func(_ model.MessageSeverity, code, _ string, _ result.Value) {
+				gotEvaluations[code]++
+			}
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(newOrFatal(t, 14), getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+			if diff := cmp.Diff(tc.wantEvaluations, gotEvaluations); diff != "" {
+				t.Errorf("Evaluation counts diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestRetrieves(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -152,6 +408,205 @@ func TestRetrieves(t *testing.T) {
 	}
 }
 
+func TestRetrieves_FilteredRetriever(t *testing.T) {
+	tests := []struct {
+		name          string
+		cql           string
+		wantResult    result.Value
+		wantRetrieves []recordedRetrieve
+	}{
+		{
+			name: "Retrieve filtered by versioned valueset",
+			cql: dedent.Dedent(`
+			valueset GlucoseVS: 'https://example.com/vs/glucose' version '1.0.0'
+			define TESTRESULT: [Observation: GlucoseVS]`),
+			// The retriever is trusted to have filtered the resources, so the engine does not filter
+			// the resource it returns, even though it is not in GlucoseVS.
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Observation", "1"), RuntimeType: &types.Named{TypeName: "FHIR.Observation"}}),
+				},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}},
+			}),
+			wantRetrieves: []recordedRetrieve{
+				{
+					ResourceType: "Observation",
+					Filter: retriever.Filter{
+						Codes: &retriever.CodeFilter{
+							CodeProperty:    "code",
+							ValueSetURL:     "https://example.com/vs/glucose",
+							ValueSetVersion: "1.0.0",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Retrieve filtered by unversioned valueset on primary code path",
+			cql: dedent.Dedent(`
+			valueset EncounterVS: 'https://example.com/vs/encounter'
+			define TESTRESULT: [Encounter: EncounterVS]`),
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Encounter", "1"), RuntimeType: &types.Named{TypeName: "FHIR.Encounter"}}),
+				},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}},
+			}),
+			wantRetrieves: []recordedRetrieve{
+				{
+					ResourceType: "Encounter",
+					Filter:       retriever.Filter{Codes: &retriever.CodeFilter{CodeProperty: "type", ValueSetURL: "https://example.com/vs/encounter"}},
+				},
+			},
+		},
+		{
+			name: "Query during interval forwards the date filter",
+			cql: dedent.Dedent(`
+			include FHIRHelpers version '4.0.1' called FHIRHelpers
+			parameter MeasurementPeriod Interval<DateTime> default Interval[@2018-01-01T00:00:00.000Z, @2019-01-01T00:00:00.000Z)
+			define TESTRESULT: [Encounter] E where E.period during MeasurementPeriod`),
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Encounter", "1"), RuntimeType: &types.Named{TypeName: "FHIR.Encounter"}}),
+				},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}},
+			}),
+			wantRetrieves: []recordedRetrieve{
+				{
+					ResourceType: "Encounter",
+					Filter: retriever.Filter{
+						Dates: &retriever.DateFilter{
+							DateProperty: "period",
+							DateRange: result.Interval{
+								Low:           newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), Precision: model.MILLISECOND}),
+								High:          newOrFatal(t, result.DateTime{Date: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), Precision: model.MILLISECOND}),
+								LowInclusive:  true,
+								HighInclusive: false,
+								StaticType:    &types.Interval{PointType: types.DateTime},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Query with codes and during interval forwards both filters",
+			cql: dedent.Dedent(`
+			include FHIRHelpers version '4.0.1' called FHIRHelpers
+			valueset GlucoseVS: 'https://example.com/vs/glucose'
+			define TESTRESULT: [Observation: GlucoseVS] O where O.status = 'final' and O.effective during Interval[@2018-01-01T00:00:00.000Z, @2019-01-01T00:00:00.000Z]`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}},
+			}),
+			wantRetrieves: []recordedRetrieve{
+				{
+					ResourceType: "Observation",
+					Filter: retriever.Filter{
+						Codes: &retriever.CodeFilter{CodeProperty: "code", ValueSetURL: "https://example.com/vs/glucose"},
+						Dates: &retriever.DateFilter{
+							DateProperty: "effective",
+							DateRange: result.Interval{
+								Low:           newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), Precision: model.MILLISECOND}),
+								High:          newOrFatal(t, result.DateTime{Date: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), Precision: model.MILLISECOND}),
+								LowInclusive:  true,
+								HighInclusive: true,
+								StaticType:    &types.Interval{PointType: types.DateTime},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Query during null interval is not filtered by date",
+			cql: dedent.Dedent(`
+			include FHIRHelpers version '4.0.1' called FHIRHelpers
+			parameter MeasurementPeriod Interval<DateTime>
+			define TESTRESULT: [Encounter] E where E.period during MeasurementPeriod`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}},
+			}),
+		},
+		{
+			name: "Query during day of interval is not filtered by date",
+			cql: dedent.Dedent(`
+			include FHIRHelpers version '4.0.1' called FHIRHelpers
+			define TESTRESULT: [Encounter] E where E.period during day of Interval[@2018-11-13T, @2018-11-13T]`),
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Encounter", "1"), RuntimeType: &types.Named{TypeName: "FHIR.Encounter"}}),
+				},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Encounter"}},
+			}),
+		},
+		{
+			name: "Retrieve without codes is not filtered",
+			cql:  "define TESTRESULT: [Observation]",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Observation", "1"), RuntimeType: &types.Named{TypeName: "FHIR.Observation"}}),
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Observation", "2"), RuntimeType: &types.Named{TypeName: "FHIR.Observation"}}),
+					newOrFatal(t, result.Named{Value: RetrieveFHIRResource(t, "Observation", "3"), RuntimeType: &types.Named{TypeName: "FHIR.Observation"}}),
+				},
+				StaticType: &types.List{ElementType: &types.Named{TypeName: "FHIR.Observation"}},
+			}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testCQL := dedent.Dedent(fmt.Sprintf(`
+				library TESTLIB version '1.0.0'
+				using FHIR version '4.0.1'
+				%v`, tc.cql))
+
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, testCQL), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			r := &recordingFilteredRetriever{Retriever: BuildRetriever(t)}
+			config := defaultInterpreterConfig(t, p)
+			config.Retriever = r
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+			if diff := cmp.Diff(tc.wantRetrieves, r.retrieves); diff != "" {
+				t.Errorf("RetrieveFiltered calls diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+// recordedRetrieve is a single call to recordingFilteredRetriever.RetrieveFiltered.
+type recordedRetrieve struct {
+	ResourceType string
+	Filter       retriever.Filter
+}
+
+// recordingFilteredRetriever is a fake retriever.FilteredRetriever that records the filters it
+// receives. RetrieveFiltered returns only the first resource of the wrapped Retriever, regardless of
+// the filter.
+type recordingFilteredRetriever struct {
+	retriever.Retriever
+	retrieves []recordedRetrieve
+}
+
+func (r *recordingFilteredRetriever) RetrieveFiltered(ctx context.Context, fhirResourceType string, filter retriever.Filter) ([]*r4pb.ContainedResource, error) {
+	r.retrieves = append(r.retrieves, recordedRetrieve{ResourceType: fhirResourceType, Filter: filter})
+	resources, err := r.Retrieve(ctx, fhirResourceType)
+	if err != nil || len(resources) == 0 {
+		return resources, err
+	}
+	return resources[:1], nil
+}
+
 func TestLocalReferences(t *testing.T) {
 	tests := []struct {
 		name       string
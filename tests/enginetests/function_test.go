@@ -26,6 +26,7 @@ import (
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/lithammer/dedent"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -168,6 +169,27 @@ func TestLocalFunctions(t *testing.T) {
 			define TESTRESULT: 4.Add(4)`),
 			wantResult: newOrFatal(t, 8),
 		},
+		{
+			name: "Scalar argument is promoted to a List",
+			cql: dedent.Dedent(`
+			define function Listify(l List<Integer>): l
+			define TESTRESULT: Listify(5)`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 5)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name: "Null argument is promoted to an empty List",
+			cql: dedent.Dedent(`
+			define function Listify(l List<Integer>): l
+			define TESTRESULT: Listify(null as Integer)`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name: "List argument is passed through",
+			cql: dedent.Dedent(`
+			define function Listify(l List<Integer>): l
+			define TESTRESULT: Listify({1, 2})`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 1), newOrFatal(t, 2)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -181,7 +203,7 @@ func TestLocalFunctions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs); tc.wantModels != nil && diff != "" {
+			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs, cmpopts.IgnoreFields(model.Element{}, "Locator")); tc.wantModels != nil && diff != "" {
 				t.Errorf("Parse diff (-want +got):\n%s", diff)
 			}
 
@@ -242,7 +264,7 @@ func TestGlobalFunctions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs); tc.wantModels != nil && diff != "" {
+			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs, cmpopts.IgnoreFields(model.Element{}, "Locator")); tc.wantModels != nil && diff != "" {
 				t.Errorf("Parse Expression diff (-want +got):\n%s", diff)
 			}
 
@@ -312,7 +334,7 @@ func TestFailingFunctions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs); tc.wantModels != nil && diff != "" {
+			if diff := cmp.Diff(tc.wantModels, getTESTLIBModel(t, parsedLibs).Statements.Defs, cmpopts.IgnoreFields(model.Element{}, "Locator")); tc.wantModels != nil && diff != "" {
 				t.Errorf("Parse Expression diff (-want +got):\n%s", diff)
 			}
 
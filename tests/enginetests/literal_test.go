@@ -257,6 +257,143 @@ func TestIntervalSelector(t *testing.T) {
 	}
 }
 
+func TestIntervalSelector_Bounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "Closed interval start",
+			cql:        "start of Interval[1, 5]",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "Closed interval end",
+			cql:        "end of Interval[1, 5]",
+			wantResult: newOrFatal(t, 5),
+		},
+		{
+			name:       "Closed interval contains low",
+			cql:        "Interval[1, 5] contains 1",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Closed interval contains high",
+			cql:        "Interval[1, 5] contains 5",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Closed open interval start",
+			cql:        "start of Interval[1, 5)",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "Closed open interval end",
+			cql:        "end of Interval[1, 5)",
+			wantResult: newOrFatal(t, 4),
+		},
+		{
+			name:       "Closed open interval contains low",
+			cql:        "Interval[1, 5) contains 1",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Closed open interval does not contain high",
+			cql:        "Interval[1, 5) contains 5",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open closed interval start",
+			cql:        "start of Interval(1, 5]",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Open closed interval end",
+			cql:        "end of Interval(1, 5]",
+			wantResult: newOrFatal(t, 5),
+		},
+		{
+			name:       "Open closed interval does not contain low",
+			cql:        "Interval(1, 5] contains 1",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open closed interval contains high",
+			cql:        "Interval(1, 5] contains 5",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Open interval start",
+			cql:        "start of Interval(1, 5)",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Open interval end",
+			cql:        "end of Interval(1, 5)",
+			wantResult: newOrFatal(t, 4),
+		},
+		{
+			name:       "Open interval does not contain low",
+			cql:        "Interval(1, 5) contains 1",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open interval does not contain high",
+			cql:        "Interval(1, 5) contains 5",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Closed null high is unbounded",
+			cql:        "end of Interval[1, null]",
+			wantResult: newOrFatal(t, 2147483647),
+		},
+		{
+			name:       "Closed null high contains large value",
+			cql:        "Interval[1, null] contains 1000000",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Open null high is unknown",
+			cql:        "end of Interval[1, null)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Open null high containment is unknown",
+			cql:        "Interval[1, null) contains 1000000",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Closed null low is unbounded",
+			cql:        "start of Interval[null, 5]",
+			wantResult: newOrFatal(t, -2147483648),
+		},
+		{
+			name:       "Open null low is unknown",
+			cql:        "start of Interval(null, 5]",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestListSelector(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -431,6 +568,11 @@ func TestTupleAndInstanceSelector(t *testing.T) {
 			cql:        "Quantity{value: 4, unit: 'day' }",
 			wantResult: newOrFatal(t, result.Quantity{Value: 4, Unit: "day"}),
 		},
+		{
+			name:       "Ratio Instance",
+			cql:        "Ratio{numerator: 1 'mg', denominator: 2 'mL'}",
+			wantResult: newOrFatal(t, result.Ratio{Numerator: result.Quantity{Value: 1, Unit: "mg"}, Denominator: result.Quantity{Value: 2, Unit: "mL"}}),
+		},
 		{
 			name: "Code Instance",
 			cql:  "Code{code: 'foo', system: 'bar', version: '1.0', display: 'severed leg' }",
@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/google/cql/result"
 	"github.com/google/cql/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/lithammer/dedent"
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
@@ -231,6 +233,37 @@ func TestAvg(t *testing.T) {
 			cql:        "Avg({2.5 'g', 3.5 'g', null as Quantity})",
 			wantResult: newOrFatal(t, result.Quantity{Value: 3.0, Unit: "g"}),
 		},
+		{
+			name: "Avg({1, 2})",
+			cql:  "Avg({1, 2})",
+			wantModel: &model.Avg{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2"}, types.Integer),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 1.5),
+		},
+		{
+			name:       "Avg({1L, 2L, null})",
+			cql:        "Avg({1L, 2L, null})",
+			wantResult: newOrFatal(t, 1.5),
+		},
+		{
+			name:       "Avg with empty integer list",
+			cql:        "Avg({} as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Avg with all null integer list",
+			cql:        "Avg({null as Integer, null as Integer})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Avg({1 'g', 500 'mg'})",
+			cql:        "Avg({1 'g', 500 'mg'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 0.75, Unit: "g"}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -293,6 +326,43 @@ func TestCount(t *testing.T) {
 			cql:        "Count({null, null})",
 			wantResult: newOrFatal(t, 0),
 		},
+		{
+			name: "Count(distinct {1, 1, 2, 3, 3})",
+			cql:  "Count(distinct {1, 1, 2, 3, 3})",
+			wantModel: &model.Count{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "1", "2", "3", "3"}, types.Integer),
+					Expression: model.ResultType(types.Integer),
+				},
+				Distinct: true,
+			},
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "Count distinct with nulls interleaved with duplicates",
+			cql:        "Count(distinct {1, null, 1, 2, null, 2})",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Count distinct with mixed types",
+			cql:        "Count(distinct {1, 'a', 1, 'a', @2012-01-01, @2012-01-01})",
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "Count distinct Quantities",
+			cql:        "Count(distinct {1 'g', 1 'g', 2 'g'})",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Count distinct with all null list",
+			cql:        "Count(distinct {null, null})",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Count distinct with null input",
+			cql:        "Count(distinct (null as List<Integer>))",
+			wantResult: newOrFatal(t, 0),
+		},
 	}
 
 	for _, tc := range tests {
@@ -360,6 +430,61 @@ func TestMax(t *testing.T) {
 			cql:        "Max({@2014-01-01T01:01:00.000Z, @2014-01-01T01:03:00.000Z, @2014-01-01T01:02:00.000Z})",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.January, 01, 1, 3, 0, 0, time.UTC), Precision: model.MILLISECOND}),
 		},
+		{
+			name:       "Max Integer",
+			cql:        "Max({1, 5, null, 3})",
+			wantResult: newOrFatal(t, int32(5)),
+		},
+		{
+			name:       "Max Long",
+			cql:        "Max({1L, 12L, -5L})",
+			wantResult: newOrFatal(t, int64(12)),
+		},
+		{
+			name:       "Max Decimal",
+			cql:        "Max({1.5, 2.25, -3.0})",
+			wantResult: newOrFatal(t, 2.25),
+		},
+		{
+			name:       "Max Quantity same unit",
+			cql:        "Max({1 'g', 3 'g', 2 'g'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: "g"}),
+		},
+		{
+			name:       "Max Quantity mixed units",
+			cql:        "Max({5 'g', 100 'mg', 6000 'mg'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 6000, Unit: "mg"}),
+		},
+		{
+			name:       "Max Time",
+			cql:        "Max({@T10:00, @T12:00, @T09:00})",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 12, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Max String",
+			cql:        "Max({'a', 'c', 'b'})",
+			wantResult: newOrFatal(t, "c"),
+		},
+		{
+			name:       "Max all null Integer list",
+			cql:        "Max({null as Integer, null as Integer})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Max of mixed precision DateTimes",
+			cql:        "Max({@2014-01-01T09, @2014-01-01T10:30})",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.January, 01, 10, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Max of DateTimes that cannot be compared at their precisions is null",
+			cql:        "Max({@2014-01-01T10, @2014-01-01T09:15, @2014-01-01T10:30})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Uncertainties",
+			cql:        "Max({months between @2020 and @2022, 20})",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 20, High: 35}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -427,6 +552,61 @@ func TestMin(t *testing.T) {
 			cql:        "Min({@2014-01-01T01:01:00.000Z, @2014-01-01T01:03:00.000Z, @2014-01-01T01:02:00.000Z})",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.January, 01, 1, 1, 0, 0, time.UTC), Precision: model.MILLISECOND}),
 		},
+		{
+			name:       "Min Integer",
+			cql:        "Min({1, 5, null, 3})",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Min Long",
+			cql:        "Min({1L, 12L, -5L})",
+			wantResult: newOrFatal(t, int64(-5)),
+		},
+		{
+			name:       "Min Decimal",
+			cql:        "Min({1.5, 2.25, -3.0})",
+			wantResult: newOrFatal(t, -3.0),
+		},
+		{
+			name:       "Min Quantity same unit",
+			cql:        "Min({1 'g', 3 'g', 2 'g'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1, Unit: "g"}),
+		},
+		{
+			name:       "Min Quantity mixed units",
+			cql:        "Min({5 'g', 100 'mg', 6000 'mg'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 100, Unit: "mg"}),
+		},
+		{
+			name:       "Min Time",
+			cql:        "Min({@T10:00, @T12:00, @T09:00})",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 9, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Min String",
+			cql:        "Min({'b', 'a', 'c'})",
+			wantResult: newOrFatal(t, "a"),
+		},
+		{
+			name:       "Min all null Integer list",
+			cql:        "Min({null as Integer, null as Integer})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Min of mixed precision DateTimes",
+			cql:        "Min({@2014-01-01T10:30, @2014-01-01T09})",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.January, 01, 9, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.HOUR}),
+		},
+		{
+			name:       "Min of DateTimes that cannot be compared at their precisions is null",
+			cql:        "Min({@2014-01-01T09, @2014-01-01T10:30, @2014-01-01T09:15})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Uncertainties",
+			cql:        "Min({months between @2020 and @2022, 20})",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 12, High: 20}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -451,6 +631,43 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMinMax_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Max Quantity incompatible units",
+			cql:                 "Max({1 'g', 1 'm'})",
+			wantEvalErrContains: "incompatible units",
+		},
+		{
+			name:                "Min Quantity incompatible units",
+			cql:                 "Min({1 'g', 1 'm'})",
+			wantEvalErrContains: "incompatible units",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestSum(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -509,6 +726,26 @@ func TestSum(t *testing.T) {
 			cql:        "Sum({2.1 'g', 3.1 'g'})",
 			wantResult: newOrFatal(t, result.Quantity{Value: 5.2, Unit: "g"}),
 		},
+		{
+			name:       "Sum({1 'g', 500 'mg'})",
+			cql:        "Sum({1 'g', 500 'mg'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.5, Unit: "g"}),
+		},
+		{
+			name:       "Sum({null as Quantity, 2 'min', 30 's'})",
+			cql:        "Sum({null as Quantity, 2 'min', 30 's'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2.5, Unit: "min"}),
+		},
+		{
+			name:       "Sum with all null quantity list",
+			cql:        "Sum({null as Quantity, null as Quantity})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Uncertainties",
+			cql:        "Sum({months between @2020 and @2022, 1, null})",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 13, High: 36}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -545,6 +782,11 @@ func TestSum_Error(t *testing.T) {
 			cql:             "Sum({2.1 'cm', 3.1 'g'})",
 			wantErrContains: "Quantity values with different units",
 		},
+		{
+			name:            "Sum({1 'g', 1 'm'})",
+			cql:             "Sum({1 'g', 1 'm'})",
+			wantErrContains: "incompatible units",
+		},
 	}
 
 	for _, tc := range tests {
@@ -614,6 +856,47 @@ func TestMedian(t *testing.T) {
 			cql:        "Median({2.5, 3.5, 1.5, 4.5})",
 			wantResult: newOrFatal(t, 3.0),
 		},
+		{
+			name: "Median({3, 1, 2})",
+			cql:  "Median({3, 1, 2})",
+			wantModel: &model.Median{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"3", "1", "2"}, types.Integer),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Even length Integer list: Median({4, 1, 2, 3})",
+			cql:        "Median({4, 1, 2, 3})",
+			wantResult: newOrFatal(t, 2.5),
+		},
+		{
+			name:       "Duplicates: Median({2, 2, 5, 1})",
+			cql:        "Median({2, 2, 5, 1})",
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Median({4L, 1L, null, 2L, 3L})",
+			cql:        "Median({4L, 1L, null, 2L, 3L})",
+			wantResult: newOrFatal(t, 2.5),
+		},
+		{
+			name:       "Median(List<Integer>{})",
+			cql:        "Median(List<Integer>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Quantity list with convertible units: Median({1 'g', 500 'mg', 2 'g'})",
+			cql:        "Median({1 'g', 500 'mg', 2 'g'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.0, Unit: "g"}),
+		},
+		{
+			name:       "Quantity list with leading null: Median({null as Quantity, 2 'min', 30 's'})",
+			cql:        "Median({null as Quantity, 2 'min', 30 's'})",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.25, Unit: "min"}),
+		},
 		{
 			name:       "Median(List<Decimal>{})",
 			cql:        "Median(List<Decimal>{})",
@@ -678,12 +961,12 @@ func TestMedian_Error(t *testing.T) {
 		{
 			name:            "Median({1 'cm', 2 'g'})",
 			cql:             "Median({1 'cm', 2 'g'})",
-			wantErrContains: "Median(List<Quantity>) operand has different units which is not supported",
+			wantErrContains: "Median got Quantity values with different units",
 		},
 		{
 			name:            "Median({1 '', 2 'g'})",
 			cql:             "Median({1 '', 2 'g'})",
-			wantErrContains: "Median(List<Quantity>) operand has different units which is not supported",
+			wantErrContains: "Median got Quantity values with different units",
 		},
 	}
 
@@ -705,3 +988,681 @@ func TestMedian_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Mode({1, 2, 2, 3})",
+			cql:  "Mode({1, 2, 2, 3})",
+			wantModel: &model.Mode{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "2", "3"}, types.Integer),
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+			wantResult: newOrFatal(t, int32(2)),
+		},
+		{
+			name:       "Tie returns the smallest value: Mode({3, 1, 3, 1, 2})",
+			cql:        "Mode({3, 1, 3, 1, 2})",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "All distinct values returns the smallest value: Mode({5L, 4L, 6L})",
+			cql:        "Mode({5L, 4L, 6L})",
+			wantResult: newOrFatal(t, int64(4)),
+		},
+		{
+			name:       "Mode({1.5, 2.5, null, 2.5, null})",
+			cql:        "Mode({1.5, 2.5, null, 2.5, null})",
+			wantResult: newOrFatal(t, 2.5),
+		},
+		{
+			name:       "Mode({'b', 'c', 'a', 'c', 'b'})",
+			cql:        "Mode({'b', 'c', 'a', 'c', 'b'})",
+			wantResult: newOrFatal(t, "b"),
+		},
+		{
+			name:       "Mode({@2012, @2011, @2012})",
+			cql:        "Mode({@2012, @2011, @2012})",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2012, time.January, 01, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.YEAR}),
+		},
+		{
+			name:       "Mode(List<Integer>{})",
+			cql:        "Mode(List<Integer>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Mode({null as Integer, null})",
+			cql:        "Mode({null as Integer, null})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Mode(null as List<String>)",
+			cql:        "Mode(null as List<String>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestPopulationVariance(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "PopulationVariance({1.0, 2.0, 3.0, 4.0, 5.0})",
+			cql:  "PopulationVariance({1.0, 2.0, 3.0, 4.0, 5.0})",
+			wantModel: &model.PopulationVariance{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1.0", "2.0", "3.0", "4.0", "5.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Integer list: PopulationVariance({2, 4, 4, 4, 5, 5, 7, 9})",
+			cql:        "PopulationVariance({2, 4, 4, 4, 5, 5, 7, 9})",
+			wantResult: newOrFatal(t, 4.0),
+		},
+		{
+			name:       "PopulationVariance({1.5, null, 2.5})",
+			cql:        "PopulationVariance({1.5, null, 2.5})",
+			wantResult: newOrFatal(t, 0.25),
+		},
+		{
+			name:       "Single element: PopulationVariance({3.0})",
+			cql:        "PopulationVariance({3.0})",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "PopulationVariance(List<Decimal>{})",
+			cql:        "PopulationVariance(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "PopulationVariance({null as Decimal})",
+			cql:        "PopulationVariance({null as Decimal})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "PopulationVariance(null as List<Decimal>)",
+			cql:        "PopulationVariance(null as List<Decimal>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestVariance(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Variance({1.0, 2.0, 3.0, 4.0, 5.0})",
+			cql:  "Variance({1.0, 2.0, 3.0, 4.0, 5.0})",
+			wantModel: &model.Variance{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1.0", "2.0", "3.0", "4.0", "5.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 2.5),
+		},
+		{
+			name:       "Integer list: Variance({2, 4, 4, 4, 5, 5, 7, 9})",
+			cql:        "Variance({2, 4, 4, 4, 5, 5, 7, 9})",
+			wantResult: newOrFatal(t, 4.57142857),
+		},
+		{
+			name:       "Variance({1.5, null, 2.5})",
+			cql:        "Variance({1.5, null, 2.5})",
+			wantResult: newOrFatal(t, 0.5),
+		},
+		{
+			name:       "Single element: Variance({3.0})",
+			cql:        "Variance({3.0})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Single non-null element: Variance({null, 3})",
+			cql:        "Variance({null, 3})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Variance(List<Decimal>{})",
+			cql:        "Variance(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Variance(null as List<Decimal>)",
+			cql:        "Variance(null as List<Decimal>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestPopulationStdDev(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "PopulationStdDev({2.0, 4.0, 4.0, 4.0, 5.0, 5.0, 7.0, 9.0})",
+			cql:  "PopulationStdDev({2.0, 4.0, 4.0, 4.0, 5.0, 5.0, 7.0, 9.0})",
+			wantModel: &model.PopulationStdDev{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"2.0", "4.0", "4.0", "4.0", "5.0", "5.0", "7.0", "9.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Square root of PopulationVariance: PopulationStdDev({1, 2, 3, 4, 5})",
+			cql:        "PopulationStdDev({1, 2, 3, 4, 5})",
+			wantResult: newOrFatal(t, 1.41421356),
+		},
+		{
+			name:       "PopulationStdDev({null, 3.0})",
+			cql:        "PopulationStdDev({null, 3.0})",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "PopulationStdDev(List<Decimal>{})",
+			cql:        "PopulationStdDev(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "PopulationStdDev(null as List<Integer>)",
+			cql:        "PopulationStdDev(null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "StdDev({1.0, 2.0, 3.0, 4.0, 5.0})",
+			cql:  "StdDev({1.0, 2.0, 3.0, 4.0, 5.0})",
+			wantModel: &model.StdDev{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1.0", "2.0", "3.0", "4.0", "5.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 1.58113883),
+		},
+		{
+			name:       "Square root of Variance: StdDev({2, 4, 4, 4, 5, 5, 7, 9})",
+			cql:        "StdDev({2, 4, 4, 4, 5, 5, 7, 9})",
+			wantResult: newOrFatal(t, 2.13808994),
+		},
+		{
+			name:       "StdDev({1.0, null, 3.0})",
+			cql:        "StdDev({1.0, null, 3.0})",
+			wantResult: newOrFatal(t, 1.41421356),
+		},
+		{
+			name:       "Single element: StdDev({3.0})",
+			cql:        "StdDev({3.0})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "StdDev(List<Decimal>{})",
+			cql:        "StdDev(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "StdDev(null as List<Decimal>)",
+			cql:        "StdDev(null as List<Decimal>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestGeometricMean(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "GeometricMean({2.0, 8.0})",
+			cql:  "GeometricMean({2.0, 8.0})",
+			wantModel: &model.GeometricMean{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"2.0", "8.0"}, types.Decimal),
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 4.0),
+		},
+		{
+			name:       "Integer list: GeometricMean({4, 1, 16, null})",
+			cql:        "GeometricMean({4, 1, 16, null})",
+			wantResult: newOrFatal(t, 4.0),
+		},
+		{
+			name:       "Zero element: GeometricMean({2.0, 0.0, 8.0})",
+			cql:        "GeometricMean({2.0, 0.0, 8.0})",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "GeometricMean(List<Decimal>{})",
+			cql:        "GeometricMean(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "GeometricMean({null as Integer})",
+			cql:        "GeometricMean({null as Integer})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "GeometricMean(null as List<Decimal>)",
+			cql:        "GeometricMean(null as List<Decimal>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestGeometricMean_Error(t *testing.T) {
+	tests := []struct {
+		name            string
+		cql             string
+		wantModel       model.IExpression
+		wantErrContains string
+	}{
+		{
+			name:            "GeometricMean({2.0, -8.0})",
+			cql:             "GeometricMean({2.0, -8.0})",
+			wantErrContains: "undefined for negative values",
+		},
+		{
+			name:            "GeometricMean({0, -1})",
+			cql:             "GeometricMean({0, -1})",
+			wantErrContains: "undefined for negative values",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrContains) {
+				t.Errorf("Eval returned unexpected error: %v, want error containing %q", err, tc.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Product({1, 2, 3, 4})",
+			cql:  "Product({1, 2, 3, 4})",
+			wantModel: &model.Product{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewList([]string{"1", "2", "3", "4"}, types.Integer),
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+			wantResult: newOrFatal(t, int32(24)),
+		},
+		{
+			name:       "Product({2L, null, 5L})",
+			cql:        "Product({2L, null, 5L})",
+			wantResult: newOrFatal(t, int64(10)),
+		},
+		{
+			name:       "Product({1.5, 2.0, -2.0})",
+			cql:        "Product({1.5, 2.0, -2.0})",
+			wantResult: newOrFatal(t, -6.0),
+		},
+		{
+			name:       "List containing zero: Product({3, 0, 5})",
+			cql:        "Product({3, 0, 5})",
+			wantResult: newOrFatal(t, int32(0)),
+		},
+		{
+			name:       "Product({null as Integer, null})",
+			cql:        "Product({null as Integer, null})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Product(List<Decimal>{})",
+			cql:        "Product(List<Decimal>{})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Product(null as List<Long>)",
+			cql:        "Product(null as List<Long>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestProduct_Error(t *testing.T) {
+	tests := []struct {
+		name            string
+		cql             string
+		wantModel       model.IExpression
+		wantErrContains string
+	}{
+		{
+			name:            "Integer overflow",
+			cql:             "Product({100000, 100000})",
+			wantErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:            "Long overflow",
+			cql:             "Product({9223372036854775807L, 2L})",
+			wantErrContains: "overflowed the System.Long range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrContains) {
+				t.Errorf("Eval returned unexpected error: %v, want error containing %q", err, tc.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestAggregateError_DefineLocation(t *testing.T) {
+	cql := dedent.Dedent(`
+	library TESTLIB version '1.0.0'
+	define "Valid Sum": Sum({1, 2})
+	define "Total Weight":
+	  Sum({1 'g', 1 'm'})`)
+	wantErrContains := `define "Total Weight" at 4:1-5:21: Sum`
+
+	p := newFHIRParser(t)
+	parsedLibs, err := p.Libraries(context.Background(), []string{cql}, parser.Config{})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+	_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+	if err == nil || !strings.Contains(err.Error(), wantErrContains) {
+		t.Errorf("Eval returned unexpected error: %v, want error containing %q", err, wantErrContains)
+	}
+}
+
+func TestAggregate_TypedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cql  string
+		// wantErrAs is a pointer to the error type the evaluation error should match with errors.As.
+		wantErrAs any
+	}{
+		{
+			name:      "Sum incompatible units",
+			cql:       "Sum({1 'g', 1 'm'})",
+			wantErrAs: &result.IncompatibleUnitError{},
+		},
+		{
+			name:      "Avg incompatible units",
+			cql:       "Avg({1 'g', 1 'm'})",
+			wantErrAs: &result.IncompatibleUnitError{},
+		},
+		{
+			name:      "Median incompatible units",
+			cql:       "Median({1 'cm', 2 'g'})",
+			wantErrAs: &result.IncompatibleUnitError{},
+		},
+		{
+			name:      "Sum Integer overflow",
+			cql:       "Sum({2147483647, 1})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Sum Integer underflow",
+			cql:       "Sum({-2147483648, -1})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Sum uncertain Integer overflow of the high bound",
+			cql:       "Sum({2147483620, months between @2012 and @2014})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Sum uncertain Integer overflow of the low bound",
+			cql:       "Sum({-2147483620, -(months between @2012 and @2014)})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Sum Long overflow",
+			cql:       "Sum({9223372036854775807L, 1L})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Sum Long underflow",
+			cql:       "Sum({-9223372036854775807L, -2L})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "GeometricMean negative element",
+			cql:       "GeometricMean({1.0, -2.0})",
+			wantErrAs: &result.DomainError{},
+		},
+		{
+			name:      "Product Integer overflow",
+			cql:       "Product({100000, 100000})",
+			wantErrAs: &result.OverflowError{},
+		},
+		{
+			name:      "Product Long overflow",
+			cql:       "Product({9223372036854775807L, 2L})",
+			wantErrAs: &result.OverflowError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			var engineErr result.EngineError
+			if !errors.As(err, &engineErr) {
+				t.Fatalf("Eval returned error %v, want a result.EngineError", err)
+			}
+			if !errors.As(err, tc.wantErrAs) {
+				t.Errorf("Eval returned error %v, want an error matching %T", err, tc.wantErrAs)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"errors"
 	"math"
 	"strings"
 	"testing"
@@ -58,11 +59,6 @@ func TestAbs(t *testing.T) {
 			cql:        "Abs(2)",
 			wantResult: newOrFatal(t, 2),
 		},
-		{
-			name:       "Minimum Integer",
-			cql:        "Abs(-2147483648)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "Long",
 			cql:        "Abs(-4L)",
@@ -73,11 +69,6 @@ func TestAbs(t *testing.T) {
 			cql:        "Abs(2L)",
 			wantResult: newOrFatal(t, int64(2)),
 		},
-		{
-			name:       "Minimum Long",
-			cql:        "Abs(-9223372036854775808L)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "Decimal",
 			cql:        "Abs(-1.0)",
@@ -103,6 +94,11 @@ func TestAbs(t *testing.T) {
 			cql:        "Abs(1.0 'day')",
 			wantResult: newOrFatal(t, result.Quantity{Value: 1.0, Unit: model.DAYUNIT}),
 		},
+		{
+			name:       "Quantity preserves UCUM unit",
+			cql:        "Abs(-2.5 'mg')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2.5, Unit: "mg"}),
+		},
 		{
 			name:       "Quantity",
 			cql:        "Abs(-99999999999999999999.99999999 'day')",
@@ -113,6 +109,21 @@ func TestAbs(t *testing.T) {
 			cql:        "Abs(null as Integer)",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Null Quantity",
+			cql:        "Abs(null as Quantity)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Uncertainty",
+			cql:        "Abs(months between @2022 and @2020)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 12, High: 35}),
+		},
+		{
+			name:       "Uncertainty spanning zero",
+			cql:        "Abs((months between @2020 and @2022) - 20)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 0, High: 15}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -138,6 +149,43 @@ func TestAbs(t *testing.T) {
 	}
 }
 
+func TestAbs_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Minimum Integer",
+			cql:                 "Abs(-2147483648)",
+			wantEvalErrContains: "Abs overflowed the System.Integer range",
+		},
+		{
+			name:                "Minimum Long",
+			cql:                 "Abs(-9223372036854775808L)",
+			wantEvalErrContains: "Abs overflowed the System.Long range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestCeiling(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -161,6 +209,16 @@ func TestCeiling(t *testing.T) {
 			cql:        "Ceiling(-2.1)",
 			wantResult: newOrFatal(t, -2),
 		},
+		{
+			name:       "Negative half",
+			cql:        "Ceiling(-1.5)",
+			wantResult: newOrFatal(t, -1),
+		},
+		{
+			name:       "Positive half",
+			cql:        "Ceiling(1.5)",
+			wantResult: newOrFatal(t, 2),
+		},
 		{
 			name:       "Zero",
 			cql:        "Ceiling(0.0)",
@@ -176,31 +234,11 @@ func TestCeiling(t *testing.T) {
 			cql:        "Ceiling(-2147483648)",
 			wantResult: newOrFatal(t, -2147483648),
 		},
-		{
-			name:       "Minimum Decimal out of range",
-			cql:        "Ceiling(-99999999999999999999.99999999)",
-			wantResult: newOrFatal(t, nil),
-		},
-		{
-			name:       "Maximum Decimal out of range",
-			cql:        "Ceiling(99999999999999999999.99999999)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "Just less than min int32",
 			cql:        "Ceiling(-2147483648.5)",
 			wantResult: newOrFatal(t, math.MinInt32),
 		},
-		{
-			name:       "More than one less than min int32",
-			cql:        "Ceiling(-2147483649.5)",
-			wantResult: newOrFatal(t, nil),
-		},
-		{
-			name:       "Just more than max int32",
-			cql:        "Ceiling(2147483647.5)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "equal to min int32",
 			cql:        "Ceiling(-2147483648.0)",
@@ -241,6 +279,53 @@ func TestCeiling(t *testing.T) {
 	}
 }
 
+func TestCeiling_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Minimum Decimal out of range",
+			cql:                 "Ceiling(-99999999999999999999.99999999)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Maximum Decimal out of range",
+			cql:                 "Ceiling(99999999999999999999.99999999)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "More than one less than min int32",
+			cql:                 "Ceiling(-2147483649.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Just more than max int32",
+			cql:                 "Ceiling(2147483647.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestExp(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -362,6 +447,16 @@ func TestFloor(t *testing.T) {
 			cql:        "Floor(-2.1)",
 			wantResult: newOrFatal(t, -3),
 		},
+		{
+			name:       "Negative half",
+			cql:        "Floor(-1.5)",
+			wantResult: newOrFatal(t, -2),
+		},
+		{
+			name:       "Positive half",
+			cql:        "Floor(1.5)",
+			wantResult: newOrFatal(t, 1),
+		},
 		{
 			name:       "Zero",
 			cql:        "Floor(0.0)",
@@ -377,31 +472,11 @@ func TestFloor(t *testing.T) {
 			cql:        "Floor(-2147483648)",
 			wantResult: newOrFatal(t, -2147483648),
 		},
-		{
-			name:       "Minimum Decimal out of range",
-			cql:        "Floor(-99999999999999999999.99999999)",
-			wantResult: newOrFatal(t, nil),
-		},
-		{
-			name:       "Maximum Decimal out of range",
-			cql:        "Floor(99999999999999999999.99999999)",
-			wantResult: newOrFatal(t, nil),
-		},
-		{
-			name:       "Just less than min int32",
-			cql:        "Floor(-2147483648.5)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "Just more than max int32",
 			cql:        "Floor(2147483647.5)",
 			wantResult: newOrFatal(t, math.MaxInt32),
 		},
-		{
-			name:       "More than one more than max int32",
-			cql:        "Floor(2147483648.5)",
-			wantResult: newOrFatal(t, nil),
-		},
 		{
 			name:       "equal to min int32",
 			cql:        "Floor(-2147483648.0)",
@@ -442,6 +517,53 @@ func TestFloor(t *testing.T) {
 	}
 }
 
+func TestFloor_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Minimum Decimal out of range",
+			cql:                 "Floor(-99999999999999999999.99999999)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Maximum Decimal out of range",
+			cql:                 "Floor(99999999999999999999.99999999)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Just less than min int32",
+			cql:                 "Floor(-2147483648.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "More than one more than max int32",
+			cql:                 "Floor(2147483648.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestLn(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -475,6 +597,11 @@ func TestLn(t *testing.T) {
 			cql:        "Ln(10.0)",
 			wantResult: newOrFatal(t, 2.302585092994046),
 		},
+		{
+			name:       "e",
+			cql:        "Ln(Exp(1))",
+			wantResult: newOrFatal(t, 1.0),
+		},
 		{
 			name:       "Integer",
 			cql:        "Ln(1)",
@@ -520,6 +647,102 @@ func TestLn(t *testing.T) {
 	}
 }
 
+func TestLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Decimal",
+			cql:  "Log(100.0, 10.0)",
+			wantModel: &model.Log{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("100.0", types.Decimal),
+						model.NewLiteral("10.0", types.Decimal),
+					},
+					Expression: model.ResultType(types.Decimal),
+				},
+			},
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Base 2",
+			cql:        "Log(8.0, 2.0)",
+			wantResult: newOrFatal(t, 3.0),
+		},
+		{
+			name:       "Integers",
+			cql:        "Log(16, 2)",
+			wantResult: newOrFatal(t, 4.0),
+		},
+		{
+			name:       "Argument of one",
+			cql:        "Log(1.0, 10.0)",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "Zero argument",
+			cql:        "Log(0.0, 10.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Negative argument",
+			cql:        "Log(-1.0, 10.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Base of one",
+			cql:        "Log(10.0, 1.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Zero base",
+			cql:        "Log(10.0, 0.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Negative base",
+			cql:        "Log(10.0, -2.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null argument",
+			cql:        "Log(null, 10.0)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null base",
+			cql:        "Log(10.0, null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestPrecision(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -618,7 +841,7 @@ func TestPrecision(t *testing.T) {
 	}
 }
 
-func TestAdd(t *testing.T) {
+func TestRound(t *testing.T) {
 	tests := []struct {
 		name       string
 		cql        string
@@ -626,44 +849,186 @@ func TestAdd(t *testing.T) {
 		wantResult result.Value
 	}{
 		{
-			name:       "Integers",
-			cql:        "1 + 2",
-			wantResult: newOrFatal(t, 3),
-		},
-		{
-			name: "Longs",
-			cql:  "1L + 2",
-			wantModel: &model.Add{
-				BinaryExpression: &model.BinaryExpression{
+			name: "No precision",
+			cql:  "Round(3.5)",
+			wantModel: &model.Round{
+				NaryExpression: &model.NaryExpression{
 					Operands: []model.IExpression{
-						model.NewLiteral("1L", types.Long),
-						&model.ToLong{
-							UnaryExpression: &model.UnaryExpression{
-								Expression: model.ResultType(types.Long),
-								Operand:    model.NewLiteral("2", types.Integer),
-							},
-						},
+						model.NewLiteral("3.5", types.Decimal),
 					},
-					Expression: model.ResultType(types.Long),
+					Expression: model.ResultType(types.Decimal),
 				},
 			},
-			wantResult: newOrFatal(t, int64(3)),
+			wantResult: newOrFatal(t, 4.0),
 		},
 		{
-			name:       "Decimals",
-			cql:        "1.5 + 2",
-			wantResult: newOrFatal(t, 3.5),
+			name:       "Precision 0",
+			cql:        "Round(3.5, 0)",
+			wantResult: newOrFatal(t, 4.0),
 		},
 		{
-			name:       "Quantity",
-			cql:        "11 'day' + 9 'day'",
-			wantResult: newOrFatal(t, result.Quantity{Value: 20, Unit: model.DAYUNIT}),
+			name:       "Precision 2",
+			cql:        "Round(3.14159, 2)",
+			wantResult: newOrFatal(t, 3.14),
 		},
 		{
-			name:       "Quantity via class instances",
+			name:       "Precision rounds half up",
+			cql:        "Round(0.125, 2)",
+			wantResult: newOrFatal(t, 0.13),
+		},
+		{
+			name:       "Decimal midpoint not exactly representable as a float",
+			cql:        "Round(1.005, 2)",
+			wantResult: newOrFatal(t, 1.01),
+		},
+		{
+			name:       "Decimal midpoint stored below the midpoint as a float",
+			cql:        "Round(2.675, 2)",
+			wantResult: newOrFatal(t, 2.68),
+		},
+		{
+			name:       "Negative decimal midpoint rounds towards positive infinity",
+			cql:        "Round(-2.675, 2)",
+			wantResult: newOrFatal(t, -2.67),
+		},
+		{
+			name:       "Null precision",
+			cql:        "Round(3.5, null)",
+			wantResult: newOrFatal(t, 4.0),
+		},
+		{
+			name:       "Negative midpoint",
+			cql:        "Round(-0.5)",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "Negative",
+			cql:        "Round(-1.6)",
+			wantResult: newOrFatal(t, -2.0),
+		},
+		{
+			name:       "Integer",
+			cql:        "Round(5)",
+			wantResult: newOrFatal(t, 5.0),
+		},
+		{
+			name:       "Null",
+			cql:        "Round(null as Decimal)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestRound_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Negative precision",
+			cql:                 "Round(3.14159, -1)",
+			wantEvalErrContains: "Round precision must not be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "Integers",
+			cql:        "1 + 2",
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name: "Longs",
+			cql:  "1L + 2",
+			wantModel: &model.Add{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("1L", types.Long),
+						&model.ToLong{
+							UnaryExpression: &model.UnaryExpression{
+								Expression: model.ResultType(types.Long),
+								Operand:    model.NewLiteral("2", types.Integer),
+							},
+						},
+					},
+					Expression: model.ResultType(types.Long),
+				},
+			},
+			wantResult: newOrFatal(t, int64(3)),
+		},
+		{
+			name:       "Decimals",
+			cql:        "1.5 + 2",
+			wantResult: newOrFatal(t, 3.5),
+		},
+		{
+			name:       "Quantity",
+			cql:        "11 'day' + 9 'day'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 20, Unit: model.DAYUNIT}),
+		},
+		{
+			name:       "Quantity via class instances",
 			cql:        "Quantity{value: 11, unit: 'day'} + 9 'day'",
 			wantResult: newOrFatal(t, result.Quantity{Value: 20, Unit: model.DAYUNIT}),
 		},
+		{
+			name:       "Quantity with convertible units",
+			cql:        "1 'g' + 500 'mg'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.5, Unit: "g"}),
+		},
+		{
+			name:       "Quantity preserves UCUM unit",
+			cql:        "1 'mg' + 2 'mg'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: "mg"}),
+		},
 		// Tests for Date and Quantity
 		// TODO(b/301606416): Add more tests for DateTime + Quantity
 		{
@@ -710,6 +1075,51 @@ func TestAdd(t *testing.T) {
 			cql:        "@2014-01-01T00:00:00.000Z + 1.6 'second'",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.January, 1, 0, 0, 1, 600_000_000, time.UTC), Precision: "millisecond"}),
 		},
+		{
+			name:       "Date add days overflows into next month",
+			cql:        "@2014-01-30 + 3 days",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2014, time.February, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "Date add month clamps to end of month",
+			cql:        "@2019-01-31 + 1 month",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2019, time.February, 28, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "Date add month clamps to leap day",
+			cql:        "@2020-01-31 + 1 month",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2020, time.February, 29, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "Date add year from leap day clamps",
+			cql:        "@2020-02-29 + 1 year",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2021, time.February, 28, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "DateTime add month clamps and preserves precision",
+			cql:        "@2020-01-31T10:30 + 1 month",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2020, time.February, 29, 10, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Date month precision add months preserves precision",
+			cql:        "@2020-01 + 13 months",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2021, time.February, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
+		},
+		{
+			name:       "Time add hours",
+			cql:        "@T15:59:59.999 + 5 hours",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 20, 59, 59, 999_000_000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "Time add wraps around midnight",
+			cql:        "@T23:30 + 1 hour",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 0, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Time Null",
+			cql:        "@T10:00 + null",
+			wantResult: newOrFatal(t, nil),
+		},
 		// Tests for Nulls
 		{
 			name: "Integer Null",
@@ -771,6 +1181,11 @@ func TestAdd(t *testing.T) {
 			cql:        "@2014-01-01T00:00:00.000Z + null",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Add uncertainties",
+			cql:        "(months between @2020 and @2021) + (months between @2020 and @2020-06)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: -6, High: 28}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -817,6 +1232,21 @@ func TestAdd_EvalErrors(t *testing.T) {
 			cql:                 "@2014-01 + 1 'minute'",
 			wantEvalErrContains: "invalid unit conversion",
 		},
+		{
+			name:                "Date add non calendar duration returns error",
+			cql:                 "@2014-01-01 + 1 'cm'",
+			wantEvalErrContains: "precision must be one of",
+		},
+		{
+			name:                "Time add day returns error",
+			cql:                 "@T10:00 + 1 day",
+			wantEvalErrContains: "precision must be one of",
+		},
+		{
+			name:                "Quantities with incompatible units",
+			cql:                 "1 'g' + 1 'm'",
+			wantEvalErrContains: "incompatible units",
+		},
 	}
 
 	for _, tc := range tests {
@@ -841,6 +1271,170 @@ func TestAdd_EvalErrors(t *testing.T) {
 	}
 }
 
+func TestUncertainty_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Uncertainty plus Decimal",
+			cql:                 "(months between @2020 and @2022) + 1.5",
+			wantEvalErrContains: "cannot convert uncertain Integer [12, 35] to a float64",
+		},
+		{
+			name:                "ToDecimal",
+			cql:                 "ToDecimal(months between @2020 and @2022)",
+			wantEvalErrContains: "cannot convert uncertain Integer [12, 35] to a float64",
+		},
+		{
+			name:                "ToString",
+			cql:                 "ToString(months between @2020 and @2022)",
+			wantEvalErrContains: "cannot convert uncertain Integer [12, 35] to a int32",
+		},
+		{
+			name:                "Avg",
+			cql:                 "Avg({months between @2020 and @2022, 20})",
+			wantEvalErrContains: "cannot convert uncertain Integer [12, 35] to a float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if !errors.Is(err, result.ErrUncertain) {
+				t.Fatalf("Eval returned error %v, want a result.ErrUncertain error", err)
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
+func TestArithmetic_OverflowErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Integer Add",
+			cql:                 "2147483647 + 1",
+			wantEvalErrContains: "Add overflowed the System.Integer range",
+		},
+		{
+			name:                "Integer Subtract",
+			cql:                 "-2147483648 - 1",
+			wantEvalErrContains: "Subtract overflowed the System.Integer range",
+		},
+		{
+			name:                "Integer Multiply",
+			cql:                 "2147483647 * 2",
+			wantEvalErrContains: "Multiply overflowed the System.Integer range",
+		},
+		{
+			name:                "Integer Multiply minimum by -1",
+			cql:                 "-2147483648 * -1",
+			wantEvalErrContains: "Multiply overflowed the System.Integer range",
+		},
+		{
+			name:                "Long Add",
+			cql:                 "9223372036854775807L + 1L",
+			wantEvalErrContains: "Add overflowed the System.Long range",
+		},
+		{
+			name:                "Long Subtract",
+			cql:                 "-9223372036854775808L - 1L",
+			wantEvalErrContains: "Subtract overflowed the System.Long range",
+		},
+		{
+			name:                "Long Multiply",
+			cql:                 "4611686018427387904L * 2L",
+			wantEvalErrContains: "Multiply overflowed the System.Long range",
+		},
+		{
+			name:                "Abs",
+			cql:                 "Abs(-2147483648)",
+			wantEvalErrContains: "Abs overflowed the System.Integer range",
+		},
+		{
+			name:                "Ceiling",
+			cql:                 "Ceiling(2147483647.5)",
+			wantEvalErrContains: "Ceiling overflowed the System.Integer range",
+		},
+		{
+			name:                "Floor",
+			cql:                 "Floor(-2147483648.5)",
+			wantEvalErrContains: "Floor overflowed the System.Integer range",
+		},
+		{
+			name:                "Truncate",
+			cql:                 "Truncate(2147483648.5)",
+			wantEvalErrContains: "Truncate overflowed the System.Integer range",
+		},
+		{
+			name:                "TruncatedDivide",
+			cql:                 "-2147483648 div -1",
+			wantEvalErrContains: "TruncatedDivide overflowed the System.Integer range",
+		},
+		{
+			name:                "Add uncertainties",
+			cql:                 "(months between @2012 and @2014) + 2147483620",
+			wantEvalErrContains: "Add overflowed the System.Integer range",
+		},
+		{
+			name:                "Subtract uncertainties",
+			cql:                 "-2147483630 - (months between @2012 and @2014)",
+			wantEvalErrContains: "Subtract overflowed the System.Integer range",
+		},
+		{
+			name:                "Multiply uncertainties",
+			cql:                 "(months between @2012 and @2014) * 100000000",
+			wantEvalErrContains: "Multiply overflowed the System.Integer range",
+		},
+		{
+			name:                "Negate uncertainty with the minimum Integer as its low bound",
+			cql:                 "-(-(months between @2012 and @2014) + -2147483613)",
+			wantEvalErrContains: "Negate overflowed the System.Integer range",
+		},
+		{
+			name:                "Abs uncertainty with the minimum Integer as its low bound",
+			cql:                 "Abs(-(months between @2012 and @2014) + -2147483613)",
+			wantEvalErrContains: "Abs overflowed the System.Integer range",
+		},
+		{
+			name:                "Power",
+			cql:                 "Power(2L, 63L)",
+			wantEvalErrContains: "Power overflowed the System.Long range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if !errors.As(err, &result.OverflowError{}) {
+				t.Fatalf("Eval returned error %v, want a result.OverflowError", err)
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestSubtract(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -882,6 +1476,16 @@ func TestSubtract(t *testing.T) {
 			cql:        "10.1 'day' - 1.1 'day'",
 			wantResult: newOrFatal(t, result.Quantity{Value: 9, Unit: model.DAYUNIT}),
 		},
+		{
+			name:       "Quantity with convertible units",
+			cql:        "1 'g' - 500 'mg'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 0.5, Unit: "g"}),
+		},
+		{
+			name:       "Quantity Null",
+			cql:        "1 'mg' - null",
+			wantResult: newOrFatal(t, nil),
+		},
 		// Tests for Date and Quantity
 		// TODO(b/301606416): Add more tests for DateTime + Quantity
 		{
@@ -889,6 +1493,26 @@ func TestSubtract(t *testing.T) {
 			cql:        "@2014 - 1 'year'",
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(2013, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.YEAR}),
 		},
+		{
+			name:       "DateTime subtract month clamps to end of month",
+			cql:        "@2020-03-31T10:00 - 1 month",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2020, time.February, 29, 10, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Date subtract days underflows into previous month",
+			cql:        "@2016-06-10 - 11 days",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2016, time.May, 30, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "Time subtract wraps around midnight",
+			cql:        "@T00:30 - 1 hour",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 23, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Subtract uncertainties",
+			cql:        "(months between @2020 and @2021) - (months between @2020 and @2020-06)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: -5, High: 29}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -949,6 +1573,46 @@ func TestMultiply(t *testing.T) {
 			},
 			wantResult: newOrFatal(t, 4.0),
 		},
+		{
+			name:       "Quantity by scalar",
+			cql:        "2 'mg' * 2",
+			wantResult: newOrFatal(t, result.Quantity{Value: 4, Unit: "mg"}),
+		},
+		{
+			name:       "Scalar by Quantity",
+			cql:        "2.5 * 2 'mg'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 5, Unit: "mg"}),
+		},
+		{
+			name:       "Quantities combine units",
+			cql:        "2 'mg' * 3 'mL'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 6, Unit: "mg.mL"}),
+		},
+		{
+			name:       "Quantities with the same unit",
+			cql:        "1 'cm' * 2 'cm'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2, Unit: "cm2"}),
+		},
+		{
+			name:       "Quantities with the same unit with an exponent",
+			cql:        "2 'cm2' * 3 'cm2'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 6, Unit: "cm2.cm2"}),
+		},
+		{
+			name:       "Quantities with the same unit with a negative exponent",
+			cql:        "2 's-1' * 3 's-1'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 6, Unit: "s-1.s-1"}),
+		},
+		{
+			name:       "Quantity Null",
+			cql:        "2 'mg' * null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Multiply uncertainty by Integer",
+			cql:        "(months between @2020 and @2021) * -2",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: -46, High: 0}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1011,6 +1675,16 @@ func TestTruncate(t *testing.T) {
 			cql:        "Truncate(0.0)",
 			wantResult: newOrFatal(t, 0),
 		},
+		{
+			name:       "Just less than min int32",
+			cql:        "Truncate(-2147483648.9)",
+			wantResult: newOrFatal(t, math.MinInt32),
+		},
+		{
+			name:       "Just more than max int32",
+			cql:        "Truncate(2147483647.9)",
+			wantResult: newOrFatal(t, math.MaxInt32),
+		},
 		{
 			name:       "Null",
 			cql:        "Truncate(null as Decimal)",
@@ -1072,14 +1746,141 @@ func TestTruncatedDivide(t *testing.T) {
 			wantResult: newOrFatal(t, 2.0),
 		},
 		{
-			name:       "Quantity",
-			cql:        "10.1 day div 1.1 day",
-			wantResult: newOrFatal(t, result.Quantity{Value: 9.0, Unit: model.ONEUNIT}),
+			name:       "Quantity",
+			cql:        "10.1 day div 1.1 day",
+			wantResult: newOrFatal(t, result.Quantity{Value: 9.0, Unit: model.ONEUNIT}),
+		},
+		{
+			name:       "Divide by zero",
+			cql:        "10 div 0",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Negative dividend truncates towards zero",
+			cql:        "-7 div 2",
+			wantResult: newOrFatal(t, -3),
+		},
+		{
+			name:       "Negative divisor truncates towards zero",
+			cql:        "7 div -2",
+			wantResult: newOrFatal(t, -3),
+		},
+		{
+			name:       "Negative Longs",
+			cql:        "-7L div 2L",
+			wantResult: newOrFatal(t, int64(-3)),
+		},
+		{
+			name:       "Negative Decimals",
+			cql:        "-7.5 div 2.0",
+			wantResult: newOrFatal(t, -3.0),
+		},
+		{
+			name:       "Decimal divide by zero",
+			cql:        "5.0 div 0.0",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Long divide by zero",
+			cql:        "5L div 0L",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Quantity divide by zero",
+			cql:        "5 'g' div 0 'g'",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null left",
+			cql:        "null div 2",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null right",
+			cql:        "2 div null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Max Integer divided by -1",
+			cql:        "maximum Integer div -1",
+			wantResult: newOrFatal(t, -math.MaxInt32),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestTruncate_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "More than max int32",
+			cql:                 "Truncate(2147483648.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Less than min int32",
+			cql:                 "Truncate(-2147483649.5)",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
+func TestTruncatedDivide_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Min Integer divided by -1",
+			cql:                 "minimum Integer div -1",
+			wantEvalErrContains: "overflowed the System.Integer range",
 		},
 		{
-			name:       "Divide by zero",
-			cql:        "10 div 0",
-			wantResult: newOrFatal(t, nil),
+			name:                "Min Long divided by -1",
+			cql:                 "minimum Long div -1L",
+			wantEvalErrContains: "overflowed the System.Long range",
 		},
 	}
 
@@ -1090,16 +1891,13 @@ func TestTruncatedDivide(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Parse returned unexpected error: %v", err)
 			}
-			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
-				t.Errorf("Parse diff (-want +got):\n%s", diff)
-			}
 
-			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
-			if err != nil {
-				t.Fatalf("Eval returned unexpected error: %v", err)
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
 			}
-			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
-				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
 			}
 		})
 	}
@@ -1151,6 +1949,36 @@ func TestDivide(t *testing.T) {
 			cql:        "5.0 day / 2.0 day",
 			wantResult: newOrFatal(t, result.Quantity{Value: 2.5, Unit: model.ONEUNIT}),
 		},
+		{
+			name:       "Quantities combine units",
+			cql:        "10 'mg' / 2 'mL'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 5, Unit: "mg/mL"}),
+		},
+		{
+			name:       "Quantity with compound unit",
+			cql:        "10 'mg' / 2 'mg/mL'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 5, Unit: "mg/(mg/mL)"}),
+		},
+		{
+			name:       "Quantities with convertible units",
+			cql:        "1 'g' / 500 'mg'",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2, Unit: model.ONEUNIT}),
+		},
+		{
+			name:       "Quantity by scalar",
+			cql:        "10 'mg' / 4",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2.5, Unit: "mg"}),
+		},
+		{
+			name:       "Quantity divide by zero",
+			cql:        "10 'mg' / 0 'mL'",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Quantity Null",
+			cql:        "null / 2 'mL'",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "Divide by zero",
 			cql:        "10 / 0",
@@ -1180,6 +2008,220 @@ func TestDivide(t *testing.T) {
 	}
 }
 
+func TestDecimalPrecision(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		precision  *int
+		rounding   interpreter.RoundingMode
+		wantResult result.Value
+	}{
+		{
+			name:       "Divide at default precision",
+			cql:        "1.0 / 3.0",
+			wantResult: newOrFatal(t, 0.33333333),
+		},
+		{
+			name:       "Divide at precision 2",
+			cql:        "1.0 / 3.0",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 0.33),
+		},
+		{
+			name:       "Divide at precision 4",
+			cql:        "2.0 / 3.0",
+			precision:  intPtr(4),
+			wantResult: newOrFatal(t, 0.6667),
+		},
+		{
+			name:       "Divide truncated at precision 4",
+			cql:        "2.0 / 3.0",
+			precision:  intPtr(4),
+			rounding:   interpreter.RoundDown,
+			wantResult: newOrFatal(t, 0.6666),
+		},
+		{
+			name:       "Divide Quantity at precision 2",
+			cql:        "10.0 'mg' / 3.0 'mL'",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, result.Quantity{Value: 3.33, Unit: "mg/mL"}),
+		},
+		{
+			name:       "Modulo at precision 1",
+			cql:        "7.0 mod 2.25",
+			precision:  intPtr(1),
+			wantResult: newOrFatal(t, 0.3),
+		},
+		{
+			name:       "Modulo truncated at precision 1",
+			cql:        "5.57 mod 2.0",
+			precision:  intPtr(1),
+			rounding:   interpreter.RoundDown,
+			wantResult: newOrFatal(t, 1.5),
+		},
+		{
+			name:       "Modulo Quantity at precision 1",
+			cql:        "7.0 'm' mod 2.25 'm'",
+			precision:  intPtr(1),
+			wantResult: newOrFatal(t, result.Quantity{Value: 0.3, Unit: "m"}),
+		},
+		{
+			name:       "GeometricMean at default precision",
+			cql:        "GeometricMean({1.0, 2.0})",
+			wantResult: newOrFatal(t, 1.41421356),
+		},
+		{
+			name:       "GeometricMean at precision 2",
+			cql:        "GeometricMean({1.0, 2.0})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 1.41),
+		},
+		{
+			name:       "Median at precision 2",
+			cql:        "Median({1.25, 1.5})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 1.38),
+		},
+		{
+			name:       "Median truncated at precision 2",
+			cql:        "Median({1.25, 1.5})",
+			precision:  intPtr(2),
+			rounding:   interpreter.RoundDown,
+			wantResult: newOrFatal(t, 1.37),
+		},
+		{
+			name:       "Median Quantity at precision 2",
+			cql:        "Median({1.25 'g', 1.5 'g'})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.38, Unit: "g"}),
+		},
+		{
+			name:       "Avg at default precision",
+			cql:        "Avg({1.0, 2.0, 2.0})",
+			wantResult: newOrFatal(t, 1.66666667),
+		},
+		{
+			name:       "Avg at precision 2",
+			cql:        "Avg({1.0, 2.0, 2.0})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 1.67),
+		},
+		{
+			name:       "Avg half up at precision 1",
+			cql:        "Avg({0.1, 0.4})",
+			precision:  intPtr(1),
+			wantResult: newOrFatal(t, 0.3),
+		},
+		{
+			name:       "Avg half even at precision 1",
+			cql:        "Avg({0.1, 0.4})",
+			precision:  intPtr(1),
+			rounding:   interpreter.RoundHalfEven,
+			wantResult: newOrFatal(t, 0.2),
+		},
+		{
+			name:       "StdDev at precision 2",
+			cql:        "StdDev({1.0, 2.0, 3.0, 4.0, 5.0})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 1.58),
+		},
+		{
+			name:       "StdDev at precision 4",
+			cql:        "StdDev({1.0, 2.0, 3.0, 4.0, 5.0})",
+			precision:  intPtr(4),
+			wantResult: newOrFatal(t, 1.5811),
+		},
+		{
+			name:       "Variance at precision 2",
+			cql:        "Variance({2, 4, 4, 4, 5, 5, 7, 9})",
+			precision:  intPtr(2),
+			wantResult: newOrFatal(t, 4.57),
+		},
+		{
+			name:       "PopulationStdDev at precision 3",
+			cql:        "PopulationStdDev({1, 2, 3, 4, 5})",
+			precision:  intPtr(3),
+			wantResult: newOrFatal(t, 1.414),
+		},
+		{
+			name:       "Round half up",
+			cql:        "Round(2.5)",
+			wantResult: newOrFatal(t, 3.0),
+		},
+		{
+			name:       "Round half even",
+			cql:        "Round(2.5)",
+			rounding:   interpreter.RoundHalfEven,
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Divide at precision 0",
+			cql:        "2.0 / 3.0",
+			precision:  intPtr(0),
+			wantResult: newOrFatal(t, 1.0),
+		},
+		{
+			name:       "Round half even on decimal midpoint",
+			cql:        "Round(2.675, 2)",
+			rounding:   interpreter.RoundHalfEven,
+			wantResult: newOrFatal(t, 2.68),
+		},
+		{
+			name:       "Round half even on decimal midpoint rounds to even",
+			cql:        "Round(2.665, 2)",
+			rounding:   interpreter.RoundHalfEven,
+			wantResult: newOrFatal(t, 2.66),
+		},
+		{
+			name:       "Round down on decimal value stored below it as a float",
+			cql:        "Round(4.35, 2)",
+			rounding:   interpreter.RoundDown,
+			wantResult: newOrFatal(t, 4.35),
+		},
+		{
+			name:       "Round down",
+			cql:        "Round(2.57, 1)",
+			rounding:   interpreter.RoundDown,
+			wantResult: newOrFatal(t, 2.5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			config := defaultInterpreterConfig(t, p)
+			config.DecimalPrecision = tc.precision
+			config.DecimalRounding = tc.rounding
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestDecimalPrecision_Error(t *testing.T) {
+	p := newFHIRParser(t)
+	parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, "1.0 / 3.0"), parser.Config{})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+	config := defaultInterpreterConfig(t, p)
+	config.DecimalPrecision = intPtr(-1)
+	_, err = interpreter.Eval(context.Background(), parsedLibs, config)
+	if err == nil || !strings.Contains(err.Error(), "DecimalPrecision must not be negative") {
+		t.Errorf("Eval() with a negative DecimalPrecision returned unexpected error, got: %v, want: DecimalPrecision must not be negative", err)
+	}
+}
+
 func TestMod(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1219,13 +2261,63 @@ func TestMod(t *testing.T) {
 		{
 			name:       "Decimals",
 			cql:        "10.1111 mod 2.1111",
-			wantResult: newOrFatal(t, 1.6667000000000005),
+			wantResult: newOrFatal(t, 1.6667),
 		},
 		{
 			name:       "Another Decimals",
 			cql:        "2.1111 mod 10.1111",
 			wantResult: newOrFatal(t, 2.1111),
 		},
+		{
+			name:       "Decimal with Integer divisor",
+			cql:        "5.5 mod 2",
+			wantResult: newOrFatal(t, 1.5),
+		},
+		{
+			name:       "Negative Decimal dividend",
+			cql:        "-5.5 mod 2",
+			wantResult: newOrFatal(t, -1.5),
+		},
+		{
+			name:       "Negative dividend",
+			cql:        "-5 mod 3",
+			wantResult: newOrFatal(t, -2),
+		},
+		{
+			name:       "Negative divisor",
+			cql:        "5 mod -3",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Negative Long dividend",
+			cql:        "-5L mod 3L",
+			wantResult: newOrFatal(t, int64(-2)),
+		},
+		{
+			name:       "Integer mod zero",
+			cql:        "5 mod 0",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Long mod zero",
+			cql:        "5L mod 0L",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Decimal mod zero",
+			cql:        "5.5 mod 0.0",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null dividend",
+			cql:        "null mod 2",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null divisor",
+			cql:        "5 mod null",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "Quantity",
 			cql:        "10 'm' mod 2 'm'",
@@ -1321,6 +2413,51 @@ func TestPower(t *testing.T) {
 			cql:        "Power(2, 2)",
 			wantResult: newOrFatal(t, 4),
 		},
+		{
+			name:       "Max Integer power",
+			cql:        "2 ^ 30",
+			wantResult: newOrFatal(t, 1073741824),
+		},
+		{
+			name:       "Negative base odd exponent",
+			cql:        "(-2) ^ 31",
+			wantResult: newOrFatal(t, math.MinInt32),
+		},
+		{
+			name:       "Max Long power",
+			cql:        "2L ^ 62L",
+			wantResult: newOrFatal(t, int64(4611686018427387904)),
+		},
+		{
+			name:       "Fractional exponent",
+			cql:        "4.0 ^ 0.5",
+			wantResult: newOrFatal(t, 2.0),
+		},
+		{
+			name:       "Negative base fractional exponent",
+			cql:        "(-8.0) ^ 0.5",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Decimal underflow returns zero",
+			cql:        "0.5 ^ 2000.0",
+			wantResult: newOrFatal(t, 0.0),
+		},
+		{
+			name:       "Zero to negative Integer exponent",
+			cql:        "0 ^ -1",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Zero to negative Decimal exponent",
+			cql:        "0.0 ^ -1.0",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null exponent",
+			cql:        "2 ^ null",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1345,6 +2482,68 @@ func TestPower(t *testing.T) {
 	}
 }
 
+func TestExpPower_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Exp out of Decimal range",
+			cql:                 "Exp(1000)",
+			wantEvalErrContains: "Exp overflowed the System.Decimal range",
+		},
+		{
+			name:                "Integer overflow",
+			cql:                 "2 ^ 31",
+			wantEvalErrContains: "Power overflowed the System.Integer range",
+		},
+		{
+			name:                "Integer overflow with large exponent",
+			cql:                 "2 ^ 2147483647",
+			wantEvalErrContains: "overflowed the System.Integer range",
+		},
+		{
+			name:                "Long overflow",
+			cql:                 "2L ^ 63L",
+			wantEvalErrContains: "Power overflowed the System.Long range",
+		},
+		{
+			name:                "Decimal out of range",
+			cql:                 "10.0 ^ 400.0",
+			wantEvalErrContains: "Power overflowed the System.Decimal range",
+		},
+		{
+			name:                "Infinite Power out of Decimal range",
+			cql:                 "Power(0.5, -2000.0)",
+			wantEvalErrContains: "Power overflowed the System.Decimal range",
+		},
+		{
+			name:                "Infinite Decimal Multiply",
+			cql:                 "1" + strings.Repeat("0", 200) + ".0 * 1" + strings.Repeat("0", 200) + ".0",
+			wantEvalErrContains: "Multiply overflowed the System.Decimal range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
 func TestMaximum(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1361,31 +2560,37 @@ func TestMaximum(t *testing.T) {
 		{
 			name:       "maximum Long",
 			cql:        "maximum Long",
+			wantModel:  &model.MaxValue{ValueType: types.Long, Expression: model.ResultType(types.Long)},
 			wantResult: newOrFatal(t, int64(9223372036854775807)),
 		},
 		{
 			name:       "maximum Decimal",
 			cql:        "maximum Decimal",
+			wantModel:  &model.MaxValue{ValueType: types.Decimal, Expression: model.ResultType(types.Decimal)},
 			wantResult: newOrFatal(t, float64(99999999999999999999.99999999)),
 		},
 		{
 			name:       "maximum Date",
 			cql:        "maximum Date",
+			wantModel:  &model.MaxValue{ValueType: types.Date, Expression: model.ResultType(types.Date)},
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(9999, 12, 31, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
 		},
 		{
 			name:       "maximum DateTime",
 			cql:        "maximum DateTime",
-			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(9999, 12, 31, 23, 59, 59, 999, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+			wantModel:  &model.MaxValue{ValueType: types.DateTime, Expression: model.ResultType(types.DateTime)},
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(9999, 12, 31, 23, 59, 59, 999000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
 		},
 		{
 			name:       "maximum Time",
 			cql:        "maximum Time",
+			wantModel:  &model.MaxValue{ValueType: types.Time, Expression: model.ResultType(types.Time)},
 			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 23, 59, 59, 999000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
 		},
 		{
 			name:       "maximum Quantity",
 			cql:        "maximum Quantity",
+			wantModel:  &model.MaxValue{ValueType: types.Quantity, Expression: model.ResultType(types.Quantity)},
 			wantResult: newOrFatal(t, result.Quantity{Value: float64(99999999999999999999.99999999), Unit: "1"}),
 		},
 	}
@@ -1428,31 +2633,37 @@ func TestMinimum(t *testing.T) {
 		{
 			name:       "minimum Long",
 			cql:        "minimum Long",
+			wantModel:  &model.MinValue{ValueType: types.Long, Expression: model.ResultType(types.Long)},
 			wantResult: newOrFatal(t, int64(-9223372036854775808)),
 		},
 		{
 			name:       "minimum Decimal",
 			cql:        "minimum Decimal",
+			wantModel:  &model.MinValue{ValueType: types.Decimal, Expression: model.ResultType(types.Decimal)},
 			wantResult: newOrFatal(t, float64(-99999999999999999999.99999999)),
 		},
 		{
 			name:       "minimum Date",
 			cql:        "minimum Date",
+			wantModel:  &model.MinValue{ValueType: types.Date, Expression: model.ResultType(types.Date)},
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(1, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
 		},
 		{
 			name:       "minimum DateTime",
 			cql:        "minimum DateTime",
+			wantModel:  &model.MinValue{ValueType: types.DateTime, Expression: model.ResultType(types.DateTime)},
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(1, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
 		},
 		{
 			name:       "minimum Time",
 			cql:        "minimum Time",
+			wantModel:  &model.MinValue{ValueType: types.Time, Expression: model.ResultType(types.Time)},
 			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
 		},
 		{
 			name:       "minimum Quantity",
 			cql:        "minimum Quantity",
+			wantModel:  &model.MinValue{ValueType: types.Quantity, Expression: model.ResultType(types.Quantity)},
 			wantResult: newOrFatal(t, result.Quantity{Value: float64(-99999999999999999999.99999999), Unit: "1"}),
 		},
 	}
@@ -1512,6 +2723,11 @@ func TestPredecessor(t *testing.T) {
 			cql:        "predecessor of @2024-01-02",
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
 		},
+		{
+			name:       "Date month precision",
+			cql:        "predecessor of @2024-01",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2023, 12, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
+		},
 		{
 			name:       "predecessor of @2024-01-01T00:00:00.001Z",
 			cql:        "predecessor of @2024-01-01T00:00:00.001Z",
@@ -1594,6 +2810,11 @@ func TestSuccessor(t *testing.T) {
 			cql:        "successor of @2024-01-01T00:00:00.999Z",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), Precision: model.MILLISECOND}),
 		},
+		{
+			name:       "DateTime minute precision rolls over year",
+			cql:        "successor of @2024-12-31T23:59Z",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Precision: model.MINUTE}),
+		},
 		{
 			name:       "successor of @T11:59:59.999",
 			cql:        "successor of @T11:59:59.999",
@@ -1641,6 +2862,51 @@ func TestSuccessorPredecessor_EvalErrors(t *testing.T) {
 			wantEvalErrContains: "tried to compute successor for value that is already a max",
 		},
 		// Date tests
+		{
+			name:                "predecessor of min Integer",
+			cql:                 "predecessor of minimum Integer",
+			wantEvalErrContains: "tried to compute predecessor for value that is already a min",
+		},
+		{
+			name:                "successor of max Long",
+			cql:                 "successor of maximum Long",
+			wantEvalErrContains: "tried to compute successor for value that is already a max",
+		},
+		{
+			name:                "predecessor of min Long",
+			cql:                 "predecessor of minimum Long",
+			wantEvalErrContains: "tried to compute predecessor for value that is already a min",
+		},
+		{
+			name:                "successor of max Decimal",
+			cql:                 "successor of maximum Decimal",
+			wantEvalErrContains: "tried to compute successor for value that is already a max",
+		},
+		{
+			name:                "predecessor of min Decimal",
+			cql:                 "predecessor of minimum Decimal",
+			wantEvalErrContains: "tried to compute predecessor for value that is already a min",
+		},
+		{
+			name:                "successor of max Quantity",
+			cql:                 "successor of maximum Quantity",
+			wantEvalErrContains: "tried to compute successor for value that is already a max",
+		},
+		{
+			name:                "predecessor of min Quantity",
+			cql:                 "predecessor of minimum Quantity",
+			wantEvalErrContains: "tried to compute predecessor for value that is already a min",
+		},
+		{
+			name:                "successor of max Quantity with unit",
+			cql:                 "successor of 99999999999999999999.99999999 'mg'",
+			wantEvalErrContains: "tried to compute successor for value that is already a max",
+		},
+		{
+			name:                "predecessor of min Quantity with unit",
+			cql:                 "predecessor of -99999999999999999999.99999999 'mg'",
+			wantEvalErrContains: "tried to compute predecessor for value that is already a min",
+		},
 		{
 			name:                "successor of maximum Date",
 			cql:                 "successor of maximum Date",
@@ -1775,6 +3041,11 @@ func TestNegate(t *testing.T) {
 			cql:        "-1.0 'day'",
 			wantResult: newOrFatal(t, result.Quantity{Value: -1.0, Unit: model.DAYUNIT}),
 		},
+		{
+			name:       "Quantity preserves UCUM unit",
+			cql:        "-(2 'mg/mL')",
+			wantResult: newOrFatal(t, result.Quantity{Value: -2, Unit: "mg/mL"}),
+		},
 		{
 			name:       "Null",
 			cql:        "-(null as Integer)",
@@ -1800,6 +3071,11 @@ func TestNegate(t *testing.T) {
 			cql:        "Negate(minimum Quantity)",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Uncertainty",
+			cql:        "-(months between @2020 and @2022)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: -35, High: -12}),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1824,3 +3100,7 @@ func TestNegate(t *testing.T) {
 		})
 	}
 }
+
+func intPtr(i int) *int {
+	return &i
+}
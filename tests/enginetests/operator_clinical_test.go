@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/google/cql/model"
 	"github.com/google/cql/parser"
 	"github.com/google/cql/result"
+	"github.com/google/cql/terminology"
 	"github.com/google/cql/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/lithammer/dedent"
@@ -132,6 +134,126 @@ func TestCalculateAgeAt(t *testing.T) {
 			cql:        "CalculateAgeInDaysAt(@2023-06-01, @2023-06-15)",
 			wantResult: newOrFatal(t, 14),
 		},
+		{
+			name:       "Years precision counts whole years across a leap day",
+			cql:        "CalculateAgeInYearsAt(@2000-02-29, @2024-02-28)",
+			wantResult: newOrFatal(t, 23),
+		},
+		{
+			name:       "Hours precision DateTimes",
+			cql:        "CalculateAgeInHoursAt(@2023-06-14T10:30, @2023-06-15T10:29)",
+			wantResult: newOrFatal(t, 23),
+		},
+		{
+			name:       "As of date before birth date is negative",
+			cql:        "CalculateAgeInYearsAt(@2023-06-15, @2000-06-14)",
+			wantResult: newOrFatal(t, -23),
+		},
+		{
+			name:       "As of date one day before birth date",
+			cql:        "CalculateAgeInDaysAt(@2023-06-15, @2023-06-14)",
+			wantResult: newOrFatal(t, -1),
+		},
+		{
+			name:       "Year precision birth date in years",
+			cql:        "CalculateAgeInYearsAt(@2000, @2023-06-14)",
+			wantResult: newOrFatal(t, 23),
+		},
+		{
+			name:       "Year precision birth date in months is uncertain",
+			cql:        "CalculateAgeInMonthsAt(@2000, @2023-06-14)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 269, High: 281}),
+		},
+		{
+			name:       "Date birth date in hours is uncertain",
+			cql:        "CalculateAgeInHoursAt(@2023-06-14, @2023-06-15T10:00:00.000)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 10, High: 34}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestCalculateAge(t *testing.T) {
+	// Ages are calculated as of the evaluation timestamp, which is 2024-01-01T00:00:00.000+04:00.
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Years precision Date",
+			cql:  "CalculateAgeInYears(@2000-01-02)",
+			wantModel: &model.CalculateAge{
+				Precision: model.YEAR,
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operand:    model.NewLiteral("@2000-01-02", types.Date),
+				},
+			},
+			wantResult: newOrFatal(t, 23),
+		},
+		{
+			name:       "Years precision on birthday",
+			cql:        "CalculateAgeInYears(@2000-01-01)",
+			wantResult: newOrFatal(t, 24),
+		},
+		{
+			name:       "Months precision Date",
+			cql:        "CalculateAgeInMonths(@2023-06-15)",
+			wantResult: newOrFatal(t, 6),
+		},
+		{
+			name:       "Days precision DateTime",
+			cql:        "CalculateAgeInDays(@2023-12-01T00:00:00.000+04:00)",
+			wantResult: newOrFatal(t, 31),
+		},
+		{
+			name:       "Hours precision DateTime",
+			cql:        "CalculateAgeInHours(@2023-12-31T10:00:00.000+04:00)",
+			wantResult: newOrFatal(t, 14),
+		},
+		{
+			name:       "Birth date after evaluation timestamp",
+			cql:        "CalculateAgeInDays(@2024-01-03)",
+			wantResult: newOrFatal(t, -2),
+		},
+		{
+			name:       "Year precision birth date in months is uncertain",
+			cql:        "CalculateAgeInMonths(@2000)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 276, High: 288}),
+		},
+		{
+			name:       "Null",
+			cql:        "CalculateAgeInYears(null as Date)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			// The test patient's birthday is 1950-01-01.
+			name:       "AgeInYears of the patient",
+			cql:        "AgeInYears()",
+			wantResult: newOrFatal(t, 74),
+		},
 	}
 
 	for _, tc := range tests {
@@ -370,3 +492,396 @@ func TestInValueSetAndCodeSystem(t *testing.T) {
 		})
 	}
 }
+
+func TestAnyInValueSetAndCodeSystem(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "AnyInValueSet with a member",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code ExistsCode: 'gluc' from CS
+			code NonexistantCode: 'NotInValueSet' from CS
+			define TESTRESULT: AnyInValueSet({ NonexistantCode, ExistsCode }, VS)`),
+			wantModel: &model.AnyInValueSet{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.CodeRef{Name: "NonexistantCode", Expression: model.ResultType(types.Code)},
+								&model.CodeRef{Name: "ExistsCode", Expression: model.ResultType(types.Code)},
+							},
+							Expression: model.ResultType(&types.List{ElementType: types.Code}),
+						},
+						&model.ValuesetRef{Name: "VS", Expression: model.ResultType(types.ValueSet)},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "AnyInValueSet with no members",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code NonexistantCode: 'NotInValueSet' from CS
+			code NonexistantCode2: 'NotInValueSet2' from CS
+			define TESTRESULT: AnyInValueSet({ NonexistantCode, NonexistantCode2 }, VS)`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "AnyInValueSet with a member and null codes",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code ExistsCode: 'gluc' from CS
+			define TESTRESULT: AnyInValueSet({ null as Code, ExistsCode }, VS)`),
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "AnyInValueSet with a Concept member",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code ExistsCode: 'gluc' from CS
+			code NonexistantCode: 'NotInValueSet' from CS
+			concept ConWithValidCode: { ExistsCode }
+			concept ConNoValidCode: { NonexistantCode }
+			define TESTRESULT: AnyInValueSet({ ConNoValidCode, ConWithValidCode }, VS)`),
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "AnyInValueSet with an empty list",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			define TESTRESULT: AnyInValueSet(List<Code>{}, VS)`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "AnyInValueSet with a null list",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			define TESTRESULT: AnyInValueSet(null as List<Code>, VS)`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "AnyInCodeSystem with a member",
+			cql: dedent.Dedent(`
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code ExistsCode: 'snfl' from CS
+			code NonexistantCode: 'NotInCodeSystem' from CS
+			define TESTRESULT: AnyInCodeSystem({ NonexistantCode, ExistsCode }, CS)`),
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "AnyInCodeSystem with no members",
+			cql: dedent.Dedent(`
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			code NonexistantCode: 'NotInCodeSystem' from CS
+			code NonexistantCode2: 'NotInCodeSystem2' from CS
+			define TESTRESULT: AnyInCodeSystem({ NonexistantCode, NonexistantCode2 }, CS)`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "AnyInCodeSystem with an empty list",
+			cql: dedent.Dedent(`
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			define TESTRESULT: AnyInCodeSystem(List<Code>{}, CS)`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "AnyInCodeSystem with a null list",
+			cql: dedent.Dedent(`
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			define TESTRESULT: AnyInCodeSystem(null as List<Code>, CS)`),
+			wantResult: newOrFatal(t, false),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testCQL := dedent.Dedent(fmt.Sprintf(`
+				library TESTLIB version '1.0.0'
+				using FHIR version '4.0.1'
+				%v`, tc.cql))
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, testCQL), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestInValueSetAndCodeSystem_TerminologyProviderQueries(t *testing.T) {
+	tests := []struct {
+		name           string
+		cql            string
+		strictVersions bool
+		wantResult     result.Value
+		wantQueries    []terminologyQuery
+	}{
+		{
+			name: "Code In ValueSet",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			define TESTRESULT: Code { code: 'gluc', system: 'https://example.com/cs/diagnosis', version: '2.0.0' } in VS`),
+			wantResult: newOrFatal(t, true),
+			wantQueries: []terminologyQuery{
+				{
+					Method:  "AnyInValueSet",
+					Codes:   []terminology.Code{{Code: "gluc", System: "https://example.com/cs/diagnosis"}},
+					URL:     "https://example.com/vs/glucose",
+					Version: "1.0.0",
+				},
+			},
+		},
+		{
+			name: "Code In ValueSet with strict code system versions",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose' version '1.0.0'
+			define TESTRESULT: Code { code: 'gluc', system: 'https://example.com/cs/diagnosis', version: '2.0.0' } in VS`),
+			strictVersions: true,
+			wantResult:     newOrFatal(t, true),
+			wantQueries: []terminologyQuery{
+				{
+					Method:  "AnyInValueSet",
+					Codes:   []terminology.Code{{Code: "gluc", System: "https://example.com/cs/diagnosis", Version: "2.0.0"}},
+					URL:     "https://example.com/vs/glucose",
+					Version: "1.0.0",
+				},
+			},
+		},
+		{
+			name: "List<Code> In unversioned ValueSet is a single query",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose'
+			define TESTRESULT: { Code { code: 'other', system: 'a' }, Code { code: 'gluc', system: 'b' } } in VS`),
+			wantResult: newOrFatal(t, true),
+			wantQueries: []terminologyQuery{
+				{
+					Method: "AnyInValueSet",
+					Codes:  []terminology.Code{{Code: "other", System: "a"}, {Code: "gluc", System: "b"}},
+					URL:    "https://example.com/vs/glucose",
+				},
+			},
+		},
+		{
+			name: "Concept not In ValueSet",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose'
+			define TESTRESULT: Concept { codes: { Code { code: 'other', system: 'a' } } } in VS`),
+			wantResult: newOrFatal(t, false),
+			wantQueries: []terminologyQuery{
+				{
+					Method: "AnyInValueSet",
+					Codes:  []terminology.Code{{Code: "other", System: "a"}},
+					URL:    "https://example.com/vs/glucose",
+				},
+			},
+		},
+		{
+			name: "Null Code In ValueSet does not query the provider",
+			cql: dedent.Dedent(`
+			valueset VS: 'https://example.com/vs/glucose'
+			define TESTRESULT: null as Code in VS`),
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "Code In CodeSystem",
+			cql: dedent.Dedent(`
+			codesystem CS: 'https://example.com/cs/diagnosis' version '1.0.0'
+			define TESTRESULT: Code { code: 'gluc', system: 'https://example.com/cs/diagnosis' } in CS`),
+			wantResult: newOrFatal(t, true),
+			wantQueries: []terminologyQuery{
+				{
+					Method:  "AnyInCodeSystem",
+					Codes:   []terminology.Code{{Code: "gluc", System: "https://example.com/cs/diagnosis"}},
+					URL:     "https://example.com/cs/diagnosis",
+					Version: "1.0.0",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testCQL := dedent.Dedent(fmt.Sprintf(`
+				library TESTLIB version '1.0.0'
+				using FHIR version '4.0.1'
+				%v`, tc.cql))
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, testCQL), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			tp := &recordingTerminologyProvider{memberCodes: map[string]bool{"gluc": true}}
+			config := defaultInterpreterConfig(t, p)
+			config.Terminology = tp
+			config.StrictCodeSystemVersions = tc.strictVersions
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+			if diff := cmp.Diff(tc.wantQueries, tp.queries); diff != "" {
+				t.Errorf("Terminology queries diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestInValueSet_CodeSystemVersions(t *testing.T) {
+	vs := `{
+		"resourceType": "ValueSet",
+		"url": "https://example.com/vs/versioned",
+		"version": "1.0.0",
+		"expansion": {
+			"contains": [
+				{ "system": "https://example.com/cs/diagnosis", "code": "gluc", "version": "2024" }
+			]
+		}
+	}`
+	tests := []struct {
+		name           string
+		codeVersion    string
+		strictVersions bool
+		want           bool
+	}{
+		{
+			name:           "Matching version strict",
+			codeVersion:    "2024",
+			strictVersions: true,
+			want:           true,
+		},
+		{
+			name:           "Mismatched version strict",
+			codeVersion:    "2023",
+			strictVersions: true,
+			want:           false,
+		},
+		{
+			name:           "Mismatched version not strict",
+			codeVersion:    "2023",
+			strictVersions: false,
+			want:           true,
+		},
+		{
+			name:           "Matching version not strict",
+			codeVersion:    "2024",
+			strictVersions: false,
+			want:           true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cql := dedent.Dedent(fmt.Sprintf(`
+				library TESTLIB version '1.0.0'
+				using FHIR version '4.0.1'
+				valueset VS: 'https://example.com/vs/versioned'
+				define Gluc: Code { code: 'gluc', system: 'https://example.com/cs/diagnosis', version: '%s' }
+				define TESTRESULT: Gluc in VS and { Gluc } in VS and Concept { codes: { Gluc } } in VS`, tc.codeVersion))
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			tp, err := terminology.NewInMemoryFHIRProvider([]string{vs})
+			if err != nil {
+				t.Fatalf("NewInMemoryFHIRProvider() returned unexpected error: %v", err)
+			}
+
+			config := defaultInterpreterConfig(t, p)
+			config.Terminology = tp
+			config.StrictCodeSystemVersions = tc.strictVersions
+			results, err := interpreter.Eval(context.Background(), parsedLibs, config)
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(newOrFatal(t, tc.want), getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestInValueSet_TerminologyProviderError(t *testing.T) {
+	cql := dedent.Dedent(`
+		library TESTLIB version '1.0.0'
+		using FHIR version '4.0.1'
+		valueset VS: 'https://example.com/vs/glucose'
+		define TESTRESULT: Code { code: 'gluc', system: 'a' } in VS`)
+	p := newFHIRParser(t)
+	parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, cql), parser.Config{})
+	if err != nil {
+		t.Fatalf("Parse returned unexpected error: %v", err)
+	}
+
+	wantErr := errors.New("terminology server unavailable")
+	config := defaultInterpreterConfig(t, p)
+	config.Terminology = &recordingTerminologyProvider{err: wantErr}
+	_, err = interpreter.Eval(context.Background(), parsedLibs, config)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Eval returned unexpected error. got: %v, want: %v", err, wantErr)
+	}
+}
+
+// terminologyQuery is a single membership query received by a recordingTerminologyProvider.
+type terminologyQuery struct {
+	Method  string
+	Codes   []terminology.Code
+	URL     string
+	Version string
+}
+
+// recordingTerminologyProvider is a fake terminology.Provider that records the membership queries
+// made by the interpreter. A code is a member of every ValueSet and CodeSystem if its code value is
+// in memberCodes.
+type recordingTerminologyProvider struct {
+	memberCodes map[string]bool
+	err         error
+	queries     []terminologyQuery
+}
+
+func (r *recordingTerminologyProvider) AnyInValueSet(codes []terminology.Code, valueSetURL, valueSetVersion string) (bool, error) {
+	return r.anyIn("AnyInValueSet", codes, valueSetURL, valueSetVersion)
+}
+
+func (r *recordingTerminologyProvider) AnyInCodeSystem(codes []terminology.Code, codeSystemURL, codeSystemVersion string) (bool, error) {
+	return r.anyIn("AnyInCodeSystem", codes, codeSystemURL, codeSystemVersion)
+}
+
+func (r *recordingTerminologyProvider) ExpandValueSet(valueSetURL, valueSetVersion string) ([]*terminology.Code, error) {
+	return nil, fmt.Errorf("ExpandValueSet is not supported by recordingTerminologyProvider")
+}
+
+func (r *recordingTerminologyProvider) anyIn(method string, codes []terminology.Code, url, version string) (bool, error) {
+	r.queries = append(r.queries, terminologyQuery{Method: method, Codes: codes, URL: url, Version: version})
+	if r.err != nil {
+		return false, r.err
+	}
+	for _, c := range codes {
+		if r.memberCodes[c.Code] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
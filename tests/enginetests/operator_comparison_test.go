@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -111,6 +112,128 @@ func TestEqual(t *testing.T) {
 			cql:        "@2024-02-29 = @2024-02",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Times equal",
+			cql:        "@T10:00:00.000 = @T10:00:00.000",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Times not equal",
+			cql:        "@T10:00 = @T10:30",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Equal Times until differing precision is null",
+			cql:        "@T10 = @T10:30",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "DateTimes with different offsets equal",
+			cql:        "@2020-01-01T12:00:00-05:00 = @2020-01-01T17:00:00Z",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "DateTimes with different offsets not equal",
+			cql:        "@2020-01-01T12:00:00-05:00 = @2020-01-01T12:00:00Z",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "DateTime without offset uses the evaluation timezone",
+			// The evaluation timestamp has an offset of +04:00.
+			cql:        "@2020-01-01T16:00:00 = @2020-01-01T12:00:00Z",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "DateTime compared to day precision DateTime in the evaluation timezone",
+			// 21:00Z on Jan 1 is Jan 2 in the evaluation timezone, so the result is uncertain.
+			cql:        "@2020-01-01T21:00:00Z = @2020-01-02T",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Ratios equal",
+			cql:        "1 'mg':2 'mL' = 1 'mg':2 'mL'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratios with different units equal",
+			cql:        "1 'g':2 'mL' = 1000 'mg':0.002 'L'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratios not equal",
+			cql:        "1:2 = 1:3",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Ratios representing the same value are not equal",
+			cql:        "1:100 = 10:1000",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Ratio instance selectors equal",
+			cql:        "Ratio { numerator: 1 'mg', denominator: 2 'mL' } = Ratio { numerator: 1 'mg', denominator: 2 'mL' }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratio instance selector equals Ratio literal",
+			cql:        "Ratio { numerator: 1 'g', denominator: 2 'mL' } = 1000 'mg':0.002 'L'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratio = null",
+			cql:        "1:2 = null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Non overlapping uncertainty and Integer are not equal",
+			cql:        "(months between @2020 and @2021) = 30",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Overlapping uncertainty and Integer are uncertain",
+			cql:        "(months between @2020 and @2021) = 5",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Code = Code with different versions",
+			cql:        "Code { system: 'http://example.com', code: '1', version: '1.0' } = Code { system: 'http://example.com', code: '1', version: '2.0' }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Code = Code with different displays",
+			cql:        "Code { system: 'http://example.com', code: '1', display: 'One' } = Code { system: 'http://example.com', code: '1', display: 'Uno' }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Code = Code with different codes",
+			cql:        "Code { system: 'http://example.com', code: '1' } = Code { system: 'http://example.com', code: '2' }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Code = Code with different systems",
+			cql:        "Code { system: 'http://example.com', code: '1' } = Code { system: 'http://other.com', code: '1' }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Code = null",
+			cql:        "Code { system: 'http://example.com', code: '1' } = null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Concept = Concept ignores display and version",
+			cql:        "Concept { codes: { Code { system: 'http://example.com', code: '1' } }, display: 'One' } = Concept { codes: { Code { system: 'http://example.com', code: '1', version: '2.0' } } }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Concept = Concept with a different code",
+			cql:        "Concept { codes: { Code { system: 'http://example.com', code: '1' }, Code { system: 'http://example.com', code: '2' } } } = Concept { codes: { Code { system: 'http://example.com', code: '1' } } }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "null = Concept",
+			cql:        "null = Concept { codes: { Code { system: 'http://example.com', code: '1' } } }",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -192,6 +315,26 @@ func TestNotEqual(t *testing.T) {
 			cql:        "true != false",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "Equal Ratios with different units",
+			cql:        "1 'g':2 'mL' != 1000 'mg':2 'mL'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Ratios not equal",
+			cql:        "1 'mg':2 'mL' != 1 'mg':3 'mL'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratio instance selectors not equal",
+			cql:        "Ratio { numerator: 1 'mg', denominator: 2 'mL' } != Ratio { numerator: 1 'mg', denominator: 3 'mL' }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "null != Ratio",
+			cql:        "null != 1:2",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -454,6 +597,86 @@ func TestEquivalent(t *testing.T) {
 			cql:        "null as String ~ null as String",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "Untyped nulls",
+			cql:        "null ~ null",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Equivalent Decimals with trailing zeros",
+			cql:        "5.0 ~ 5.00",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Not equivalent Decimals",
+			cql:        "1.4 ~ 1.0",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Decimal and null",
+			cql:        "1.0 ~ null as Decimal",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Equivalent Quantities",
+			cql:        "1.0 'g' ~ 1.00 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Equivalent Quantities with different units",
+			cql:        "1 'g' ~ 1000 'mg'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Quantities with incompatible units",
+			cql:        "1 'g' ~ 1 'm'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Equivalent Ratios",
+			cql:        "1 'mg':100 'mL' ~ 10 'mg':1000 'mL'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Not equivalent Ratios",
+			cql:        "1:2 ~ 1:3",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Equivalent Ratios with different units",
+			cql:        "1 'g':2 'mL' ~ 1000 'mg':2 'mL'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Equivalent Ratio instance selector and Ratio literal",
+			cql:        "Ratio { numerator: 1 'mg', denominator: 100 'mL' } ~ 10 'mg':1000 'mL'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ratio and null",
+			cql:        "1:2 ~ null",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Equivalent null Ratios",
+			cql:        "null as Ratio ~ null as Ratio",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Equivalent Times",
+			cql:        "@T10:00 ~ @T10:00",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Not equivalent Times",
+			cql:        "@T10:00 ~ @T22:00",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Dates with different precision",
+			cql:        "@2020 ~ @2020-01",
+			wantResult: newOrFatal(t, false),
+		},
 	}
 
 	for _, tc := range tests {
@@ -478,6 +701,82 @@ func TestEquivalent(t *testing.T) {
 	}
 }
 
+// TestEqualVersusEquivalent contrasts = and ~ on the same operands. Equal returns null if either
+// operand is null or the precision is insufficient to decide, while equivalent never returns null.
+func TestEqualVersusEquivalent(t *testing.T) {
+	tests := []struct {
+		name           string
+		left           string
+		right          string
+		wantEqual      result.Value
+		wantEquivalent result.Value
+	}{
+		{
+			name:           "null and null",
+			left:           "null",
+			right:          "null",
+			wantEqual:      newOrFatal(t, nil),
+			wantEquivalent: newOrFatal(t, true),
+		},
+		{
+			name:           "Integer and null",
+			left:           "1",
+			right:          "null",
+			wantEqual:      newOrFatal(t, nil),
+			wantEquivalent: newOrFatal(t, false),
+		},
+		{
+			name:           "Decimals with different trailing zeros",
+			left:           "5.0",
+			right:          "5.00",
+			wantEqual:      newOrFatal(t, true),
+			wantEquivalent: newOrFatal(t, true),
+		},
+		{
+			name:           "Quantities in different units",
+			left:           "1 'g'",
+			right:          "1000 'mg'",
+			wantEqual:      newOrFatal(t, true),
+			wantEquivalent: newOrFatal(t, true),
+		},
+		{
+			name:           "Partial Dates",
+			left:           "@2020",
+			right:          "@2020-01",
+			wantEqual:      newOrFatal(t, nil),
+			wantEquivalent: newOrFatal(t, false),
+		},
+		{
+			name:           "DateTimes with different precision",
+			left:           "@2020-01-01T10:00",
+			right:          "@2020-01-01T10:00:00",
+			wantEqual:      newOrFatal(t, nil),
+			wantEquivalent: newOrFatal(t, false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for op, want := range map[string]result.Value{"=": tc.wantEqual, "~": tc.wantEquivalent} {
+				cql := fmt.Sprintf("%v %v %v", tc.left, op, tc.right)
+				p := newFHIRParser(t)
+				parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, cql), parser.Config{})
+				if err != nil {
+					t.Fatalf("Parse %q returned unexpected error: %v", cql, err)
+				}
+
+				results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+				if err != nil {
+					t.Fatalf("Eval %q returned unexpected error: %v", cql, err)
+				}
+				if diff := cmp.Diff(want, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+					t.Errorf("Eval %q diff (-want +got)\n%v", cql, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestEquivalent_Errors(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -840,6 +1139,21 @@ func TestEquivalentConceptCode(t *testing.T) {
 			cql:        "define TESTRESULT: Equivalent(Concept { codes: { } }, null as Code)",
 			wantResult: newOrFatal(t, false),
 		},
+		{
+			name:       "Concept ~ Concept with a shared code",
+			cql:        "define TESTRESULT: Concept { codes: { Code { system: 'http://example.com', code: '1' }, Code { system: 'http://example.com', code: '2' } } } ~ Concept { codes: { Code { system: 'http://example.com', code: '2', display: 'Two' } } }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Concept ~ Concept with no shared code",
+			cql:        "define TESTRESULT: Concept { codes: { Code { system: 'http://example.com', code: '1' } } } ~ Concept { codes: { Code { system: 'http://example.com', code: '2' } } }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "null Concept ~ null Concept",
+			cql:        "define TESTRESULT: (null as Concept) ~ (null as Concept)",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -864,6 +1178,63 @@ func TestEquivalentConceptCode(t *testing.T) {
 	}
 }
 
+func TestInConcept(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "Code in Concept",
+			cql:        "Code { system: 'http://example.com', code: '2' } in Concept { codes: { Code { system: 'http://example.com', code: '1' }, Code { system: 'http://example.com', code: '2' } } }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Code with different version and display in Concept",
+			cql:        "Code { system: 'http://example.com', code: '1', version: '1.0', display: 'One' } in Concept { codes: { Code { system: 'http://example.com', code: '1', version: '2.0' } } }",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Code not in Concept",
+			cql:        "Code { system: 'http://example.com', code: '3' } in Concept { codes: { Code { system: 'http://example.com', code: '1' }, Code { system: 'http://example.com', code: '2' } } }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Code from another system not in Concept",
+			cql:        "Code { system: 'http://other.com', code: '1' } in Concept { codes: { Code { system: 'http://example.com', code: '1' } } }",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "null Code in Concept",
+			cql:        "(null as Code) in Concept { codes: { Code { system: 'http://example.com', code: '1' } } }",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Code in null Concept",
+			cql:        "Code { system: 'http://example.com', code: '1' } in (null as Concept)",
+			wantResult: newOrFatal(t, false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestGreater(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1005,6 +1376,16 @@ func TestGreater(t *testing.T) {
 			cql:        "'ab' > null",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Uncertainty entirely greater than Integer",
+			cql:        "months between DateTime(2005) and DateTime(2006, 7) > 5",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Uncertainty overlapping Integer is uncertain",
+			cql:        "months between DateTime(2005) and DateTime(2006, 2) > 5",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1130,6 +1511,26 @@ func TestGreaterOrEqual(t *testing.T) {
 			cql:        "@2020-01 >= @2020",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "@2020 >= @2020-06-15 right has greater precision",
+			cql:        "@2020 >= @2020-06-15",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "@2021 >= @2020-06-15 determinate at the shared precision",
+			cql:        "@2021 >= @2020-06-15",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "@T10:00 >= @T09:59:59.999",
+			cql:        "@T10:00 >= @T09:59:59.999",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "@T10 >= @T10:30 uncertain Time",
+			cql:        "@T10 >= @T10:30",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "@2020-01-02T02 >= @2020-01-02T01",
 			cql:        "@2020-01-02T02:01:00.000Z >= @2020-01-02T01:01:00.000Z",
@@ -1295,6 +1696,41 @@ func TestLess(t *testing.T) {
 			cql:        "@2020-01 < @2020",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "@2020 < @2020-06 right has greater precision",
+			cql:        "@2020 < @2020-06",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "@2019 < @2020-06 determinate at the shared precision",
+			cql:        "@2019 < @2020-06",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "@2020-07 < @2020-06-15 determinate at the shared precision",
+			cql:        "@2020-07 < @2020-06-15",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "@2020-01-02T10 < @2020-01-02T10:30 uncertain DateTime",
+			cql:        "@2020-01-02T10Z < @2020-01-02T10:30Z",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "@T09 < @T10:30",
+			cql:        "@T09 < @T10:30",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "@T10:31 < @T10:30",
+			cql:        "@T10:31 < @T10:30",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "@T10 < @T10:30 uncertain Time",
+			cql:        "@T10 < @T10:30",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "@2020-01-02T02 < @2020-01-02T01",
 			cql:        "@2020-01-02T02:01:00.000Z < @2020-01-02T01:01:00.000Z",
@@ -1335,6 +1771,16 @@ func TestLess(t *testing.T) {
 			cql:        "'ab' < null",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Uncertainty entirely greater than Integer",
+			cql:        "(months between @2020 and @2021) < -1",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Uncertainties that do not overlap",
+			cql:        "(months between @2020 and @2020-06) < (months between @2019 and @2021)",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1523,3 +1969,219 @@ func TestLessOrEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "Integer in range",
+			cql:        "5 between 1 and 10",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer out of range",
+			cql:        "11 between 1 and 10",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Integer on boundary",
+			cql:        "10 between 1 and 10",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Properly between on boundary",
+			cql:        "10 properly between 1 and 10",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Decimal with implicit conversion",
+			cql:        "2.5 between 1 and 10",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Quantity with different units in range",
+			cql:        "500 'mg' between 0.1 'g' and 1 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Quantity out of range",
+			cql:        "2 'g' between 100 'mg' and 1 'g'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "DateTime in range",
+			cql:        "@2024-03-15T10:00 between @2024-01-01T00:00 and @2024-12-31T23:59",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "DateTime out of range",
+			cql:        "@2025-01-01T00:00 between @2024-01-01T00:00 and @2024-12-31T23:59",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null low bound in range is null",
+			cql:        "5 between null and 10",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null low bound out of range is false",
+			cql:        "11 between null and 10",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null high bound",
+			cql:        "5 between 1 and null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null operand",
+			cql:        "null as Integer between 1 and 10",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestCompareQuantity(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "Same unit less",
+			cql:        "1 'g' < 2 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Same unit greater or equal",
+			cql:        "2 'g' >= 2 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Convertible units greater",
+			cql:        "5 'g' > 100 'mg'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Convertible units less",
+			cql:        "5 'g' < 100 'mg'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Convertible units less or equal",
+			cql:        "1000 'mg' <= 1 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Convertible units greater or equal",
+			cql:        "1 'g' >= 1000 'mg'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Convertible units equal",
+			cql:        "1 'g' = 1000 'mg'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Convertible units not equal",
+			cql:        "1 'g' != 1000 'mg'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Same unit not equal values",
+			cql:        "1 'g' = 2 'g'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null right operand",
+			cql:        "5 'g' > null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null left operand",
+			cql:        "null < 5 'g'",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null equal",
+			cql:        "5 'g' = null as Quantity",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestCompareQuantity_Errors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Incompatible units less",
+			cql:                 "5 'g' < 1 'm'",
+			wantEvalErrContains: "incompatible units",
+		},
+		{
+			name:                "Incompatible units equal",
+			cql:                 "5 'g' = 5 'm'",
+			wantEvalErrContains: "incompatible units",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned containing %q, got nil instead", tc.wantEvalErrContains)
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
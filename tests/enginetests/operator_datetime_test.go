@@ -129,6 +129,17 @@ func TestDateTimeOperatorBefore(t *testing.T) {
 			cql:        "@2020-03-01 before day of @2020-03-01",
 			wantResult: newOrFatal(t, false),
 		},
+		{
+			name:       "DateTimes with different offsets are compared as instants",
+			cql:        "@2020-01-01T22:00:00-05:00 before day of @2020-01-02T04:00:00Z",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name: "DateTime compared to day precision DateTime in the evaluation timezone",
+			// 21:00Z on Jan 1 is Jan 2 in the evaluation timezone of +04:00.
+			cql:        "@2020-01-01T21:00:00Z before day of @2020-01-02T",
+			wantResult: newOrFatal(t, false),
+		},
 		{
 			name: "Left null",
 			// Ambiguous match without casting.
@@ -438,6 +449,41 @@ func TestDateTimeOperatorDifferenceBetween(t *testing.T) {
 			cql:        "difference in milliseconds between @2022-02-22T01:20:30.101-07:00 and @2022-02-22T01:20:30.105-07:00",
 			wantResult: newOrFatal(t, 4),
 		},
+		{
+			name:       "difference in days counts calendar days in each timezone offset",
+			cql:        "difference in days between @2017-03-12T00:00:00-07:00 and @2017-03-13T00:00:00-06:00",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "difference in hours counts hour boundaries crossed",
+			cql:        "difference in hours between @2014-01-01T10:59:00.000Z and @2014-01-01T11:01:00.000Z",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "difference in minutes between @T10:00 and @T12:30",
+			cql:        "difference in minutes between @T10:00 and @T12:30",
+			wantResult: newOrFatal(t, 150),
+		},
+		{
+			name:       "difference in hours between @T12:59 and @T10:00",
+			cql:        "difference in hours between @T12:59 and @T10:00",
+			wantResult: newOrFatal(t, -2),
+		},
+		{
+			name:       "difference in months between imprecise @2014 and @2016 returns uncertainty",
+			cql:        "difference in months between @2014 and @2016",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 13, High: 35}),
+		},
+		{
+			name:       "difference in days between imprecise DateTime(2014, 2) returns uncertainty",
+			cql:        "difference in days between DateTime(2014, 1, 15) and DateTime(2014, 2)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 17, High: 44}),
+		},
+		{
+			name:       "difference in days between imprecise @2014-01 and @2014-03 returns uncertainty",
+			cql:        "difference in days between @2014-01 and @2014-03",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 29, High: 89}),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -462,6 +508,260 @@ func TestDateTimeOperatorDifferenceBetween(t *testing.T) {
 	}
 }
 
+func TestDateTimeOperatorDurationBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "months between @2020-01-31 and @2020-02-01",
+			cql:  "months between @2020-01-31 and @2020-02-01",
+			wantModel: &model.DurationBetween{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("@2020-01-31", types.Date),
+						model.NewLiteral("@2020-02-01", types.Date),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+				Precision: model.MONTH,
+			},
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "difference in months between @2020-01-31 and @2020-02-01 for contrast",
+			cql:        "difference in months between @2020-01-31 and @2020-02-01",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "duration in months between @2020-01-31 and @2020-02-29 clamps to the end of the month",
+			cql:        "duration in months between @2020-01-31 and @2020-02-29",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "months between @2020-03-31 and @2020-02-29",
+			cql:        "months between @2020-03-31 and @2020-02-29",
+			wantResult: newOrFatal(t, -1),
+		},
+		{
+			name:       "years between @2012-03-10 and @2013-03-09",
+			cql:        "years between @2012-03-10 and @2013-03-09",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "years between @2012-03-10 and @2013-03-10",
+			cql:        "years between @2012-03-10 and @2013-03-10",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "years between @2013-03-10 and @2012-03-11",
+			cql:        "years between @2013-03-10 and @2012-03-11",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "weeks between @2012-03-10T22:05:09 and @2012-03-20T07:19:33",
+			cql:        "weeks between @2012-03-10T22:05:09 and @2012-03-20T07:19:33",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "days between @2014-01-01T12:00:00.000Z and @2014-01-03T11:59:59.999Z",
+			cql:        "days between @2014-01-01T12:00:00.000Z and @2014-01-03T11:59:59.999Z",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "hours between @T10:59 and @T11:01",
+			cql:        "hours between @T10:59 and @T11:01",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "hours between timezone offsets",
+			cql:        "hours between @2017-03-12T01:00:00-07:00 and @2017-03-12T03:00:00-06:00",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "milliseconds between @T10:00:00.000 and @T10:00:01.500",
+			cql:        "milliseconds between @T10:00:00.000 and @T10:00:01.500",
+			wantResult: newOrFatal(t, 1500),
+		},
+		{
+			name:       "days between imprecise DateTime(2014, 2) returns uncertainty",
+			cql:        "days between DateTime(2014, 1, 15) and DateTime(2014, 2)",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 16, High: 44}),
+		},
+		{
+			name:       "months between imprecise @2014 and @2014-06 returns uncertainty",
+			cql:        "months between @2014 and @2014-06",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: -6, High: 5}),
+		},
+		{
+			name:       "months between imprecise @2020 and @2021 returns uncertainty",
+			cql:        "months between @2020 and @2021",
+			wantResult: newOrFatal(t, result.Uncertainty{Low: 0, High: 23}),
+		},
+		{
+			name:       "days between null and @2014-01-01 returns null",
+			cql:        "days between null and @2014-01-01",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestDateTimeOperatorComponentFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "year from DateTime",
+			cql:  "year from @2014-01-02T10:30:15.500",
+			wantModel: &model.DateTimeComponentFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("@2014-01-02T10:30:15.500", types.DateTime),
+					Expression: model.ResultType(types.Integer),
+				},
+				Precision: model.YEAR,
+			},
+			wantResult: newOrFatal(t, 2014),
+		},
+		{
+			name:       "month from DateTime",
+			cql:        "month from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "day from DateTime",
+			cql:        "day from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "hour from DateTime",
+			cql:        "hour from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 10),
+		},
+		{
+			name:       "minute from DateTime",
+			cql:        "minute from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 30),
+		},
+		{
+			name:       "second from DateTime",
+			cql:        "second from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 15),
+		},
+		{
+			name:       "millisecond from DateTime",
+			cql:        "millisecond from @2014-01-02T10:30:15.500",
+			wantResult: newOrFatal(t, 500),
+		},
+		{
+			name:       "year from Date",
+			cql:        "year from @2014-01-02",
+			wantResult: newOrFatal(t, 2014),
+		},
+		{
+			name:       "day from Date",
+			cql:        "day from @2014-01-02",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "hour from Time",
+			cql:        "hour from @T10:30:15.500",
+			wantResult: newOrFatal(t, 10),
+		},
+		{
+			name:       "millisecond from Time",
+			cql:        "millisecond from @T10:30:15.500",
+			wantResult: newOrFatal(t, 500),
+		},
+		{
+			name:       "month from Date with year precision is null",
+			cql:        "month from @2014",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "minute from DateTime with hour precision is null",
+			cql:        "minute from DateTime(2014, 1, 2, 10)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "second from Time with minute precision is null",
+			cql:        "second from @T10:30",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "year from null",
+			cql:        "year from (null as DateTime)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "date from DateTime",
+			cql:        "date from DateTime(2014, 1, 2, 10, 30)",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2014, time.January, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "date from DateTime with month precision",
+			cql:        "date from DateTime(2014, 1)",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2014, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
+		},
+		{
+			name:       "time from DateTime",
+			cql:        "time from DateTime(2014, 1, 2, 10, 30)",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "time from DateTime with day precision is null",
+			cql:        "time from DateTime(2014, 1, 2)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestDateTimeOperatorDifferencebetween_Error(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -470,19 +770,29 @@ func TestDateTimeOperatorDifferencebetween_Error(t *testing.T) {
 		wantEvalErrContains string
 	}{
 		{
-			name: "difference in months between 2014 and 2016 return error invalid precision",
-			cql:  "difference in months between @2014 and @2016",
+			name: "difference in hours between dates returns error invalid precision",
+			cql:  "difference in hours between @2014-01-01 and @2014-01-02",
 			wantModel: &model.DifferenceBetween{
 				BinaryExpression: &model.BinaryExpression{
 					Operands: []model.IExpression{
-						model.NewLiteral("@2014", types.Date),
-						model.NewLiteral("@2016", types.Date),
+						model.NewLiteral("@2014-01-01", types.Date),
+						model.NewLiteral("@2014-01-02", types.Date),
 					},
 					Expression: model.ResultType(types.Integer),
 				},
-				Precision: model.MONTH,
+				Precision: model.HOUR,
 			},
-			wantEvalErrContains: "difference between specified a precision greater than argument precision",
+			wantEvalErrContains: "precision must be one of",
+		},
+		{
+			name:                "difference in days between times returns error invalid precision",
+			cql:                 "difference in days between @T10:00 and @T12:00",
+			wantEvalErrContains: "precision must be one of",
+		},
+		{
+			name:                "duration in hours between dates returns error invalid precision",
+			cql:                 "duration in hours between @2014-01-01 and @2014-01-02",
+			wantEvalErrContains: "precision must be one of",
 		},
 	}
 	for _, tc := range tests {
@@ -750,6 +1060,32 @@ func TestEvaluationTimestamp(t *testing.T) {
 			evaluationTimestamp: time.Date(2024, time.January, 1, 1, 1, 1, 1, time.UTC),
 			wantResult:          newOrFatal(t, result.Date{Date: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Precision: model.DAY}),
 		},
+		{
+			name:                "Today uses the evaluation timestamp offset",
+			cql:                 "define TESTRESULT: Today()",
+			evaluationTimestamp: time.Date(2024, time.January, 1, 23, 0, 0, 0, time.FixedZone("-5", -5*60*60)),
+			wantResult:          newOrFatal(t, result.Date{Date: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.FixedZone("-5", -5*60*60)), Precision: model.DAY}),
+		},
+		{
+			name:                "Now, Today and TimeOfDay are derived from the same instant",
+			cql:                 "define TESTRESULT: Today() = date from Now() and TimeOfDay() = time from Now()",
+			evaluationTimestamp: time.Date(2024, time.March, 4, 5, 6, 7, 8_000_000, time.FixedZone("+9", 9*60*60)),
+			wantResult:          newOrFatal(t, true),
+		},
+		{
+			name: "Repeated calls within one evaluation are identical",
+			cql: dedent.Dedent(`
+			define First: Now()
+			define Second: Now()
+			define TESTRESULT: First = Second and Now() = Now() and TimeOfDay() = TimeOfDay()`),
+			evaluationTimestamp: time.Date(2024, time.January, 1, 1, 1, 1, 1_000_000, time.UTC),
+			wantResult:          newOrFatal(t, true),
+		},
+		{
+			name:       "Unset evaluation timestamp defaults to the current time",
+			cql:        "define TESTRESULT: year from Now() >= 2024",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -894,6 +1230,16 @@ func TestDateTime(t *testing.T) {
 			cql:        "DateTime(2014, 9, 4, 12, 30, 30, 100, -7)",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.September, 4, 12, 30, 30, 100*1000000, time.FixedZone("-7", -7*60*60)), Precision: model.MILLISECOND}),
 		},
+		{
+			name:       "Timezone with partial precision",
+			cql:        "DateTime(2014, 9, 4, null, null, null, null, 5.5)",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.September, 4, 0, 0, 0, 0, time.FixedZone("5.5", 5*60*60+30*60)), Precision: model.DAY}),
+		},
+		{
+			name:       "Null timezone defaults to evaluation timestamp offset",
+			cql:        "DateTime(2014, 9, 4, 12, 30, 30, 100, null)",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2014, time.September, 4, 12, 30, 30, 100*1000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
 		{
 			name:       "Functional and string constructors equal",
 			cql:        "DateTime(2014, 9, 4, 12, 30, 30, 101) = @2014-09-04T12:30:30.101",
@@ -1049,6 +1395,11 @@ func TestDateTimeConstructor_Errors(t *testing.T) {
 			cql:     "DateTime(99999999)",
 			wantErr: "year 99999999 is out of range",
 		},
+		{
+			name:    "DateTime month outside range",
+			cql:     "DateTime(2014, 13)",
+			wantErr: "month 13 is out of range",
+		},
 		{
 			name:    "DateTime millisecond outside range",
 			cql:     "DateTime(2014, 9, 4, 12, 30, 30, -101, -7)",
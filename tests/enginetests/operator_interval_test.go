@@ -101,6 +101,31 @@ func TestEnd(t *testing.T) {
 			cql:        "end of Interval[@2012-01, @2013-01)",
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(2012, time.December, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
 		},
+		{
+			name:       "Functional form",
+			cql:        "End(Interval[1, 5))",
+			wantResult: newOrFatal(t, int32(4)),
+		},
+		{
+			name:       "DateTime high inclusive",
+			cql:        "end of Interval[@2012-01-01T00:00:00.000, @2013-01-01T00:00:00.000]",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2013, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime high exclusive returns predecessor",
+			cql:        "end of Interval[@2012-01-01T00:00:00.000, @2013-01-01T00:00:00.000)",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2012, time.December, 31, 23, 59, 59, 999000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime high inclusive null returns maximum",
+			cql:        "end of Interval[@2012-01-01T00:00:00.000, null]",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(9999, time.December, 31, 23, 59, 59, 999000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime high exclusive null",
+			cql:        "end of Interval[@2012-01-01T00:00:00.000, null)",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -197,6 +222,31 @@ func TestStart(t *testing.T) {
 			cql:        "start of Interval(@2012-11, @2013-01]",
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(2012, time.December, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
 		},
+		{
+			name:       "Functional form",
+			cql:        "Start(Interval(1, 5])",
+			wantResult: newOrFatal(t, int32(2)),
+		},
+		{
+			name:       "DateTime low inclusive",
+			cql:        "start of Interval[@2012-01-01T00:00:00.000, @2013-01-01T00:00:00.000]",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2012, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime low exclusive returns successor",
+			cql:        "start of Interval(@2012-01-01T00:00:00.000, @2013-01-01T00:00:00.000]",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2012, time.January, 1, 0, 0, 0, 1000000, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime low inclusive null returns minimum",
+			cql:        "start of Interval[null, @2013-01-01T00:00:00.000]",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(1, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "DateTime low exclusive null",
+			cql:        "start of Interval(null, @2013-01-01T00:00:00.000]",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 
 	for _, tc := range tests {
@@ -221,6 +271,317 @@ func TestStart(t *testing.T) {
 	}
 }
 
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Closed integer interval",
+			cql:  "width of Interval[1, 5]",
+			wantModel: &model.Width{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("5", types.Integer),
+						LowInclusive:  true,
+						HighInclusive: true,
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, int32(4)),
+		},
+		{
+			name:       "Open integer interval",
+			cql:        "Width(Interval(1, 5))",
+			wantResult: newOrFatal(t, int32(2)),
+		},
+		{
+			name:       "Long interval",
+			cql:        "Width(Interval[1L, 10L])",
+			wantResult: newOrFatal(t, int64(9)),
+		},
+		{
+			name:       "Decimal interval",
+			cql:        "Width(Interval[1.5, 3.0])",
+			wantResult: newOrFatal(t, 1.5),
+		},
+		{
+			name:       "Quantity interval",
+			cql:        "Width(Interval[2 'cm', 5 'cm'])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: "cm"}),
+		},
+		{
+			name:       "Date interval",
+			cql:        "Width(Interval[@2012-01-01, @2012-03-01])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 60, Unit: model.DAYUNIT}),
+		},
+		{
+			name:       "DateTime interval",
+			cql:        "Width(Interval[@2012-01-01T10:00, @2012-01-01T12:30])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 150, Unit: model.MINUTEUNIT}),
+		},
+		{
+			name:       "Date interval with mixed precision",
+			cql:        "Width(Interval[@2012-01, @2012-03-15])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 2, Unit: model.MONTHUNIT}),
+		},
+		{
+			name:       "Unbounded high",
+			cql:        "Width(Interval[1, null])",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unbounded low",
+			cql:        "Width(Interval(null, 5])",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Width(null as Interval<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Closed integer interval",
+			cql:  "Size(Interval[1, 5])",
+			wantModel: &model.Size{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("1", types.Integer),
+						High:          model.NewLiteral("5", types.Integer),
+						LowInclusive:  true,
+						HighInclusive: true,
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, int32(5)),
+		},
+		{
+			name:       "Open integer interval",
+			cql:        "Size(Interval(1, 5))",
+			wantResult: newOrFatal(t, int32(3)),
+		},
+		{
+			name:       "Single point interval",
+			cql:        "Size(Interval[3, 3])",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Long interval",
+			cql:        "Size(Interval[1L, 10L])",
+			wantResult: newOrFatal(t, int64(10)),
+		},
+		{
+			name:       "Decimal interval",
+			cql:        "Size(Interval[1.0, 2.0])",
+			wantResult: newOrFatal(t, 1.00000001),
+		},
+		{
+			name:       "Date interval",
+			cql:        "Size(Interval[@2012-01-01, @2012-01-03])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: model.DAYUNIT}),
+		},
+		{
+			name:       "DateTime interval",
+			cql:        "Size(Interval[@2012-01-01T10, @2012-01-01T12])",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: model.HOURUNIT}),
+		},
+		{
+			name:       "Unbounded high",
+			cql:        "Size(Interval[1, null])",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Size(null as Interval<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestWidthSize_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Width of full Integer range",
+			cql:                 "Width(Interval[-2147483648, 2147483647])",
+			wantEvalErrContains: "Width overflowed the System.Integer range",
+		},
+		{
+			name:                "Size of full Integer range",
+			cql:                 "Size(Interval[-2147483648, 2147483647])",
+			wantEvalErrContains: "Size overflowed the System.Integer range",
+		},
+		{
+			name:                "Size of Integer range ending at the maximum",
+			cql:                 "Size(Interval[0, 2147483647])",
+			wantEvalErrContains: "Size overflowed the System.Integer range",
+		},
+		{
+			name:                "Width of full Long range",
+			cql:                 "Width(Interval[-9223372036854775808L, 9223372036854775807L])",
+			wantEvalErrContains: "Width overflowed the System.Long range",
+		},
+		{
+			name:                "Size of Long range ending at the maximum",
+			cql:                 "Size(Interval[0L, 9223372036854775807L])",
+			wantEvalErrContains: "Size overflowed the System.Long range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
+func TestPointFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Unit interval",
+			cql:  "point from Interval[5, 5]",
+			wantModel: &model.PointFrom{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operand: &model.Interval{
+						Low:           model.NewLiteral("5", types.Integer),
+						High:          model.NewLiteral("5", types.Integer),
+						LowInclusive:  true,
+						HighInclusive: true,
+						Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, int32(5)),
+		},
+		{
+			name:       "Half open unit interval",
+			cql:        "point from Interval[5, 6)",
+			wantResult: newOrFatal(t, int32(5)),
+		},
+		{
+			name:       "Functional form",
+			cql:        "PointFrom(Interval[2.5, 2.5])",
+			wantResult: newOrFatal(t, 2.5),
+		},
+		{
+			name:       "Date unit interval",
+			cql:        "point from Interval[@2012-01-01, @2012-01-01]",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2012, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+		},
+		{
+			name:       "Unknown boundary",
+			cql:        "point from Interval(null, 5]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "point from (null as Interval<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestIntervalBefore(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -419,28 +780,79 @@ func TestIntervalBefore(t *testing.T) {
 			cql:        "Interval[@2024, null] ends after Interval[@2024, @2027]",
 			wantResult: newOrFatal(t, true),
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			p := newFHIRParser(t)
-			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
-			if err != nil {
-				t.Fatalf("Parse returned unexpected error: %v", err)
-			}
-			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
-				t.Errorf("Parse diff (-want +got):\n%s", diff)
-			}
-
-			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
-			if err != nil {
-				t.Fatalf("Eval returned unexpected error: %v", err)
-			}
-			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
-				t.Errorf("Eval diff (-want +got)\n%v", diff)
-			}
-		})
-	}
+		// Interval<Integer>, Interval<Integer> overloads:
+		{
+			name:       "Interval<Integer> before with gap",
+			cql:        "Interval[1, 5] before Interval[7, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> touching is not before",
+			cql:        "Interval[1, 5] before Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Integer> open end before",
+			cql:        "Interval[1, 5) before Interval[5, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer before Interval<Integer>",
+			cql:        "3 before Interval[4, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> before Integer",
+			cql:        "Interval[1, 3] before 4",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Decimal> before",
+			cql:        "Interval[1.0, 2.5] before Interval[2.6, 3.0]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Quantity> before",
+			cql:        "Interval[1 'cm', 2 'cm'] before Interval[3 'cm', 4 'cm']",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Time> before",
+			cql:        "Interval[@T10:00, @T11:00] before Interval[@T12:00, @T13:00]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded open end is indeterminate",
+			cql:        "Interval[1, null) before Interval[5, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unbounded closed end is the maximum value",
+			cql:        "Interval[1, null] before Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
 }
 
 func TestIntervalAfter(t *testing.T) {
@@ -636,6 +1048,42 @@ func TestIntervalAfter(t *testing.T) {
 			cql:        "Interval[@2021T, @2026T] starts after Interval[@2024T, @2027T]",
 			wantResult: newOrFatal(t, false),
 		},
+		// Interval<Integer>, Interval<Integer> overloads:
+		{
+			name:       "Interval<Integer> after with gap",
+			cql:        "Interval[7, 10] after Interval[1, 5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> touching is not after",
+			cql:        "Interval[5, 10] after Interval[1, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Integer> open start after",
+			cql:        "Interval(5, 10] after Interval[1, 5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer after Interval<Integer>",
+			cql:        "11 after Interval[4, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Decimal> after",
+			cql:        "Interval[2.6, 3.0] after Interval[1.0, 2.5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded open start is indeterminate",
+			cql:        "Interval(null, 10] after Interval[1, 5]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unbounded closed start is the minimum value",
+			cql:        "Interval[null, 10] after Interval[1, 5]",
+			wantResult: newOrFatal(t, false),
+		},
 	}
 
 	for _, tc := range tests {
@@ -840,6 +1288,22 @@ func TestIntervalSameOrBefore(t *testing.T) {
 			cql:        "Interval(@2024, @2026] starts on or before Interval(@2023, @2027)",
 			wantResult: newOrFatal(t, false),
 		},
+		// Interval<Integer>, Interval<Integer> overloads:
+		{
+			name:       "Interval<Integer> touching is on or before",
+			cql:        "Interval[1, 5] on or before Interval[5, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> overlapping is not on or before",
+			cql:        "Interval[1, 6] on or before Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Long> on or before",
+			cql:        "Interval[1L, 5L] on or before Interval[5L, 10L]",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1028,6 +1492,22 @@ func TestIntervalSameOrAfter(t *testing.T) {
 			cql:        "Interval(@2024, @2026] starts on or after Interval(@2023, @2027)",
 			wantResult: newOrFatal(t, false),
 		},
+		// Interval<Integer>, Interval<Integer> overloads:
+		{
+			name:       "Interval<Integer> touching is on or after",
+			cql:        "Interval[5, 10] on or after Interval[1, 5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> overlapping is not on or after",
+			cql:        "Interval[4, 10] on or after Interval[1, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Quantity> on or after",
+			cql:        "Interval[5 'cm', 10 'cm'] on or after Interval[1 'cm', 5 'cm']",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1497,6 +1977,184 @@ func TestIntervalIncludedIn(t *testing.T) {
 			cql:        "@2020-03 included in Interval[@2020-03-25, @2022-04-25)",
 			wantResult: newOrFatal(t, nil),
 		},
+		// Interval<T>, Interval<T> overloads:
+		{
+			name:       "Integer interval included in",
+			cql:        "Interval[2, 5] included in Interval[1, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer interval not included in",
+			cql:        "Interval[2, 11] included in Interval[1, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Integer interval on exclusive bound",
+			cql:        "Interval[2, 10] included in Interval[1, 10)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Date interval included in day of",
+			cql:        "Interval[@2012-01-02, @2012-01-31] included in day of Interval[@2012-01-01, @2012-01-31]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Functional syntax",
+			cql:        "IncludedIn(Interval[2, 5], Interval[1, 10])",
+			wantResult: newOrFatal(t, true),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalIncludes(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Integer interval includes",
+			cql:  "Interval[1, 10] includes Interval[2, 5]",
+			wantModel: &model.Includes{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("2", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Same interval",
+			cql:        "Interval[1, 10] includes Interval[1, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Ends after",
+			cql:        "Interval[1, 10] includes Interval[2, 11]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Starts before",
+			cql:        "Interval[1, 10] includes Interval[0, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "On exclusive end bound",
+			cql:        "Interval[1, 10) includes Interval[2, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "On exclusive start bound",
+			cql:        "Interval(1, 10] includes Interval[1, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Exclusive bounds of both intervals",
+			cql:        "Interval(1, 10) includes Interval(1, 10)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Long interval",
+			cql:        "Interval[1L, 10L] includes Interval[2L, 5L]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Decimal interval",
+			cql:        "Interval[1.0, 2.0] includes Interval[1.5, 2.0]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Quantity interval",
+			cql:        "Interval[1'cm', 10'cm'] includes Interval[2'cm', 5'cm']",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Date interval",
+			cql:        "Interval[@2012-01-01, @2012-01-31] includes Interval[@2012-01-05, @2012-01-25]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "DateTime interval at day precision",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-31T10:00:00.000] includes day of Interval[@2012-01-01T12:00:00.000, @2012-01-31T08:00:00.000]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "DateTime interval without precision",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-31T10:00:00.000] includes Interval[@2012-01-01T12:00:00.000, @2012-01-31T11:00:00.000]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Insufficient precision",
+			cql:        "Interval[@2012-01, @2012-03] includes day of Interval[@2012-01-05, @2012-02-25]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Time interval",
+			cql:        "Interval[@T10:00, @T12:00] includes Interval[@T10:30, @T11:00]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded exclusive end is indeterminate",
+			cql:        "Interval[1, null) includes Interval[2, 5]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unbounded exclusive end but starts after",
+			cql:        "Interval[1, null) includes Interval[0, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Unbounded inclusive end",
+			cql:        "Interval[1, null] includes Interval[2, 5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Null interval",
+			cql:        "null as Interval<Integer> includes Interval[1, 2]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Functional syntax",
+			cql:        "Includes(Interval[1, 10], Interval[2, 5])",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1636,6 +2294,67 @@ func TestIntervalOverlaps(t *testing.T) {
 			cql:        "Interval[@2024-02, @2025] overlaps Interval[@2024-03, @2025-02]",
 			wantResult: newOrFatal(t, true),
 		},
+		// Interval<Integer>, Interval<Integer> overloads:
+		{
+			name:       "Interval<Integer> partial overlap",
+			cql:        "Interval[1, 5] overlaps Interval[3, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> touching closed boundaries",
+			cql:        "Interval[1, 5] overlaps Interval[5, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Integer> touching open boundary",
+			cql:        "Interval[1, 5) overlaps Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Integer> with gap",
+			cql:        "Interval[1, 5] overlaps Interval[7, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Decimal> partial overlap",
+			cql:        "Interval[1.0, 2.5] overlaps Interval[2.0, 3.0]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Quantity> partial overlap",
+			cql:        "Interval[1 'cm', 5 'cm'] overlaps Interval[4 'cm', 9 'cm']",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Time> partial overlap",
+			cql:        "Interval[@T10:00, @T11:00] overlaps Interval[@T10:30, @T12:00]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded open start is indeterminate",
+			cql:        "Interval(null, 5] overlaps Interval[1, 3]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unbounded open start ending before right",
+			cql:        "Interval(null, 5] overlaps Interval[7, 8]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Unbounded closed start",
+			cql:        "Interval[null, 5] overlaps Interval[1, 3]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unknown open start ending within right",
+			cql:        "Interval(null, 5] overlaps Interval[3, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Right unknown open start ending within left",
+			cql:        "Interval[1, 10] overlaps Interval(null, 5]",
+			wantResult: newOrFatal(t, true),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1660,167 +2379,360 @@ func TestIntervalOverlaps(t *testing.T) {
 	}
 }
 
-func TestIntervalContains(t *testing.T) {
+func TestIntervalOverlapsBefore(t *testing.T) {
 	tests := []struct {
 		name       string
 		cql        string
 		wantModel  model.IExpression
 		wantResult result.Value
 	}{
-		// TODO: b/331225778 - Null support handling for Contains operator
 		{
-			name: "On inclusive bound date",
-			cql:  "Interval[@2020-03-25, @2022-04] contains month of @2020-03",
-			wantModel: &model.In{
+			name: "Starts before and overlaps",
+			cql:  "Interval[1, 5] overlaps before Interval[3, 10]",
+			wantModel: &model.OverlapsBefore{
 				BinaryExpression: &model.BinaryExpression{
 					Expression: model.ResultType(types.Boolean),
 					Operands: []model.IExpression{
-						model.NewLiteral("@2020-03", types.Date),
 						&model.Interval{
-							Low:           model.NewLiteral("@2020-03-25", types.Date),
-							High:          model.NewLiteral("@2022-04", types.Date),
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
 							LowInclusive:  true,
 							HighInclusive: true,
-							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("3", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
 						},
 					},
 				},
-				Precision: model.MONTH,
 			},
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Point arg null datetime",
-			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2024-03-31T00:00:00.000Z] contains null",
+			name:       "Starts after right starts",
+			cql:        "Interval[4, 5] overlaps before Interval[3, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Starts before but does not overlap",
+			cql:        "Interval[1, 2] overlaps before Interval[3, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Same start",
+			cql:        "Interval[3, 5] overlaps before Interval[3, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Date> overlaps before",
+			cql:        "Interval[@2024-01-01, @2024-02-01] overlaps before Interval[@2024-01-15, @2024-03-01]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded open end is indeterminate",
+			cql:        "Interval[1, null) overlaps before Interval[3, 10]",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "On inclusive bound datetime",
-			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains month of @2024-03-31T00:00:00.000Z",
+			name:       "Unbounded closed start is the minimum value",
+			cql:        "Interval[null, 5] overlaps before Interval[3, 10]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "On exclusive bound date",
-			cql:        "Interval(@2020-03-25, @2022-04) contains month of @2020-03",
+			name:       "Right unbounded closed start is the minimum value",
+			cql:        "Interval[3, 10] overlaps before Interval[null, 5]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "On exclusive bound datetime",
-			cql:        "Interval(@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains month of @2024-03-31T00:00:00.000Z",
+			name:       "Both unbounded closed starts",
+			cql:        "Interval[null, 5] overlaps before Interval[null, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "On inclusive bound datetime second precision",
-			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2024-03-31T00:00:05.000Z] contains second of @2024-03-31T00:00:00.000Z",
+			name:       "Unbounded closed end is the maximum value",
+			cql:        "Interval[1, null] overlaps before Interval[3, 10]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "On exclusive and inclusive bound",
-			cql:        "Interval(@2020-03, @2022-03] contains month of @2020-03",
+			name:       "Unknown open start is indeterminate",
+			cql:        "Interval(null, 5] overlaps before Interval[3, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unknown open start ending before right",
+			cql:        "Interval(null, 2] overlaps before Interval[3, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Insufficient precision date",
-			cql:        "Interval[@2020-03-25, @2020-04] contains day of @2020-03",
-			wantResult: newOrFatal(t, nil),
+			name:       "Interval<Date> unbounded closed start",
+			cql:        "Interval[null, @2020-01-01] overlaps before Interval[@2019-01-01, @2021-01-01]",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Insufficient precision datetime",
-			cql:        "Interval[@2024-03-28T00:00:00.000Z, @2024-03-31T00:00:00.000Z] contains day of @2024-03",
+			name:       "Null interval",
+			cql:        "Interval[1, 5] overlaps before null as Interval<Integer>",
 			wantResult: newOrFatal(t, nil),
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalOverlapsAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Ends after and overlaps",
+			cql:  "Interval[4, 12] overlaps after Interval[3, 10]",
+			wantModel: &model.OverlapsAfter{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("12", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("3", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
 		{
-			name:       "Insufficient precision but for sure false",
-			cql:        "Interval[@2028-03-25, @2020-04] contains day of @2020-03",
+			name:       "Ends before right ends",
+			cql:        "Interval[4, 9] overlaps after Interval[3, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Null inclusive bound is true",
-			cql:        "Interval[null, @2022-04) contains month of @2020-03",
+			name:       "Ends after but does not overlap",
+			cql:        "Interval[11, 12] overlaps after Interval[3, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Decimal> overlaps after",
+			cql:        "Interval[2.0, 4.0] overlaps after Interval[1.0, 3.0]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Null inclusive bound but this is for sure false",
-			cql:        "Interval[null, @2022-04) contains month of @2025-03",
+			name:       "Unbounded closed end is the maximum value",
+			cql:        "Interval[4, null] overlaps after Interval[3, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Right unbounded closed end is the maximum value",
+			cql:        "Interval[1, 12] overlaps after Interval[3, null]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Null exclusive bound is null",
-			cql:        "Interval(null, @2022-04) contains month of @2021-03",
+			name:       "Both unbounded closed ends",
+			cql:        "Interval[1, null] overlaps after Interval[3, null]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Unbounded closed start is the minimum value",
+			cql:        "Interval[null, 12] overlaps after Interval[3, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unknown open end is indeterminate",
+			cql:        "Interval[4, null) overlaps after Interval[3, 10]",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Null exclusive bound but this is for sure false",
-			cql:        "Interval(null, @2022-04) contains month of @2025-03",
+			name:       "Right unknown open end is indeterminate",
+			cql:        "Interval[1, 10] overlaps after Interval[3, null)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Unknown open end starting after right",
+			cql:        "Interval[11, null) overlaps after Interval[3, 10]",
 			wantResult: newOrFatal(t, false),
 		},
-		// No precision
 		{
-			name:       "No included in operator precision: On inclusive bound date",
-			cql:        "Interval[@2020-03-25, @2022-04) contains day of @2020-03-25",
+			name:       "Interval<DateTime> unbounded closed end",
+			cql:        "Interval[@2020-01-01T00:00:00.000Z, null] overlaps after Interval[@2019-01-01T00:00:00.000Z, @2021-01-01T00:00:00.000Z]",
+			wantResult: newOrFatal(t, true),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalStarts(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Intervals sharing a start",
+			cql:  "Interval[1, 5] starts Interval[1, 10]",
+			wantModel: &model.Starts{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "No included in operator precision: On exclusive bound datetime",
-			cql:        "Interval(@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains day of @2024-03-31T00:00:00.000Z",
+			name:       "Intervals sharing a start, left ends after right",
+			cql:        "Interval[1, 12] starts Interval[1, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "No included in operator precision with differing operand precision",
-			cql:        "Interval[@2020-03-25, @2022-04-25) contains @2020-03",
-			wantResult: newOrFatal(t, nil),
+			name:       "Equal intervals",
+			cql:        "Interval[1, 10] starts Interval[1, 10]",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "interval contains integer",
-			cql:        "Interval[0, 100] contains 42",
+			name:       "Different starts",
+			cql:        "Interval[2, 5] starts Interval[1, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open start equal to closed start",
+			cql:        "Interval(0, 5] starts Interval[1, 10]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Interval does not contain integer",
-			cql:        "Interval[0, 25] contains 42",
+			name:       "Open start with same boundary value as closed start",
+			cql:        "Interval(1, 5] starts Interval[1, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Interval contains integer on bounds",
-			cql:        "Interval[0, 25] contains 25",
+			name:       "Point equal to closed start",
+			cql:        "5 starts Interval[5, 10]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Interval on bounds not inclusive integer",
-			cql:        "Interval[0, 25) contains 25",
+			name:       "Point equal to open start boundary",
+			cql:        "5 starts Interval(5, 10]",
 			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Integer before interval bounds not inclusive",
-			cql:        "Interval[0, 25) contains 24",
+			name:       "Point equal to start of open interval",
+			cql:        "6 starts Interval(5, 10]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Interval not contains integer with null exlusive bounds",
-			cql:        "Interval[0, null) contains 25",
-			wantResult: newOrFatal(t, nil),
+			name:       "Point not equal to start",
+			cql:        "7 starts Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
 		},
 		{
-			name:       "Interval contains double on upper bounds",
-			cql:        "Interval[1.0, 1.5] contains 1.5",
+			name:       "Interval<Decimal> sharing a start",
+			cql:        "Interval[1.5, 2.0] starts Interval[1.5, 3.0]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Interval not contains long",
-			cql:        "Interval[1L, 2L] contains 0L",
-			wantResult: newOrFatal(t, false),
+			name:       "Interval<Date> sharing a start",
+			cql:        "Interval[@2020-01-01, @2020-01-15] starts Interval[@2020-01-01, @2020-02-01]",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Interval contains quantity on bounds",
-			cql:        "Interval[1'cm', 2'cm') contains 1'cm'",
+			name:       "Interval<DateTime> sharing a start with precision",
+			cql:        "Interval[@2020-01-01T10:00:00.000Z, @2020-01-02T00:00:00.000Z] starts day of Interval[@2020-01-01T00:00:00.000Z, @2020-02-01T00:00:00.000Z]",
 			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Functional syntax",
-			cql:        "Contains(Interval[0, 100], 42)",
+			name:       "Interval<DateTime> different starts without precision",
+			cql:        "Interval[@2020-01-01T10:00:00.000Z, @2020-01-02T00:00:00.000Z] starts Interval[@2020-01-01T00:00:00.000Z, @2020-02-01T00:00:00.000Z]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "DateTime point with precision",
+			cql:        "@2020-01-01T10:00:00.000Z starts day of Interval[@2020-01-01T00:00:00.000Z, @2020-02-01T00:00:00.000Z]",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "Unknown start",
+			cql:        "Interval(null, 5] starts Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Interval[1, 5] starts null as Interval<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null point",
+			cql:        "null as Integer starts Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			p := newFHIRParser(t)
@@ -1843,22 +2755,1604 @@ func TestIntervalContains(t *testing.T) {
 	}
 }
 
-func TestComparison_Error(t *testing.T) {
+func TestIntervalEnds(t *testing.T) {
 	tests := []struct {
-		name                string
-		cql                 string
-		wantModel           model.IExpression
-		wantEvalErrContains string
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
 	}{
 		{
-			name:                "Quantity in interval with not matching lower unit",
-			cql:                 "1'cm' in Interval[1'm', 2'cm']",
-			wantEvalErrContains: "in operator recieved Quantities with differing unit values",
+			name: "Intervals sharing an end",
+			cql:  "Interval[5, 10] ends Interval[1, 10]",
+			wantModel: &model.Ends{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("5", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:                "Quantity in interval with not matching lower unit",
-			cql:                 "1'cm' in Interval[1'cm', 2'm']",
-			wantEvalErrContains: "in operator recieved Quantities with differing unit values",
+			name:       "Intervals sharing an end, left starts before right",
+			cql:        "Interval[0, 10] ends Interval[1, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Different ends",
+			cql:        "Interval[5, 9] ends Interval[1, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open end equal to closed end",
+			cql:        "Interval[5, 11) ends Interval[1, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Point equal to closed end",
+			cql:        "10 ends Interval[1, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Point equal to open end boundary",
+			cql:        "10 ends Interval[1, 10)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Point equal to end of open interval",
+			cql:        "9 ends Interval[1, 10)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Date> sharing an end",
+			cql:        "Interval[@2020-01-15, @2020-02-01] ends Interval[@2020-01-01, @2020-02-01]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Date> sharing an end with precision",
+			cql:        "Interval[@2020-01-15, @2020-02-20] ends month of Interval[@2020-01-01, @2020-02-01]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unknown end",
+			cql:        "Interval[5, null) ends Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Interval[5, 10] ends null as Interval<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalMeets(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Meets before",
+			cql:  "Interval[1, 5] meets Interval[6, 10]",
+			wantModel: &model.Meets{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("6", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Meets after",
+			cql:        "Interval[6, 10] meets Interval[1, 5]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Gap between intervals",
+			cql:        "Interval[1, 5] meets Interval[7, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Overlapping intervals",
+			cql:        "Interval[1, 5] meets Interval[5, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Open boundaries",
+			cql:        "Interval[1, 5) meets Interval(4, 10]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<Decimal>",
+			cql:        "Interval[1.0, 2.0] meets Interval[2.00000001, 3.0]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded open boundaries",
+			cql:        "Interval(null, 5] meets Interval(null, 15)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Interval[1, 5] meets null as Interval<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalMeetsBefore(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Meets before",
+			cql:  "Interval[1, 5] meets before Interval[6, 10]",
+			wantModel: &model.MeetsBefore{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("6", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Meets after is not meets before",
+			cql:        "Interval[6, 10] meets before Interval[1, 5]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Gap between intervals",
+			cql:        "Interval[1, 5] meets before Interval[7, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Date>",
+			cql:        "Interval[@2012-01-01, @2012-01-15] meets before Interval[@2012-01-16, @2012-02-01]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<DateTime> at day precision",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-15T10:00:00.000] meets before day of Interval[@2012-01-16T12:00:00.000, @2012-02-01T12:00:00.000]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval<DateTime> without precision",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-15T10:00:00.000] meets before Interval[@2012-01-16T12:00:00.000, @2012-02-01T12:00:00.000]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Unbounded open boundaries",
+			cql:        "Interval(null, 5] meets before Interval(null, 25]",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalMeetsAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Meets after",
+			cql:  "Interval[6, 10] meets after Interval[1, 5]",
+			wantModel: &model.MeetsAfter{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("6", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("5", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Meets before is not meets after",
+			cql:        "Interval[1, 5] meets after Interval[6, 10]",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval<Long>",
+			cql:        "Interval[6L, 10L] meets after Interval[1L, 5L]",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Unbounded boundaries that can not meet",
+			cql:        "Interval(null, 5] meets after Interval[11, null)",
+			wantResult: newOrFatal(t, false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		// TODO: b/331225778 - Null support handling for Contains operator
+		{
+			name: "On inclusive bound date",
+			cql:  "Interval[@2020-03-25, @2022-04] contains month of @2020-03",
+			wantModel: &model.In{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Boolean),
+					Operands: []model.IExpression{
+						model.NewLiteral("@2020-03", types.Date),
+						&model.Interval{
+							Low:           model.NewLiteral("@2020-03-25", types.Date),
+							High:          model.NewLiteral("@2022-04", types.Date),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Date}),
+						},
+					},
+				},
+				Precision: model.MONTH,
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Point arg null datetime",
+			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2024-03-31T00:00:00.000Z] contains null",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "On inclusive bound datetime",
+			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains month of @2024-03-31T00:00:00.000Z",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "On exclusive bound date",
+			cql:        "Interval(@2020-03-25, @2022-04) contains month of @2020-03",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "On exclusive bound datetime",
+			cql:        "Interval(@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains month of @2024-03-31T00:00:00.000Z",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "On inclusive bound datetime second precision",
+			cql:        "Interval[@2024-03-31T00:00:00.000Z, @2024-03-31T00:00:05.000Z] contains second of @2024-03-31T00:00:00.000Z",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "On exclusive and inclusive bound",
+			cql:        "Interval(@2020-03, @2022-03] contains month of @2020-03",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Insufficient precision date",
+			cql:        "Interval[@2020-03-25, @2020-04] contains day of @2020-03",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Insufficient precision datetime",
+			cql:        "Interval[@2024-03-28T00:00:00.000Z, @2024-03-31T00:00:00.000Z] contains day of @2024-03",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Insufficient precision but for sure false",
+			cql:        "Interval[@2028-03-25, @2020-04] contains day of @2020-03",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null inclusive bound is true",
+			cql:        "Interval[null, @2022-04) contains month of @2020-03",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Null inclusive bound but this is for sure false",
+			cql:        "Interval[null, @2022-04) contains month of @2025-03",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null exclusive bound is null",
+			cql:        "Interval(null, @2022-04) contains month of @2021-03",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null exclusive bound but this is for sure false",
+			cql:        "Interval(null, @2022-04) contains month of @2025-03",
+			wantResult: newOrFatal(t, false),
+		},
+		// No precision
+		{
+			name:       "No included in operator precision: On inclusive bound date",
+			cql:        "Interval[@2020-03-25, @2022-04) contains day of @2020-03-25",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "No included in operator precision: On exclusive bound datetime",
+			cql:        "Interval(@2024-03-31T00:00:00.000Z, @2025-03-31T00:00:00.000Z) contains day of @2024-03-31T00:00:00.000Z",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "No included in operator precision with differing operand precision",
+			cql:        "Interval[@2020-03-25, @2022-04-25) contains @2020-03",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "interval contains integer",
+			cql:        "Interval[0, 100] contains 42",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval does not contain integer",
+			cql:        "Interval[0, 25] contains 42",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval contains integer on bounds",
+			cql:        "Interval[0, 25] contains 25",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval on bounds not inclusive integer",
+			cql:        "Interval[0, 25) contains 25",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Integer before interval bounds not inclusive",
+			cql:        "Interval[0, 25) contains 24",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval not contains integer with null exlusive bounds",
+			cql:        "Interval[0, null) contains 25",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Interval contains double on upper bounds",
+			cql:        "Interval[1.0, 1.5] contains 1.5",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Interval not contains long",
+			cql:        "Interval[1L, 2L] contains 0L",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Interval contains quantity on bounds",
+			cql:        "Interval[1'cm', 2'cm') contains 1'cm'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Functional syntax",
+			cql:        "Contains(Interval[0, 100], 42)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Contains day of datetime on a later time of the end day",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-31T10:00:00.000] contains day of @2012-01-31T12:00:00.000",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Contains datetime on a later time of the end day",
+			cql:        "Interval[@2012-01-01T10:00:00.000, @2012-01-31T10:00:00.000] contains @2012-01-31T12:00:00.000",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Includes point is contains",
+			cql:        "Interval[0, 25) includes 24",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Includes point on exclusive bound",
+			cql:        "Interval[0, 25) includes 25",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Includes day of date",
+			cql:        "Interval[@2012-01-01, @2012-01-31] includes day of @2012-01-31",
+			wantResult: newOrFatal(t, true),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalIntersect(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping intervals",
+			cql:  "Interval[1, 10] intersect Interval[4, 15]",
+			wantModel: &model.Intersect{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("15", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 4),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Boundary openness is preserved",
+			cql:  "Interval[1, 10) intersect Interval(4, 15]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 4),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  false,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Equal boundaries are only inclusive if both are",
+			cql:  "Interval[1, 10) intersect Interval[1, 10]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Included interval",
+			cql:  "Interval[1, 10] intersect Interval[4, 6]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 4),
+				High:          newOrFatal(t, 6),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name:       "Meeting intervals do not intersect",
+			cql:        "Interval[1, 5] intersect Interval[6, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Disjoint intervals",
+			cql:        "Interval[1, 10] intersect Interval[11, 20]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "Decimal intervals",
+			cql:  "Interval[1.0, 10.0] intersect Interval[4.0, 10.0]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 4.0),
+				High:          newOrFatal(t, 10.0),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Decimal},
+			}),
+		},
+		{
+			name: "Date intervals",
+			cql:  "Interval[@2012-01-07, @2012-01-14] intersect Interval[@2012-01-07, @2012-01-10]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, result.Date{Date: time.Date(2012, 1, 7, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+				High:          newOrFatal(t, result.Date{Date: time.Date(2012, 1, 10, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Date},
+			}),
+		},
+		{
+			name: "Unknown end",
+			cql:  "Interval[1, 10] intersect Interval[5, null)",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 5),
+				High:          newOrFatal(t, nil),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Unknown start",
+			cql:  "Interval(null, 5] intersect Interval[3, 10]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, nil),
+				High:          newOrFatal(t, 5),
+				LowInclusive:  false,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Interval[1, 10] intersect null as Interval<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalUnion(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping intervals",
+			cql:  "Interval[1, 10] union Interval[4, 15]",
+			wantModel: &model.Union{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("15", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 15),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Meeting intervals",
+			cql:  "Interval[1, 5] union Interval[6, 10]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Meeting intervals with open boundaries",
+			cql:  "Interval[1, 5) union Interval[5, 10)",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Equal boundaries are inclusive if either is",
+			cql:  "Interval(1, 10) union Interval[1, 5]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name:       "Disjoint intervals",
+			cql:        "Interval[1, 10] union Interval[12, 15]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Open boundaries that do not meet",
+			cql:        "Interval[1, 10) union Interval(10, 15]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "Decimal intervals",
+			cql:  "Interval[1.0, 10.0] union Interval[4.0, 15.0]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1.0),
+				High:          newOrFatal(t, 15.0),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Decimal},
+			}),
+		},
+		{
+			name:       "Null interval",
+			cql:        "null as Interval<Integer> union Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIntervalExcept(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Right overlaps the end of left",
+			cql:  "Interval[1, 10] except Interval[4, 10]",
+			wantModel: &model.Except{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.Interval{PointType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.Interval{
+							Low:           model.NewLiteral("1", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+						&model.Interval{
+							Low:           model.NewLiteral("4", types.Integer),
+							High:          model.NewLiteral("10", types.Integer),
+							LowInclusive:  true,
+							HighInclusive: true,
+							Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 3),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Right overlaps the start of left",
+			cql:  "Interval[1, 10] except Interval[0, 4]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 5),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Boundary openness of left is preserved",
+			cql:  "Interval(0, 10) except Interval[1, 5]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 6),
+				High:          newOrFatal(t, 10),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Right exclusive boundary",
+			cql:  "Interval[1, 10] except Interval[4, 11)",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 3),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Disjoint intervals",
+			cql:  "Interval[1, 4) except Interval[6, 8]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 4),
+				LowInclusive:  true,
+				HighInclusive: false,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name: "Meeting intervals",
+			cql:  "Interval[1, 5] except Interval[6, 8]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1),
+				High:          newOrFatal(t, 5),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Integer},
+			}),
+		},
+		{
+			name:       "Right properly included in left",
+			cql:        "Interval[1, 10] except Interval[3, 7]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Right includes left",
+			cql:        "Interval[3, 7] except Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Same interval",
+			cql:        "Interval[1, 10] except Interval[1, 10]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "Decimal intervals",
+			cql:  "Interval[1.0, 10.0] except Interval[4.0, 10.0]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, 1.0),
+				High:          newOrFatal(t, 3.99999999),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Decimal},
+			}),
+		},
+		{
+			name: "Date intervals",
+			cql:  "Interval[@2012-01-05, @2012-01-15] except Interval[@2012-01-07, @2012-01-15]",
+			wantResult: newOrFatal(t, result.Interval{
+				Low:           newOrFatal(t, result.Date{Date: time.Date(2012, 1, 5, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+				High:          newOrFatal(t, result.Date{Date: time.Date(2012, 1, 6, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+				LowInclusive:  true,
+				HighInclusive: true,
+				StaticType:    &types.Interval{PointType: types.Date},
+			}),
+		},
+		{
+			name:       "Null interval",
+			cql:        "Interval[1, 10] except null as Interval<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping intervals",
+			cql:  "collapse { Interval[1, 5], Interval[3, 7], Interval[12, 19], Interval[7, 10] }",
+			wantModel: &model.Collapse{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.Interval{
+									Low:           model.NewLiteral("1", types.Integer),
+									High:          model.NewLiteral("5", types.Integer),
+									LowInclusive:  true,
+									HighInclusive: true,
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+								},
+								&model.Interval{
+									Low:           model.NewLiteral("3", types.Integer),
+									High:          model.NewLiteral("7", types.Integer),
+									LowInclusive:  true,
+									HighInclusive: true,
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+								},
+								&model.Interval{
+									Low:           model.NewLiteral("12", types.Integer),
+									High:          model.NewLiteral("19", types.Integer),
+									LowInclusive:  true,
+									HighInclusive: true,
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+								},
+								&model.Interval{
+									Low:           model.NewLiteral("7", types.Integer),
+									High:          model.NewLiteral("10", types.Integer),
+									LowInclusive:  true,
+									HighInclusive: true,
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+								},
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+						},
+						&model.As{
+							UnaryExpression: &model.UnaryExpression{
+								Operand:    model.NewLiteral("null", types.Any),
+								Expression: model.ResultType(types.Quantity),
+							},
+							AsTypeSpecifier: types.Quantity,
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 10),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 12),
+						High:          newOrFatal(t, 19),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Meeting intervals",
+			cql:  "collapse { Interval[1, 5], Interval[6, 8] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 8),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Unsorted disjoint intervals",
+			cql:  "collapse { Interval[10, 12], Interval[1, 5] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 5),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 10),
+						High:          newOrFatal(t, 12),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Gap within per",
+			cql:  "collapse { Interval[1, 5], Interval[8, 10] } per 3 '1'",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 10),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Gap larger than per",
+			cql:  "collapse { Interval[1, 5], Interval[9, 10] } per 3 '1'",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 5),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 9),
+						High:          newOrFatal(t, 10),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Null intervals are ignored",
+			cql:  "Collapse({ Interval[1, 5], null, Interval[4, 7] }, null)",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 7),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Decimal open boundary meets closed boundary",
+			cql:  "collapse { Interval[1.0, 2.0), Interval[2.0, 3.0] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1.0),
+						High:          newOrFatal(t, 3.0),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Decimal},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Decimal}},
+			}),
+		},
+		{
+			name: "Date intervals",
+			cql:  "collapse { Interval[@2012-01-01, @2012-01-15], Interval[@2012-01-16, @2012-01-20], Interval[@2012-01-25, @2012-01-30] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2012, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2012, 1, 20, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2012, 1, 25, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2012, 1, 30, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Date}},
+			}),
+		},
+		{
+			name: "Date intervals per days",
+			cql:  "collapse { Interval[@2012-01-01, @2012-01-15], Interval[@2012-01-18, @2012-01-20] } per 3 days",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2012, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2012, 1, 20, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Date}},
+			}),
+		},
+		{
+			name:       "Null list",
+			cql:        "collapse null as List<Interval<Integer>>",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Integer interval",
+			cql:  "expand { Interval[1, 4] }",
+			wantModel: &model.Expand{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+					Operands: []model.IExpression{
+						&model.List{
+							List: []model.IExpression{
+								&model.Interval{
+									Low:           model.NewLiteral("1", types.Integer),
+									High:          model.NewLiteral("4", types.Integer),
+									LowInclusive:  true,
+									HighInclusive: true,
+									Expression:    model.ResultType(&types.Interval{PointType: types.Integer}),
+								},
+							},
+							Expression: model.ResultType(&types.List{ElementType: &types.Interval{PointType: types.Integer}}),
+						},
+						&model.As{
+							UnaryExpression: &model.UnaryExpression{
+								Operand:    model.NewLiteral("null", types.Any),
+								Expression: model.ResultType(types.Quantity),
+							},
+							AsTypeSpecifier: types.Quantity,
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 1),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2),
+						High:          newOrFatal(t, 2),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 3),
+						High:          newOrFatal(t, 3),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 4),
+						High:          newOrFatal(t, 4),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Integer interval per 3",
+			cql:  "expand { Interval[1, 10] } per 3 '1'",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 3),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 4),
+						High:          newOrFatal(t, 6),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 7),
+						High:          newOrFatal(t, 9),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Multiple intervals",
+			cql:  "expand { Interval[1, 2], Interval[5, 6] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1),
+						High:          newOrFatal(t, 1),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2),
+						High:          newOrFatal(t, 2),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 5),
+						High:          newOrFatal(t, 5),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 6),
+						High:          newOrFatal(t, 6),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Date interval per day",
+			cql:  "expand { Interval[@2018-01-01, @2018-01-03] } per day",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2018, 1, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2018, 1, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2018, 1, 3, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2018, 1, 3, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Date}},
+			}),
+		},
+		{
+			name: "Date interval per 2 days",
+			cql:  "expand { Interval[@2018-01-01, @2018-01-04] } per 2 days",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2018, 1, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.Date{Date: time.Date(2018, 1, 3, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.Date{Date: time.Date(2018, 1, 4, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Date},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Date}},
+			}),
+		},
+		{
+			name: "DateTime interval truncated to per day",
+			cql:  "expand { Interval[@2018-01-01T10:00:00.000, @2018-01-02T12:00:00.000] } per day",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.DateTime},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						High:          newOrFatal(t, result.DateTime{Date: time.Date(2018, 1, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.DateTime},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.DateTime}},
+			}),
+		},
+		{
+			name:       "Per finer than the point precision",
+			cql:        "expand { Interval[@T10, @T10] } per minute",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: &types.Interval{PointType: types.Time}}}),
+		},
+		{
+			name:       "Unknown end",
+			cql:        "expand { Interval[1, null) }",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null list",
+			cql:        "expand null as List<Interval<Integer>>",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "Integer interval ending at the maximum Integer",
+			cql:  "expand { Interval[2147483638, 2147483647] } per 5",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2147483638),
+						High:          newOrFatal(t, 2147483642),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2147483643),
+						High:          newOrFatal(t, 2147483647),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Integer interval near the maximum Integer",
+			cql:  "expand { Interval[2147483640, 2147483647] } per 5",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2147483640),
+						High:          newOrFatal(t, 2147483644),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Integer},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Integer}},
+			}),
+		},
+		{
+			name: "Decimal interval defaults to per 1",
+			cql:  "expand { Interval[1.0, 3.0] }",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 1.0),
+						High:          newOrFatal(t, 1.99999999),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Decimal},
+					}),
+					newOrFatal(t, result.Interval{
+						Low:           newOrFatal(t, 2.0),
+						High:          newOrFatal(t, 2.99999999),
+						LowInclusive:  true,
+						HighInclusive: true,
+						StaticType:    &types.Interval{PointType: types.Decimal},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Interval{PointType: types.Decimal}},
+			}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestComparison_Error(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantModel           model.IExpression
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Quantity in interval with not matching lower unit",
+			cql:                 "1'cm' in Interval[1'm', 2'cm']",
+			wantEvalErrContains: "in operator recieved Quantities with differing unit values",
+		},
+		{
+			name:                "Quantity in interval with not matching lower unit",
+			cql:                 "1'cm' in Interval[1'cm', 2'm']",
+			wantEvalErrContains: "in operator recieved Quantities with differing unit values",
+		},
+		{
+			name:                "Expand per zero",
+			cql:                 "expand { Interval[1, 10] } per 0 '1'",
+			wantEvalErrContains: "expand per must be positive",
+		},
+		{
+			name:                "Point from multi-point interval",
+			cql:                 "point from Interval[1, 10]",
+			wantEvalErrContains: "point from requires a unit interval",
 		},
 	}
 	for _, tc := range tests {
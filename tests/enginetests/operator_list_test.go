@@ -18,6 +18,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/cql/interpreter"
 	"github.com/google/cql/model"
@@ -59,6 +60,11 @@ func TestExists(t *testing.T) {
 			cql:        "exists({null})",
 			wantResult: newOrFatal(t, false),
 		},
+		{
+			name:       "List With Null And Non Null",
+			cql:        "exists({null, 1})",
+			wantResult: newOrFatal(t, true),
+		},
 		{
 			name: "Null",
 			cql:  "exists(null)",
@@ -158,6 +164,86 @@ func TestInList(t *testing.T) {
 			cql:        "In(1, {1, 2})",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "null not in list",
+			cql:        "null in {1, 2}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Typed null in list",
+			cql:        "null as Integer in {1, null}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "In null list",
+			cql:        "1 in (null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Quantity in list",
+			cql:        "1.0 'g' in {1 'g', 2 'g'}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Equal quantity in different unit",
+			cql:        "1 'g' in {1000 'mg'}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Contains equal quantity in different unit",
+			cql:        "{1000 'mg'} contains 1 'g'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Uncertain equality returns null",
+			cql:        "@2012 in {@2012-01-01, @2013-01-01}",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Equal element found despite uncertain equality",
+			cql:        "@2012 in {@2012-01-01, @2012}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Contains",
+			cql:        "{'a', 'b'} contains 'b'",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Does not contain",
+			cql:        "{'a', 'b'} contains 'c'",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Contains null",
+			cql:        "{1, null} contains null",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Does not contain null",
+			cql:        "{1, 2} contains null",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null list contains",
+			cql:        "(null as List<String>) contains 'a'",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Functional syntax: Contains",
+			cql:        "Contains({1, 2}, 1)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Uncertainty that may be in the list is null",
+			cql:        "(months between @2020 and @2022) in {12, 20}",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Uncertainty that cannot be in the list is false",
+			cql:        "(months between @2020 and @2022) in {1, 50}",
+			wantResult: newOrFatal(t, false),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -233,6 +319,21 @@ func TestFirst(t *testing.T) {
 			cql:        "First(null)",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "First({'a'}) = 'a'",
+			cql:        "First({'a'})",
+			wantResult: newOrFatal(t, "a"),
+		},
+		{
+			name:       "First({null, 2}) = null",
+			cql:        "First({null, 2})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "First(List<Any>{1, 'a'}) keeps the runtime type",
+			cql:        "First(List<Any>{1, 'a'})",
+			wantResult: newOrFatal(t, 1),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -314,6 +415,21 @@ func TestLast(t *testing.T) {
 			cql:        "Last(null)",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Last({'a'}) = 'a'",
+			cql:        "Last({'a'})",
+			wantResult: newOrFatal(t, "a"),
+		},
+		{
+			name:       "Last({1, null}) = null",
+			cql:        "Last({1, null})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Last(List<Any>{1, 'a'}) keeps the runtime type",
+			cql:        "Last(List<Any>{1, 'a'})",
+			wantResult: newOrFatal(t, "a"),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -468,7 +584,7 @@ func TestListOperatorSingletonFrom_Error(t *testing.T) {
 	}
 }
 
-func TestIndexerList(t *testing.T) {
+func TestToList(t *testing.T) {
 	tests := []struct {
 		name       string
 		cql        string
@@ -476,57 +592,276 @@ func TestIndexerList(t *testing.T) {
 		wantResult result.Value
 	}{
 		{
-			name: "Indexer [] syntax for List<Integer>",
-			cql:  "{1, 2}[1]",
-			wantModel: &model.Indexer{
-				BinaryExpression: &model.BinaryExpression{
-					Expression: model.ResultType(types.Integer),
-					Operands: []model.IExpression{
-						model.NewList([]string{"1", "2"}, types.Integer),
-						model.NewLiteral("1", types.Integer),
+			name: "ToList(5)",
+			cql:  "ToList(5)",
+			wantModel: &model.ToList{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("5", types.Integer),
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 5)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null returns an empty list",
+			cql:        "ToList(null as String)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "List is wrapped in a list",
+			cql:  "ToList({1, 2})",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 1), newOrFatal(t, 2)}, StaticType: &types.List{ElementType: types.Integer}}),
+				},
+				StaticType: &types.List{ElementType: &types.List{ElementType: types.Integer}},
+			}),
+		},
+		{
+			name:       "Scalar is promoted for a List operator",
+			cql:        "Count(5)",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "Scalar is promoted for a List operand",
+			cql:        "{1, 2, 3} includes 2",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name: "Promoted scalar has the list type of the scalar",
+			cql:  "Distinct(3)",
+			wantModel: &model.Distinct{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.ToList{
+						UnaryExpression: &model.UnaryExpression{
+							Operand:    model.NewLiteral("3", types.Integer),
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						},
 					},
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
 				},
 			},
-			wantResult: newOrFatal(t, 2),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 3)}, StaticType: &types.List{ElementType: types.Integer}}),
 		},
 		{
-			name: "Indexer [] syntax for List<String>",
-			cql:  "{'a', 'b'}[1]",
-			wantModel: &model.Indexer{
+			name:       "Promoted list elements have the list type of the elements",
+			cql:        "Flatten({1})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 1)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Duplicate integers",
+			cql:  "Distinct({1, 2, 1})",
+			wantModel: &model.Distinct{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+							model.NewLiteral("2", types.Integer),
+							model.NewLiteral("1", types.Integer),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Duplicate strings keep first occurrence",
+			cql:        "Distinct({'b', 'a', 'b', 'c', 'a'})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "b"), newOrFatal(t, "a"), newOrFatal(t, "c")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Distinct term",
+			cql:        "distinct {'a', 'a'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Equal quantities in different units",
+			cql:        "Distinct({1 'g', 1000 'mg', 2 'g'})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 1, Unit: "g"}), newOrFatal(t, result.Quantity{Value: 2, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Duplicate dates",
+			cql:        "Distinct({@2020-01-01, @2020-01-02, @2020-01-01})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Date{Date: time.Date(2020, time.January, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}), newOrFatal(t, result.Date{Date: time.Date(2020, time.January, 2, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY})}, StaticType: &types.List{ElementType: types.Date}}),
+		},
+		{
+			name:       "Multiple nulls collapse to one",
+			cql:        "Distinct({1, null, 2, null})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, nil), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Already distinct",
+			cql:        "Distinct({1, 2, 3})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "Distinct(List<Integer>{})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null list",
+			cql:        "Distinct(null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Keeps order of first appearance",
+			cql:        "Distinct({3, 1, 3, 2, 1})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(3)), newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of first appearance for Strings",
+			cql:        "Distinct({'c', 'a', 'c', 'b', 'a'})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "c"), newOrFatal(t, "a"), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping lists",
+			cql:  "{1, 2} union {2, 3}",
+			wantModel: &model.Union{
 				BinaryExpression: &model.BinaryExpression{
-					Expression: model.ResultType(types.String),
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
 					Operands: []model.IExpression{
-						model.NewList([]string{"a", "b"}, types.String),
-						model.NewLiteral("1", types.Integer),
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
+						},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
 					},
 				},
 			},
-			wantResult: newOrFatal(t, "b"),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
 		},
 		{
-			name:       "Indexer functional form",
-			cql:        "Indexer({1, 2}, 1)",
-			wantResult: newOrFatal(t, 2),
+			name:       "Union | syntax",
+			cql:        "{1, 2} | {3}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
 		},
 		{
-			name:       "Indexer with index too large",
-			cql:        "{1, 2}[100]",
-			wantResult: newOrFatal(t, nil),
+			name:       "Disjoint lists",
+			cql:        "Union({1, 2}, {3, 4})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3)), newOrFatal(t, int32(4))}, StaticType: &types.List{ElementType: types.Integer}}),
 		},
 		{
-			name:       "Indexer with index smaller than 0",
-			cql:        "{1, 2}[-100]",
-			wantResult: newOrFatal(t, nil),
+			name:       "Duplicate elements",
+			cql:        "{1, 1, 2} union {2, 2}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
 		},
 		{
-			name:       "Indexer on null",
-			cql:        "(null as List<Integer>)[1]",
-			wantResult: newOrFatal(t, nil),
+			name:       "Equivalent quantities",
+			cql:        "{1 'g'} union {1.0 'g', 2 'g'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 1, Unit: "g"}), newOrFatal(t, result.Quantity{Value: 2, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
 		},
 		{
-			name:       "Indexer with null index",
-			cql:        "{1, 2}[null as Integer]",
-			wantResult: newOrFatal(t, nil),
+			name:       "Equal quantities in different units",
+			cql:        "{1 'g'} union {1000 'mg', 2 'g'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 1, Unit: "g"}), newOrFatal(t, result.Quantity{Value: 2, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Nulls collapse",
+			cql:        "{1, null} union {null, 2}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, nil), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "{1, 2} union {}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null left",
+			cql:        "null union {1, 1}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null right",
+			cql:        "{1, 2} union null",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Both null",
+			cql:        "(null as List<Integer>) union (null as List<Integer>)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of first appearance, left then right",
+			cql:        "{3, 1, 3} union {2, 1, 0}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(3)), newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(0))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of first appearance for Strings",
+			cql:        "{'c', 'a'} union {'b', 'c', 'd'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "c"), newOrFatal(t, "a"), newOrFatal(t, "b"), newOrFatal(t, "d")}, StaticType: &types.List{ElementType: types.String}}),
 		},
 	}
 	for _, tc := range tests {
@@ -547,7 +882,1035 @@ func TestIndexerList(t *testing.T) {
 			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
 				t.Errorf("Eval diff (-want +got)\n%v", diff)
 			}
+		})
+	}
+}
 
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping lists",
+			cql:  "{1, 2} intersect {2, 3}",
+			wantModel: &model.Intersect{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
+						},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Disjoint lists",
+			cql:        "Intersect({1, 2}, {3, 4})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Duplicate elements",
+			cql:        "{1, 2, 2, 3} intersect {3, 2, 2}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Equivalent quantities",
+			cql:        "{1 'g', 2 'g'} intersect {1.0 'g'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 1, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Equal quantities in different units",
+			cql:        "{1 'g'} intersect {1000 'mg'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 1, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Nulls match",
+			cql:        "{1, null} intersect {null, 2} as List<Integer>",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, nil)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "{1, 2} intersect List<Integer>{}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null left",
+			cql:        "(null as List<Integer>) intersect {1}",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null right",
+			cql:        "{1} intersect (null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Keeps order of the left operand",
+			cql:        "{4, 3, 2, 3, 1} intersect {1, 2, 3}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(3)), newOrFatal(t, int32(2)), newOrFatal(t, int32(1))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of the left operand for Strings",
+			cql:        "{'c', 'a', 'b'} intersect {'b', 'c'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "c"), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestExcept(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Overlapping lists",
+			cql:  "{1, 2} except {2, 3}",
+			wantModel: &model.Except{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
+						},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Disjoint lists",
+			cql:        "Except({1, 2}, {3, 4})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Duplicate elements",
+			cql:        "{1, 1, 2, 3} except {3}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "All elements removed",
+			cql:        "{2, 3} except {1, 2, 3, 4}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Equivalent quantities",
+			cql:        "{1 'g', 2 'g'} except {1.0 'g'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 2, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Equal quantities in different units",
+			cql:        "{1 'g', 2 'g'} except {1000 'mg'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.Quantity{Value: 2, Unit: "g"})}, StaticType: &types.List{ElementType: types.Quantity}}),
+		},
+		{
+			name:       "Null elements",
+			cql:        "{1, null, null} except {1}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, nil)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null left",
+			cql:        "(null as List<Integer>) except {1}",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null right",
+			cql:        "{1, 2} except (null as List<Integer>)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of the left operand",
+			cql:        "{5, 1, 4, 5, 2, 3} except {4}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(5)), newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Keeps order of the left operand for Strings",
+			cql:        "{'c', 'a', 'd', 'b'} except {'a'}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "c"), newOrFatal(t, "d"), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIncludesList(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Includes",
+			cql:  "{1, 2, 3} includes {1, 3}",
+			wantModel: &model.Includes{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Includes missing element",
+			cql:        "{1, 2} includes {1, 4}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Includes equal lists",
+			cql:        "{1, 2} includes {2, 1}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Includes empty list",
+			cql:        "{1, 2} includes List<Integer>{}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Empty list includes empty list",
+			cql:        "List<Integer>{} includes List<Integer>{}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Includes null element",
+			cql:        "{1, null} includes {null}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Includes null list",
+			cql:        "{1, 2} includes (null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Included in",
+			cql:        "{2} included in {1, 2}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Included in missing element",
+			cql:        "{3} included in {1, 2}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Empty list included in",
+			cql:        "List<Integer>{} included in {1, 2}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Included in null list",
+			cql:        "{1} included in (null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Properly includes",
+			cql:        "{1, 2, 3} properly includes {1, 2}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Properly includes equal lists",
+			cql:        "{1, 2} properly includes {2, 1}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Properly includes empty list",
+			cql:        "{1} properly includes List<Integer>{}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Empty list properly includes empty list",
+			cql:        "List<Integer>{} properly includes List<Integer>{}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Properly included in",
+			cql:        "{1, 2} properly included in {1, 2, 3}",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Properly included in equal lists",
+			cql:        "{1, 2} properly included in {1, 2}",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Properly included in null list",
+			cql:        "{1} properly included in (null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "ProperIncludes functional form",
+			cql:        "ProperIncludes({'a', 'b'}, {'a'})",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ProperIncludedIn functional form",
+			cql:        "ProperIncludedIn({'a', 'b'}, {'a'})",
+			wantResult: newOrFatal(t, false),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Found",
+			cql:  "IndexOf({1, 2}, 2)",
+			wantModel: &model.IndexOf{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+							},
+						},
+						model.NewLiteral("2", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Not found",
+			cql:        "IndexOf({1, 2}, 3)",
+			wantResult: newOrFatal(t, int32(-1)),
+		},
+		{
+			name:       "Duplicate elements return the first index",
+			cql:        "IndexOf({'a', 'b', 'a', 'b'}, 'b')",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Dates",
+			cql:        "IndexOf({@2020-01-01, @2020-01-02}, @2020-01-02)",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Equal quantity in different unit",
+			cql:        "IndexOf({2 'g', 1000 'mg'}, 1 'g')",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Null element matches first null",
+			cql:        "IndexOf({1, null, 2, null}, null)",
+			wantResult: newOrFatal(t, int32(1)),
+		},
+		{
+			name:       "Null element not found",
+			cql:        "IndexOf({1, 2}, null)",
+			wantResult: newOrFatal(t, int32(-1)),
+		},
+		{
+			name:       "Empty list",
+			cql:        "IndexOf({}, 1)",
+			wantResult: newOrFatal(t, int32(-1)),
+		},
+		{
+			name:       "Null list",
+			cql:        "IndexOf(null as List<Integer>, 1)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "First two elements",
+			cql:  "Take({1, 2, 3}, 2)",
+			wantModel: &model.Take{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+						model.NewLiteral("2", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "N larger than the list",
+			cql:        "Take({1, 2, 3}, 5)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Zero",
+			cql:        "Take({1, 2, 3}, 0)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Negative",
+			cql:        "Take({1, 2, 3}, -1)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null N",
+			cql:        "Take({1, 2, 3}, null as Integer)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "Take(List<Integer>{}, 2)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null list",
+			cql:        "Take(null as List<Integer>, 2)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Skip two elements",
+			cql:  "Skip({1, 2, 3}, 2)",
+			wantModel: &model.Skip{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+							},
+						},
+						model.NewLiteral("2", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "N larger than the list",
+			cql:        "Skip({1, 2, 3}, 5)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Zero",
+			cql:        "Skip({1, 2, 3}, 0)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Negative",
+			cql:        "Skip({1, 2, 3}, -1)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null N",
+			cql:        "Skip({1, 2, 3}, null as Integer)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "Skip(List<Integer>{}, 2)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null list",
+			cql:        "Skip(null as List<Integer>, 2)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Middle of the list",
+			cql:  "Slice({1, 2, 3, 4}, 1, 3)",
+			wantModel: &model.Slice{
+				NaryExpression: &model.NaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operands: []model.IExpression{
+						&model.List{
+							Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+							List: []model.IExpression{
+								model.NewLiteral("1", types.Integer),
+								model.NewLiteral("2", types.Integer),
+								model.NewLiteral("3", types.Integer),
+								model.NewLiteral("4", types.Integer),
+							},
+						},
+						model.NewLiteral("1", types.Integer),
+						model.NewLiteral("3", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Whole list",
+			cql:        "Slice({1, 2, 3}, 0, 3)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "End past the list is clamped",
+			cql:        "Slice({1, 2, 3}, 1, 10)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Negative start is clamped",
+			cql:        "Slice({1, 2, 3}, -2, 2)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Start past the list",
+			cql:        "Slice({1, 2, 3}, 5, 10)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Reversed bounds",
+			cql:        "Slice({1, 2, 3}, 2, 1)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Equal bounds",
+			cql:        "Slice({1, 2, 3}, 1, 1)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null start defaults to 0",
+			cql:        "Slice({1, 2, 3}, null, 2)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null end defaults to the length",
+			cql:        "Slice({1, 2, 3}, 1, null)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null elements are kept",
+			cql:        "Slice({1, null, 3}, 1, 3)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, nil), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null list",
+			cql:        "Slice(null as List<Integer>, 0, 1)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestTail(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Multiple elements",
+			cql:  "Tail({1, 2, 3})",
+			wantModel: &model.Tail{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+						List: []model.IExpression{
+							model.NewLiteral("1", types.Integer),
+							model.NewLiteral("2", types.Integer),
+							model.NewLiteral("3", types.Integer),
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Single element",
+			cql:        "Tail({1})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null elements are kept",
+			cql:        "Tail({1, null, 2})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, nil), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty list",
+			cql:        "Tail(List<Integer>{})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null list",
+			cql:        "Tail(null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Nested lists",
+			cql:  "Flatten({{1, 2}, {3}})",
+			wantModel: &model.Flatten{
+				UnaryExpression: &model.UnaryExpression{
+					Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+					Operand: &model.List{
+						Expression: model.ResultType(&types.List{ElementType: &types.List{ElementType: types.Integer}}),
+						List: []model.IExpression{
+							&model.List{
+								Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+								List: []model.IExpression{
+									model.NewLiteral("1", types.Integer),
+									model.NewLiteral("2", types.Integer),
+								},
+							},
+							&model.List{
+								Expression: model.ResultType(&types.List{ElementType: types.Integer}),
+								List: []model.IExpression{
+									model.NewLiteral("3", types.Integer),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2)), newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Only one level is flattened",
+			cql:        "Flatten({{{1}, {2}}, {{3}}})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1))}, StaticType: &types.List{ElementType: types.Integer}}), newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}), newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(3))}, StaticType: &types.List{ElementType: types.Integer}})}, StaticType: &types.List{ElementType: &types.List{ElementType: types.Integer}}}),
+		},
+		{
+			name:       "Flatten term",
+			cql:        "flatten {{'a'}, {'b', 'c'}}",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a"), newOrFatal(t, "b"), newOrFatal(t, "c")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Empty outer list",
+			cql:        "Flatten(List<List<Integer>>{})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Empty inner lists",
+			cql:        "Flatten({{1}, List<Integer>{}, {2}})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Inner lists with nulls",
+			cql:        "Flatten({{1, null}, {null, 2}})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, nil), newOrFatal(t, nil), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null inner list",
+			cql:        "Flatten({{1}, null, {2}})",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, int32(1)), newOrFatal(t, int32(2))}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null input",
+			cql:        "Flatten(null as List<List<Integer>>)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIndexerList(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Indexer [] syntax for List<Integer>",
+			cql:  "{1, 2}[1]",
+			wantModel: &model.Indexer{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.Integer),
+					Operands: []model.IExpression{
+						model.NewList([]string{"1", "2"}, types.Integer),
+						model.NewLiteral("1", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name: "Indexer [] syntax for List<String>",
+			cql:  "{'a', 'b'}[1]",
+			wantModel: &model.Indexer{
+				BinaryExpression: &model.BinaryExpression{
+					Expression: model.ResultType(types.String),
+					Operands: []model.IExpression{
+						model.NewList([]string{"a", "b"}, types.String),
+						model.NewLiteral("1", types.Integer),
+					},
+				},
+			},
+			wantResult: newOrFatal(t, "b"),
+		},
+		{
+			name:       "Indexer functional form",
+			cql:        "Indexer({1, 2}, 1)",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Indexer first element",
+			cql:        "{1, 2}[0]",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "Indexer just past the end",
+			cql:        "{1, 2}[2]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer null element",
+			cql:        "{1, null}[1]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer with index too large",
+			cql:        "{1, 2}[100]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer with index smaller than 0",
+			cql:        "{1, 2}[-100]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer on null",
+			cql:        "(null as List<Integer>)[1]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer with null index",
+			cql:        "{1, 2}[null as Integer]",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+
+		})
+	}
+}
+
+func TestLengthList(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "List",
+			cql:        "Length({1, 2, 3})",
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "List with nulls",
+			cql:        "Length({1, null, null})",
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "Empty list",
+			cql:        "Length({})",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Null list",
+			cql:        "Length(null as List<Integer>)",
+			wantResult: newOrFatal(t, 0),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
 		})
 	}
 }
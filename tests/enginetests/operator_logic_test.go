@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/cql/interpreter"
@@ -332,3 +333,152 @@ func TestNot(t *testing.T) {
 		})
 	}
 }
+
+func TestLogicOperators_TruthTable(t *testing.T) {
+	operands := []string{"true", "false", "null"}
+	tests := []struct {
+		op string
+		// want is indexed by [left][right] in the order of operands. A nil entry is a null result.
+		want [3][3]any
+	}{
+		{
+			op: "and",
+			want: [3][3]any{
+				{true, false, nil},
+				{false, false, false},
+				{nil, false, nil},
+			},
+		},
+		{
+			op: "or",
+			want: [3][3]any{
+				{true, true, true},
+				{true, false, nil},
+				{true, nil, nil},
+			},
+		},
+		{
+			op: "xor",
+			want: [3][3]any{
+				{false, true, nil},
+				{true, false, nil},
+				{nil, nil, nil},
+			},
+		},
+		{
+			op: "implies",
+			want: [3][3]any{
+				{true, false, nil},
+				{true, true, true},
+				{true, nil, nil},
+			},
+		},
+	}
+	for _, tc := range tests {
+		for li, l := range operands {
+			for ri, r := range operands {
+				cql := l + " " + tc.op + " " + r
+				t.Run(cql, func(t *testing.T) {
+					p := newFHIRParser(t)
+					parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, cql), parser.Config{})
+					if err != nil {
+						t.Fatalf("Parse returned unexpected error: %v", err)
+					}
+
+					results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+					if err != nil {
+						t.Fatalf("Eval returned unexpected error: %v", err)
+					}
+					if diff := cmp.Diff(newOrFatal(t, tc.want[li][ri]), getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+						t.Errorf("Eval diff (-want +got)\n%v", diff)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestLogicOperators_ShortCircuit(t *testing.T) {
+	// singleton from {1, 2} returns an error if it is evaluated, so these tests only pass if the
+	// right operand is skipped.
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "false and error",
+			cql:        "false and (singleton from {1, 2} = 1)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "true or error",
+			cql:        "true or (singleton from {1, 2} = 1)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "false implies error",
+			cql:        "false implies (singleton from {1, 2} = 1)",
+			wantResult: newOrFatal(t, true),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestLogicOperators_EvalErrors(t *testing.T) {
+	// The right operand is evaluated when the left operand does not determine the result.
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "true and error",
+			cql:                 "true and (singleton from {1, 2} = 1)",
+			wantEvalErrContains: "singleton from requires a list of length 0 or 1",
+		},
+		{
+			name:                "null or error",
+			cql:                 "null or (singleton from {1, 2} = 1)",
+			wantEvalErrContains: "singleton from requires a list of length 0 or 1",
+		},
+		{
+			name:                "false xor error",
+			cql:                 "false xor (singleton from {1, 2} = 1)",
+			wantEvalErrContains: "singleton from requires a list of length 0 or 1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
@@ -104,6 +104,31 @@ func TestCoalesce(t *testing.T) {
 			cql:        "Coalesce({null, 1})",
 			wantResult: newOrFatal(t, 1),
 		},
+		{
+			name:       "Coalesce({null, null})",
+			cql:        "Coalesce({null as Integer, null})",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Coalesce({null, 'a', 'b'})",
+			cql:        "Coalesce({null, 'a', 'b'})",
+			wantResult: newOrFatal(t, "a"),
+		},
+		{
+			name:       "Coalesce(null as List<Integer>)",
+			cql:        "Coalesce(null as List<Integer>)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Coalesce short circuits later operands",
+			cql:        "Coalesce(null, 1, singleton from {1, 2})",
+			wantResult: newOrFatal(t, 1),
+		},
+		{
+			name:       "Coalesce short circuits with first operand",
+			cql:        "Coalesce('a', Message('b', true, 'c', 'Error', 'not evaluated'))",
+			wantResult: newOrFatal(t, "a"),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -207,6 +232,56 @@ func TestIsNullogical(t *testing.T) {
 			cql:        "IsFalse(false)",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "IsNull(true)",
+			cql:        "IsNull(true)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "IsNull({})",
+			cql:        "IsNull({})",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "1 is not null",
+			cql:        "1 is not null",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "IsTrue(false)",
+			cql:        "IsTrue(false)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "IsTrue(null)",
+			cql:        "IsTrue(null)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "null is not true",
+			cql:        "null is not true",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "IsFalse(true)",
+			cql:        "IsFalse(true)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "IsFalse(null as Boolean)",
+			cql:        "IsFalse(null as Boolean)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "is true differs from equality",
+			cql:        "(1 = null) is true",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "equality with null is null",
+			cql:        "(1 = null) = true",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
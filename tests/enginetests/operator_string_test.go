@@ -16,6 +16,7 @@ package enginetests
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/cql/interpreter"
@@ -97,6 +98,31 @@ func TestConcatenate(t *testing.T) {
 			cql:        "null & 'a'",
 			wantResult: newOrFatal(t, "a"),
 		},
+		{
+			name:       "concatenate using & with both inputs null is empty string",
+			cql:        "(null as String) & (null as String)",
+			wantResult: newOrFatal(t, ""),
+		},
+		{
+			name:       "concatenate using & treats null as empty string in a chain",
+			cql:        "'a' & (null as String) & 'c'",
+			wantResult: newOrFatal(t, "ac"),
+		},
+		{
+			name:       "concatenate using + with both inputs null",
+			cql:        "(null as String) + (null as String)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "concatenate using + propagates null in a chain",
+			cql:        "'a' + (null as String) + 'c'",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Concatenate function propagates null",
+			cql:        "Concatenate('a', null)",
+			wantResult: newOrFatal(t, nil),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -202,6 +228,66 @@ func TestToString(t *testing.T) {
 			cql:        "ToString(@T12:01:00)",
 			wantResult: newOrFatal(t, "12:01:00"),
 		},
+		{
+			name:       "ToString(1.0) keeps one decimal place",
+			cql:        "ToString(1.0)",
+			wantResult: newOrFatal(t, "1.0"),
+		},
+		{
+			name:       "ToString(-0.125)",
+			cql:        "ToString(-0.125)",
+			wantResult: newOrFatal(t, "-0.125"),
+		},
+		{
+			name:       "ToString(1.123456789) rounds to eight decimal places",
+			cql:        "ToString(1.123456789)",
+			wantResult: newOrFatal(t, "1.12345679"),
+		},
+		{
+			name:       "ToString(-0.000000001) rounds to zero without a sign",
+			cql:        "ToString(-0.000000001)",
+			wantResult: newOrFatal(t, "0.0"),
+		},
+		{
+			name:       "ToString(-0.0)",
+			cql:        "ToString(-0.0)",
+			wantResult: newOrFatal(t, "0.0"),
+		},
+		{
+			name:       "ToString(5 'mg/dL')",
+			cql:        "ToString(5 'mg/dL')",
+			wantResult: newOrFatal(t, "5 'mg/dL'"),
+		},
+		{
+			name:       "ToString(@2022-01)",
+			cql:        "ToString(@2022-01)",
+			wantResult: newOrFatal(t, "2022-01"),
+		},
+		{
+			name:       "ToString(@2022-01-03T12:00:00.000-07:00)",
+			cql:        "ToString(@2022-01-03T12:00:00.000-07:00)",
+			wantResult: newOrFatal(t, "2022-01-03T12:00:00.000-07:00"),
+		},
+		{
+			name:       "ToString(@T12)",
+			cql:        "ToString(@T12)",
+			wantResult: newOrFatal(t, "12"),
+		},
+		{
+			name:       "ToString(Code)",
+			cql:        "ToString(Code{code: '8480-6', system: 'http://loinc.org', display: 'Systolic'})",
+			wantResult: newOrFatal(t, "Code { code: '8480-6', system: 'http://loinc.org', display: 'Systolic' }"),
+		},
+		{
+			name:       "ToString(Code) with version",
+			cql:        "ToString(Code{code: 'a', system: 'b', version: '1'})",
+			wantResult: newOrFatal(t, "Code { code: 'a', system: 'b', version: '1' }"),
+		},
+		{
+			name:       "ToString(null as Code)",
+			cql:        "ToString(null as Code)",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "ToString(null as Date)",
 			cql:        "ToString(null as Date)",
@@ -283,6 +369,31 @@ func TestSplit(t *testing.T) {
 			cql:        "Split('a//b', '//')",
 			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a"), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
 		},
+		{
+			name:       "Split with consecutive seperators",
+			cql:        "Split('a,,b', ',')",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a"), newOrFatal(t, ""), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Split empty string",
+			cql:        "Split('', ',')",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Split with leading and trailing seperators",
+			cql:        "Split('::a::b::', '::')",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, ""), newOrFatal(t, "a"), newOrFatal(t, "b"), newOrFatal(t, "")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Split with consecutive multi-character seperators",
+			cql:        "Split('a::::b', '::')",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a"), newOrFatal(t, ""), newOrFatal(t, "b")}, StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Split seperator is not a regular expression",
+			cql:        "Split('a.b|c.*d', '.*')",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, "a.b|c"), newOrFatal(t, "d")}, StaticType: &types.List{ElementType: types.String}}),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -363,9 +474,39 @@ func TestCombine(t *testing.T) {
 			wantResult: newOrFatal(t, "ABC"),
 		},
 		{
-			name:       "Combine with list of nulls",
+			name:       "Combine with list of nulls is null",
 			cql:        "Combine({null as String, null as String})",
-			wantResult: newOrFatal(t, ""),
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Combine with separator skips null elements",
+			cql:        "Combine({'A', null, 'B', null}, ', ')",
+			wantResult: newOrFatal(t, "A, B"),
+		},
+		{
+			name:       "Combine with empty string separator",
+			cql:        "Combine({'A', 'B'}, '')",
+			wantResult: newOrFatal(t, "AB"),
+		},
+		{
+			name:       "Combine with empty string elements",
+			cql:        "Combine({'A', '', 'B'}, '-')",
+			wantResult: newOrFatal(t, "A--B"),
+		},
+		{
+			name:       "Combine Split round trip with multi-character seperator",
+			cql:        "Combine(Split('a::b::::c', '::'), '::')",
+			wantResult: newOrFatal(t, "a::b::::c"),
+		},
+		{
+			name:       "Combine Split round trip with leading and trailing seperators",
+			cql:        "Combine(Split('::a::b::', '::'), '::')",
+			wantResult: newOrFatal(t, "::a::b::"),
+		},
+		{
+			name:       "Combine Split round trip with regular expression characters",
+			cql:        "Combine(Split('a.b|c.*d', '.*'), '.*')",
+			wantResult: newOrFatal(t, "a.b|c.*d"),
 		},
 	}
 	for _, tc := range tests {
@@ -425,6 +566,26 @@ func TestIndexerString(t *testing.T) {
 			},
 			wantResult: newOrFatal(t, "b"),
 		},
+		{
+			name:       "Indexer first character",
+			cql:        "'abc'[0]",
+			wantResult: newOrFatal(t, "a"),
+		},
+		{
+			name:       "Indexer just past the end",
+			cql:        "'abc'[3]",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Indexer counts characters not bytes",
+			cql:        "'äb'[1]",
+			wantResult: newOrFatal(t, "b"),
+		},
+		{
+			name:       "Indexer past the end of multi-byte string",
+			cql:        "'äb'[2]",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "Indexer with index too large",
 			cql:        "'abc'[100]",
@@ -467,3 +628,795 @@ func TestIndexerString(t *testing.T) {
 		})
 	}
 }
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Full match",
+			cql:  "Matches('1234', '\\\\d+')",
+			wantModel: &model.Matches{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("1234", types.String),
+						model.NewLiteral("\\d+", types.String),
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Partial match is false",
+			cql:        "Matches('abc1234', '\\\\d+')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Explicitly anchored pattern",
+			cql:        "Matches('abc', '^abc$')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Alternation matches whole string",
+			cql:        "Matches('ab', 'a|ab')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Case sensitive",
+			cql:        "Matches('ABC', 'abc')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Null argument",
+			cql:        "Matches(null, 'abc')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null pattern",
+			cql:        "Matches('abc', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestReplaceMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "Replace all occurrences",
+			cql:        "ReplaceMatches('Who put the bop in the bop', 'bop', 'bang')",
+			wantResult: newOrFatal(t, "Who put the bang in the bang"),
+		},
+		{
+			name:       "Capture group substitution",
+			cql:        "ReplaceMatches('John Smith', '(\\\\w+) (\\\\w+)', '$2, $1')",
+			wantResult: newOrFatal(t, "Smith, John"),
+		},
+		{
+			name:       "Capture group followed by text",
+			cql:        "ReplaceMatches('abc', '(b)', '$1x')",
+			wantResult: newOrFatal(t, "abxc"),
+		},
+		{
+			name:       "Escaped dollar sign",
+			cql:        "ReplaceMatches('a b', '\\\\s', '\\\\$')",
+			wantResult: newOrFatal(t, "a$b"),
+		},
+		{
+			name:       "No match",
+			cql:        "ReplaceMatches('abc', 'z', 'y')",
+			wantResult: newOrFatal(t, "abc"),
+		},
+		{
+			name:       "Null argument",
+			cql:        "ReplaceMatches(null, 'a', 'b')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null pattern",
+			cql:        "ReplaceMatches('abc', null, 'b')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null substitution",
+			cql:        "ReplaceMatches('abc', 'a', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestRegex_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		cql                 string
+		wantEvalErrContains string
+	}{
+		{
+			name:                "Matches invalid pattern",
+			cql:                 "Matches('abc', '(')",
+			wantEvalErrContains: "Matches got an invalid regular expression: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name:                "Matches pattern that is only valid when grouped",
+			cql:                 "Matches('a', 'a)|(b')",
+			wantEvalErrContains: "Matches got an invalid regular expression: error parsing regexp: unexpected ): `a)|(b`",
+		},
+		{
+			name:                "ReplaceMatches invalid pattern",
+			cql:                 "ReplaceMatches('abc', '[', 'b')",
+			wantEvalErrContains: "ReplaceMatches got an invalid regular expression",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			_, err = interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err == nil {
+				t.Fatalf("Evaluate Expression expected an error to be returned, got nil instead")
+			}
+			if !strings.Contains(err.Error(), tc.wantEvalErrContains) {
+				t.Errorf("Unexpected evaluation error contents got (%v) want (%v)", err.Error(), tc.wantEvalErrContains)
+			}
+		})
+	}
+}
+
+func TestPositionOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Found",
+			cql:  "PositionOf('hi', 'Say hi to Ohio!')",
+			wantModel: &model.PositionOf{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("hi", types.String),
+						model.NewLiteral("Say hi to Ohio!", types.String),
+					},
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+			wantResult: newOrFatal(t, 4),
+		},
+		{
+			name:       "Found at start",
+			cql:        "PositionOf('a', 'abc')",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Not found",
+			cql:        "PositionOf('z', 'abc')",
+			wantResult: newOrFatal(t, -1),
+		},
+		{
+			name:       "Empty pattern",
+			cql:        "PositionOf('', 'abc')",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Counts characters not bytes",
+			cql:        "PositionOf('c', 'äbc')",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Null pattern",
+			cql:        "PositionOf(null, 'abc')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null argument",
+			cql:        "PositionOf('a', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestLastPositionOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "Found",
+			cql:        "LastPositionOf('hi', 'Say hi to Ohio!')",
+			wantResult: newOrFatal(t, 11),
+		},
+		{
+			name:       "Single occurrence",
+			cql:        "LastPositionOf('a', 'abc')",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Not found",
+			cql:        "LastPositionOf('z', 'abc')",
+			wantResult: newOrFatal(t, -1),
+		},
+		{
+			name:       "Empty pattern",
+			cql:        "LastPositionOf('', 'abc')",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Null pattern",
+			cql:        "LastPositionOf(null, 'abc')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null argument",
+			cql:        "LastPositionOf('a', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestStartsWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Prefix",
+			cql:  "StartsWith('Breathe deep', 'Bre')",
+			wantModel: &model.StartsWith{
+				BinaryExpression: &model.BinaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("Breathe deep", types.String),
+						model.NewLiteral("Bre", types.String),
+					},
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Exact match",
+			cql:        "StartsWith('abc', 'abc')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Not a prefix",
+			cql:        "StartsWith('abc', 'bc')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Case sensitive",
+			cql:        "StartsWith('Breathe deep', 'bre')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Prefix longer than argument",
+			cql:        "StartsWith('ab', 'abc')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Empty prefix",
+			cql:        "StartsWith('abc', '')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Null argument",
+			cql:        "StartsWith(null, 'a')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null prefix",
+			cql:        "StartsWith('abc', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestEndsWith(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "Suffix",
+			cql:        "EndsWith('Chris Schuler is the man!!', 'n!!')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Exact match",
+			cql:        "EndsWith('abc', 'abc')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Not a suffix",
+			cql:        "EndsWith('Chris Schuler is the man!!', 'n!')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Case sensitive",
+			cql:        "EndsWith('abC', 'bc')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Empty suffix",
+			cql:        "EndsWith('abc', '')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Null argument",
+			cql:        "EndsWith(null, 'a')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null suffix",
+			cql:        "EndsWith('abc', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestUpper(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Mixed case",
+			cql:  "Upper('aBc')",
+			wantModel: &model.Upper{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("aBc", types.String),
+					Expression: model.ResultType(types.String),
+				},
+			},
+			wantResult: newOrFatal(t, "ABC"),
+		},
+		{
+			name:       "Internal spaces",
+			cql:        "Upper('hello big world')",
+			wantResult: newOrFatal(t, "HELLO BIG WORLD"),
+		},
+		{
+			name:       "Unicode uses simple case mapping",
+			cql:        "Upper('straße é')",
+			wantResult: newOrFatal(t, "STRAßE É"),
+		},
+		{
+			name:       "Empty",
+			cql:        "Upper('')",
+			wantResult: newOrFatal(t, ""),
+		},
+		{
+			name:       "Null",
+			cql:        "Upper(null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestLower(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name:       "Mixed case",
+			cql:        "Lower('aBc')",
+			wantResult: newOrFatal(t, "abc"),
+		},
+		{
+			name:       "Internal spaces",
+			cql:        "Lower('HELLO Big World')",
+			wantResult: newOrFatal(t, "hello big world"),
+		},
+		{
+			name:       "Unicode",
+			cql:        "Lower('ÉCOLE')",
+			wantResult: newOrFatal(t, "école"),
+		},
+		{
+			name:       "Empty",
+			cql:        "Lower('')",
+			wantResult: newOrFatal(t, ""),
+		},
+		{
+			name:       "Null",
+			cql:        "Lower(null)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+// CQL has no Trim operator, leading and trailing whitespace is removed with ReplaceMatches.
+func TestTrimWithReplaceMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name:       "Leading and trailing spaces",
+			cql:        "ReplaceMatches('  abc  ', '^\\\\s+|\\\\s+$', '')",
+			wantResult: newOrFatal(t, "abc"),
+		},
+		{
+			name:       "Internal spaces are kept",
+			cql:        "ReplaceMatches('  a b  c ', '^\\\\s+|\\\\s+$', '')",
+			wantResult: newOrFatal(t, "a b  c"),
+		},
+		{
+			name:       "Tabs and newlines",
+			cql:        "ReplaceMatches('\\t\\nabc\\n', '^\\\\s+|\\\\s+$', '')",
+			wantResult: newOrFatal(t, "abc"),
+		},
+		{
+			name:       "Only whitespace",
+			cql:        "ReplaceMatches('   ', '^\\\\s+|\\\\s+$', '')",
+			wantResult: newOrFatal(t, ""),
+		},
+		{
+			name:       "Null",
+			cql:        "ReplaceMatches(null, '^\\\\s+|\\\\s+$', '')",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Start and length",
+			cql:  "Substring('abcdef', 1, 3)",
+			wantModel: &model.Substring{
+				NaryExpression: &model.NaryExpression{
+					Operands: []model.IExpression{
+						model.NewLiteral("abcdef", types.String),
+						model.NewLiteral("1", types.Integer),
+						model.NewLiteral("3", types.Integer),
+					},
+					Expression: model.ResultType(types.String),
+				},
+			},
+			wantResult: newOrFatal(t, "bcd"),
+		},
+		{
+			name:       "Start only",
+			cql:        "Substring('abcdef', 2)",
+			wantResult: newOrFatal(t, "cdef"),
+		},
+		{
+			name:       "Start at zero",
+			cql:        "Substring('ab', 0)",
+			wantResult: newOrFatal(t, "ab"),
+		},
+		{
+			name:       "Length past end is clamped",
+			cql:        "Substring('abc', 1, 10)",
+			wantResult: newOrFatal(t, "bc"),
+		},
+		{
+			name:       "Zero length",
+			cql:        "Substring('abc', 1, 0)",
+			wantResult: newOrFatal(t, ""),
+		},
+		{
+			name:       "Counts characters not bytes",
+			cql:        "Substring('äbc', 1, 1)",
+			wantResult: newOrFatal(t, "b"),
+		},
+		{
+			name:       "Start equal to length",
+			cql:        "Substring('ab', 2)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Start past end",
+			cql:        "Substring('ab', 5, 1)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Negative start",
+			cql:        "Substring('ab', -1)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Negative length",
+			cql:        "Substring('ab', 0, -1)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null string",
+			cql:        "Substring(null, 1)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null start",
+			cql:        "Substring('ab', null)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null length returns rest of string",
+			cql:        "Substring('abc', 1, null)",
+			wantResult: newOrFatal(t, "bc"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestLengthString(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "String",
+			cql:  "Length('abc')",
+			wantModel: &model.Length{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("abc", types.String),
+					Expression: model.ResultType(types.Integer),
+				},
+			},
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "Empty string",
+			cql:        "Length('')",
+			wantResult: newOrFatal(t, 0),
+		},
+		{
+			name:       "Counts characters not bytes",
+			cql:        "Length('äb')",
+			wantResult: newOrFatal(t, 2),
+		},
+		{
+			name:       "Null string",
+			cql:        "Length(null as String)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
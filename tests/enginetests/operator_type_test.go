@@ -93,6 +93,51 @@ func TestAs(t *testing.T) {
 			cql:        "4 as Choice<String, Decimal>",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Any As Integer downcast",
+			cql:        "(4 as Any) as Integer",
+			wantResult: newOrFatal(t, 4),
+		},
+		{
+			name:       "Any As Integer failed downcast Null",
+			cql:        "('a' as Any) as Integer",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Patient As DomainResource upcast",
+			cql:        "(First([Patient]) as FHIR.DomainResource) is not null",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Patient As Observation Null",
+			cql:        "First([Patient]) as FHIR.Observation",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "List<Any> As List<Integer>",
+			cql:        "List<Any>{1, 2} as List<Integer>",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 1), newOrFatal(t, 2)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "List<Any> with null As List<Integer>",
+			cql:        "List<Any>{1, null} as List<Integer>",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 1), newOrFatal(t, nil)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Mixed List<Any> As List<Integer> Null",
+			cql:        "List<Any>{1, 'a'} as List<Integer>",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Interval As Interval<Integer>",
+			cql:        "(Interval[1, 2] as Any) as Interval<Integer>",
+			wantResult: newOrFatal(t, result.Interval{Low: newOrFatal(t, 1), High: newOrFatal(t, 2), LowInclusive: true, HighInclusive: true, StaticType: &types.Interval{PointType: types.Integer}}),
+		},
+		{
+			name:       "Interval As Interval<String> Null",
+			cql:        "(Interval[1, 2] as Any) as Interval<String>",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name: "Strict cast Integer as Any",
 			cql:  "cast 4 as Any",
@@ -155,6 +200,16 @@ func TestAs_EvalErrors(t *testing.T) {
 			cql:                 "cast 4 as Choice<String, Decimal>",
 			wantEvalErrContains: "cannot strict cast",
 		},
+		{
+			name:                "Strict failed downcast",
+			cql:                 "cast ('a' as Any) as Integer",
+			wantEvalErrContains: "cannot strict cast",
+		},
+		{
+			name:                "Strict mixed List<Any> As List<Integer>",
+			cql:                 "cast List<Any>{1, 'a'} as List<Integer>",
+			wantEvalErrContains: "cannot strict cast",
+		},
 	}
 
 	for _, tc := range tests {
@@ -214,6 +269,26 @@ func TestToDate(t *testing.T) {
 			cql:        "ToDate(@2024-03-31)",
 			wantResult: newOrFatal(t, result.Date{Date: time.Date(2024, time.March, 31, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.DAY}),
 		},
+		{
+			name:       "Partial String",
+			cql:        "ToDate('2024-03')",
+			wantResult: newOrFatal(t, result.Date{Date: time.Date(2024, time.March, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
+		},
+		{
+			name:       "Invalid String",
+			cql:        "ToDate('garbage')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Out of range String",
+			cql:        "ToDate('2024-13-01')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Empty String",
+			cql:        "ToDate('')",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "Null",
 			cql:        "ToDate(null as String)", // as String necessary to prevent ambiguous match.
@@ -270,6 +345,31 @@ func TestToDateTime(t *testing.T) {
 			cql:        "ToDateTime('2024-03-31T01:20:30.101-07:00')",
 			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, time.March, 31, 1, 20, 30, 101e6, time.FixedZone("", -25200)), Precision: model.MILLISECOND}),
 		},
+		{
+			name:       "Partial String",
+			cql:        "ToDateTime('2024-03')",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, time.March, 1, 0, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MONTH}),
+		},
+		{
+			name:       "Hour String",
+			cql:        "ToDateTime('2024-03-31T10')",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, time.March, 31, 10, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.HOUR}),
+		},
+		{
+			name:       "String with half hour offset",
+			cql:        "ToDateTime('2024-03-31T10:30:00+05:30')",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, time.March, 31, 10, 30, 0, 0, time.FixedZone("", 5*60*60+30*60)), Precision: model.SECOND}),
+		},
+		{
+			name:       "String with Z offset",
+			cql:        "ToDateTime('2024-03-31T10:30:00Z')",
+			wantResult: newOrFatal(t, result.DateTime{Date: time.Date(2024, time.March, 31, 10, 30, 0, 0, time.UTC), Precision: model.SECOND}),
+		},
+		{
+			name:       "Invalid String",
+			cql:        "ToDateTime('garbage')",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "DateTime",
 			cql:        "ToDateTime(@2024-03-31T01:20:30.101-07:00)",
@@ -298,6 +398,93 @@ func TestToDateTime(t *testing.T) {
 	}
 }
 
+func TestToTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "String",
+			cql:  "ToTime('10:30:15.500')",
+			wantModel: &model.ToTime{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("10:30:15.500", types.String),
+					Expression: model.ResultType(types.Time),
+				},
+			},
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 30, 15, 500e6, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "Partial String",
+			cql:        "ToTime('10')",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.HOUR}),
+		},
+		{
+			name:       "Minute String",
+			cql:        "ToTime('10:30')",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "Time",
+			cql:        "ToTime(@T10:30)",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+		},
+		{
+			name:       "String with leading T",
+			cql:        "ToTime('T14:30:00.0')",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 14, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "String with timezone offset ignores offset",
+			cql:        "ToTime('T14:30:00.0-05:45')",
+			wantResult: newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 14, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MILLISECOND}),
+		},
+		{
+			name:       "Malformed String",
+			cql:        "ToTime('T14-30-00.0')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String",
+			cql:        "ToTime('garbage')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Out of range String",
+			cql:        "ToTime('25:00')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Null",
+			cql:        "ToTime(null as String)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestToDecimal(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -372,6 +559,11 @@ func TestToDecimal(t *testing.T) {
 			cql:        "ToDecimal('-.4')",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name:       "Invalid String Non Numeric",
+			cql:        "ToDecimal('abc')",
+			wantResult: newOrFatal(t, nil),
+		},
 		{
 			name:       "Invalid Empty String",
 			cql:        "ToDecimal('')",
@@ -400,7 +592,7 @@ func TestToDecimal(t *testing.T) {
 	}
 }
 
-func TestToLong(t *testing.T) {
+func TestToBoolean(t *testing.T) {
 	tests := []struct {
 		cql        string
 		name       string
@@ -408,70 +600,130 @@ func TestToLong(t *testing.T) {
 		wantResult result.Value
 	}{
 		{
-			name: "Null to Long",
-			cql:  "ToLong(null as Integer)",
-			wantModel: &model.ToLong{
+			name: "Null to Boolean",
+			cql:  "ToBoolean(null as String)",
+			wantModel: &model.ToBoolean{
 				UnaryExpression: &model.UnaryExpression{
 					Operand: &model.As{
 						UnaryExpression: &model.UnaryExpression{
 							Operand:    model.NewLiteral("null", types.Any),
-							Expression: model.ResultType(types.Integer),
+							Expression: model.ResultType(types.String),
 						},
-						AsTypeSpecifier: types.Integer,
+						AsTypeSpecifier: types.String,
 					},
-					Expression: model.ResultType(types.Long),
+					Expression: model.ResultType(types.Boolean),
 				},
 			},
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Long to Long",
-			cql:        "ToLong(412L)",
-			wantResult: newOrFatal(t, int64(412)),
+			name:       "Boolean to Boolean",
+			cql:        "ToBoolean(true)",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Integer to Long",
-			cql:        "ToLong(412)",
-			wantResult: newOrFatal(t, int64(412)),
+			name:       "String true to Boolean",
+			cql:        "ToBoolean('true')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "True to Long",
-			cql:        "ToLong(true)",
-			wantResult: newOrFatal(t, int64(1)),
+			name:       "String TRUE to Boolean",
+			cql:        "ToBoolean('TRUE')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "False to Long",
-			cql:        "ToLong(false)",
-			wantResult: newOrFatal(t, int64(0)),
+			name:       "String t to Boolean",
+			cql:        "ToBoolean('t')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Negative String to Long",
-			cql:        "ToLong('-412')",
-			wantResult: newOrFatal(t, int64(-412)),
+			name:       "String T to Boolean",
+			cql:        "ToBoolean('T')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Positive String to Long",
-			cql:        "ToLong('+412')",
-			wantResult: newOrFatal(t, int64(412)),
+			name:       "String yes to Boolean",
+			cql:        "ToBoolean('yes')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "No Sign String",
-			cql:        "ToLong('412')",
-			wantResult: newOrFatal(t, int64(412)),
+			name:       "String y to Boolean",
+			cql:        "ToBoolean('y')",
+			wantResult: newOrFatal(t, true),
 		},
 		{
-			name:       "Invalid String Additional Character",
-			cql:        "ToLong('1234a')",
+			name:       "String 1 to Boolean",
+			cql:        "ToBoolean('1')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "String false to Boolean",
+			cql:        "ToBoolean('false')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "String False to Boolean",
+			cql:        "ToBoolean('False')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "String f to Boolean",
+			cql:        "ToBoolean('f')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "String no to Boolean",
+			cql:        "ToBoolean('no')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "String N to Boolean",
+			cql:        "ToBoolean('N')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "String 0 to Boolean",
+			cql:        "ToBoolean('0')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Integer 1 to Boolean",
+			cql:        "ToBoolean(1)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer 0 to Boolean",
+			cql:        "ToBoolean(0)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Long 1 to Boolean",
+			cql:        "ToBoolean(1L)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Decimal 0.0 to Boolean",
+			cql:        "ToBoolean(0.0)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Decimal 1.0 to Boolean",
+			cql:        "ToBoolean(1.0)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Integer 2 to Boolean",
+			cql:        "ToBoolean(2)",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Invalid String Missing Digit",
-			cql:        "ToLong('-')",
+			name:       "Invalid String to Boolean",
+			cql:        "ToBoolean('maybe')",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Invalid Empty String",
-			cql:        "ToLong('')",
+			name:       "Empty String to Boolean",
+			cql:        "ToBoolean('')",
 			wantResult: newOrFatal(t, nil),
 		},
 	}
@@ -497,106 +749,83 @@ func TestToLong(t *testing.T) {
 	}
 }
 
-func TestToQuantity(t *testing.T) {
+func TestToInteger(t *testing.T) {
 	tests := []struct {
-		name       string
 		cql        string
+		name       string
 		wantModel  model.IExpression
 		wantResult result.Value
 	}{
 		{
-			name: "Null to Quantity",
-			cql:  "ToQuantity(null as Integer)",
-			wantModel: &model.ToQuantity{
+			name: "Null to Integer",
+			cql:  "ToInteger(null as String)",
+			wantModel: &model.ToInteger{
 				UnaryExpression: &model.UnaryExpression{
 					Operand: &model.As{
 						UnaryExpression: &model.UnaryExpression{
 							Operand:    model.NewLiteral("null", types.Any),
-							Expression: model.ResultType(types.Integer),
+							Expression: model.ResultType(types.String),
 						},
-						AsTypeSpecifier: types.Integer,
+						AsTypeSpecifier: types.String,
 					},
-					Expression: model.ResultType(types.Quantity),
+					Expression: model.ResultType(types.Integer),
 				},
 			},
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Integer to Quantity",
-			cql:        "ToQuantity(412)",
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.0, Unit: "1"}),
-		},
-		{
-			name:       "Decimal to Quantity",
-			cql:        "ToQuantity(412.2)",
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
-		},
-		// String ToQuantity tests
-		{
-			name:       "Negative String to Quantity",
-			cql:        "ToQuantity('-412.2')",
-			wantResult: newOrFatal(t, result.Quantity{Value: -412.2, Unit: "1"}),
-		},
-		{
-			name:       "Positive String to Quantity",
-			cql:        "ToQuantity('+412.2')",
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
+			name:       "Integer to Integer",
+			cql:        "ToInteger(412)",
+			wantResult: newOrFatal(t, int32(412)),
 		},
 		{
-			name:       "String decimal only to Quantity",
-			cql:        "ToQuantity('412.2')",
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
+			name:       "Long to Integer",
+			cql:        "ToInteger(412L)",
+			wantResult: newOrFatal(t, int32(412)),
 		},
 		{
-			name: "String decimal and unit to Quantity",
-			cql:  "ToQuantity('412.2 \\'cm\\'')",
-			wantModel: &model.ToQuantity{
-				UnaryExpression: &model.UnaryExpression{
-					Operand:    model.NewLiteral("412.2 'cm'", types.String),
-					Expression: model.ResultType(types.Quantity),
-				},
-			},
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "cm"}),
+			name:       "Out of range Long to Integer",
+			cql:        "ToInteger(2147483648L)",
+			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "String decimal and unit no spaces to Quantity",
-			cql:        "ToQuantity('412.2\\'cm\\'')",
-			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "cm"}),
+			name:       "True to Integer",
+			cql:        "ToInteger(true)",
+			wantResult: newOrFatal(t, int32(1)),
 		},
-		// Invalid String tests
 		{
-			name:       "Invalid Empty String to Quantity",
-			cql:        "ToQuantity('')",
-			wantResult: newOrFatal(t, nil),
+			name:       "False to Integer",
+			cql:        "ToInteger(false)",
+			wantResult: newOrFatal(t, int32(0)),
 		},
 		{
-			name:       "Invalid String Missing Digit and Unit to Quantity",
-			cql:        "ToQuantity('-')",
-			wantResult: newOrFatal(t, nil),
+			name:       "Negative String to Integer",
+			cql:        "ToInteger('-412')",
+			wantResult: newOrFatal(t, int32(-412)),
 		},
 		{
-			name:       "Invalid String Missing Digit to Quantity",
-			cql:        "ToQuantity('-.4')",
-			wantResult: newOrFatal(t, nil),
+			name:       "Positive String to Integer",
+			cql:        "ToInteger('+412')",
+			wantResult: newOrFatal(t, int32(412)),
 		},
 		{
-			name:       "Invalid String Missing right quotation to Quantity",
-			cql:        "ToQuantity('-1.4 \\'cm')",
-			wantResult: newOrFatal(t, nil),
+			name:       "Max String to Integer",
+			cql:        "ToInteger('2147483647')",
+			wantResult: newOrFatal(t, int32(2147483647)),
 		},
 		{
-			name:       "Invalid String Missing left quotation to Quantity",
-			cql:        "ToQuantity('-1.4 cm\\')",
+			name:       "Out of range String to Integer",
+			cql:        "ToInteger('2147483648')",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Invalid String Missing both quotations to Quantity",
-			cql:        "ToQuantity('-1.4 cm')",
+			name:       "Invalid String Decimal to Integer",
+			cql:        "ToInteger('412.5')",
 			wantResult: newOrFatal(t, nil),
 		},
 		{
-			name:       "Invalid String Missing only unit to Quantity",
-			cql:        "ToQuantity('\\'cm\\')",
+			name:       "Invalid String Non Numeric to Integer",
+			cql:        "ToInteger('abc')",
 			wantResult: newOrFatal(t, nil),
 		},
 	}
@@ -622,6 +851,565 @@ func TestToQuantity(t *testing.T) {
 	}
 }
 
+func TestToLong(t *testing.T) {
+	tests := []struct {
+		cql        string
+		name       string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Null to Long",
+			cql:  "ToLong(null as Integer)",
+			wantModel: &model.ToLong{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.As{
+						UnaryExpression: &model.UnaryExpression{
+							Operand:    model.NewLiteral("null", types.Any),
+							Expression: model.ResultType(types.Integer),
+						},
+						AsTypeSpecifier: types.Integer,
+					},
+					Expression: model.ResultType(types.Long),
+				},
+			},
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Long to Long",
+			cql:        "ToLong(412L)",
+			wantResult: newOrFatal(t, int64(412)),
+		},
+		{
+			name:       "Integer to Long",
+			cql:        "ToLong(412)",
+			wantResult: newOrFatal(t, int64(412)),
+		},
+		{
+			name:       "True to Long",
+			cql:        "ToLong(true)",
+			wantResult: newOrFatal(t, int64(1)),
+		},
+		{
+			name:       "False to Long",
+			cql:        "ToLong(false)",
+			wantResult: newOrFatal(t, int64(0)),
+		},
+		{
+			name:       "Negative String to Long",
+			cql:        "ToLong('-412')",
+			wantResult: newOrFatal(t, int64(-412)),
+		},
+		{
+			name:       "Positive String to Long",
+			cql:        "ToLong('+412')",
+			wantResult: newOrFatal(t, int64(412)),
+		},
+		{
+			name:       "No Sign String",
+			cql:        "ToLong('412')",
+			wantResult: newOrFatal(t, int64(412)),
+		},
+		{
+			name:       "Invalid String Additional Character",
+			cql:        "ToLong('1234a')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing Digit",
+			cql:        "ToLong('-')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid Empty String",
+			cql:        "ToLong('')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Out of range String",
+			cql:        "ToLong('9223372036854775808')",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestToQuantity(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "Null to Quantity",
+			cql:  "ToQuantity(null as Integer)",
+			wantModel: &model.ToQuantity{
+				UnaryExpression: &model.UnaryExpression{
+					Operand: &model.As{
+						UnaryExpression: &model.UnaryExpression{
+							Operand:    model.NewLiteral("null", types.Any),
+							Expression: model.ResultType(types.Integer),
+						},
+						AsTypeSpecifier: types.Integer,
+					},
+					Expression: model.ResultType(types.Quantity),
+				},
+			},
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Integer to Quantity",
+			cql:        "ToQuantity(412)",
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.0, Unit: "1"}),
+		},
+		{
+			name:       "Decimal to Quantity",
+			cql:        "ToQuantity(412.2)",
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
+		},
+		// String ToQuantity tests
+		{
+			name:       "Negative String to Quantity",
+			cql:        "ToQuantity('-412.2')",
+			wantResult: newOrFatal(t, result.Quantity{Value: -412.2, Unit: "1"}),
+		},
+		{
+			name:       "Positive String to Quantity",
+			cql:        "ToQuantity('+412.2')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
+		},
+		{
+			name:       "String decimal only to Quantity",
+			cql:        "ToQuantity('412.2')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "1"}),
+		},
+		{
+			name: "String decimal and unit to Quantity",
+			cql:  "ToQuantity('412.2 \\'cm\\'')",
+			wantModel: &model.ToQuantity{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("412.2 'cm'", types.String),
+					Expression: model.ResultType(types.Quantity),
+				},
+			},
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "cm"}),
+		},
+		{
+			name:       "String decimal and unit no spaces to Quantity",
+			cql:        "ToQuantity('412.2\\'cm\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 412.2, Unit: "cm"}),
+		},
+		// Invalid String tests
+		{
+			name:       "Invalid Empty String to Quantity",
+			cql:        "ToQuantity('')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing Digit and Unit to Quantity",
+			cql:        "ToQuantity('-')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing Digit to Quantity",
+			cql:        "ToQuantity('-.4')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing right quotation to Quantity",
+			cql:        "ToQuantity('-1.4 \\'cm')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing left quotation to Quantity",
+			cql:        "ToQuantity('-1.4 cm\\')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing both quotations to Quantity",
+			cql:        "ToQuantity('-1.4 cm')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String Missing only unit to Quantity",
+			cql:        "ToQuantity('\\'cm\\')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "String with bracketed unit to Quantity",
+			cql:        "ToQuantity('1.5 \\'mm[Hg]\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1.5, Unit: "mm[Hg]"}),
+		},
+		{
+			name:       "String with compound unit to Quantity",
+			cql:        "ToQuantity('5 \\'mg/dL\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 5, Unit: "mg/dL"}),
+		},
+		{
+			name:       "Invalid String non numeric to Quantity",
+			cql:        "ToQuantity('abc')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "String with unit outside the conversion table to Quantity",
+			cql:        "ToQuantity('5 \\'U/L\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 5, Unit: "U/L"}),
+		},
+		{
+			name:       "String with arbitrary power unit to Quantity",
+			cql:        "ToQuantity('4.5 \\'10*3/uL\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 4.5, Unit: "10*3/uL"}),
+		},
+		{
+			name:       "String with bracketed unit outside the conversion table to Quantity",
+			cql:        "ToQuantity('98.6 \\'[degF]\\'')",
+			wantResult: newOrFatal(t, result.Quantity{Value: 98.6, Unit: "[degF]"}),
+		},
+		{
+			name:       "Invalid String malformed unit to Quantity",
+			cql:        "ToQuantity('5 \\'mg//dL\\'')",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestToRatio(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "String to Ratio",
+			cql:  "ToRatio('5 \\'mg\\':10 \\'mL\\'')",
+			wantModel: &model.ToRatio{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("5 'mg':10 'mL'", types.String),
+					Expression: model.ResultType(types.Ratio),
+				},
+			},
+			wantResult: newOrFatal(t, result.Ratio{
+				Numerator:   result.Quantity{Value: 5, Unit: "mg"},
+				Denominator: result.Quantity{Value: 10, Unit: "mL"},
+			}),
+		},
+		{
+			name: "Unitless String to Ratio",
+			cql:  "ToRatio('1:2')",
+			wantResult: newOrFatal(t, result.Ratio{
+				Numerator:   result.Quantity{Value: 1, Unit: "1"},
+				Denominator: result.Quantity{Value: 2, Unit: "1"},
+			}),
+		},
+		{
+			name: "Ratio to Ratio",
+			cql:  "ToRatio(1 'mg':2 'mL')",
+			wantResult: newOrFatal(t, result.Ratio{
+				Numerator:   result.Quantity{Value: 1, Unit: "mg"},
+				Denominator: result.Quantity{Value: 2, Unit: "mL"},
+			}),
+		},
+		{
+			name:       "Null to Ratio",
+			cql:        "ToRatio(null as String)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String missing colon to Ratio",
+			cql:        "ToRatio('5 \\'mg\\'')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String bad numerator to Ratio",
+			cql:        "ToRatio('x:10 \\'mL\\'')",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "Invalid String malformed unit to Ratio",
+			cql:        "ToRatio('5 \\'mg//dL\\':10 \\'mL\\'')",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestConvertsTo(t *testing.T) {
+	tests := []struct {
+		cql        string
+		name       string
+		wantModel  model.IExpression
+		wantResult result.Value
+	}{
+		{
+			name: "ConvertsToInteger String",
+			cql:  "ConvertsToInteger('12')",
+			wantModel: &model.ConvertsToInteger{
+				UnaryExpression: &model.UnaryExpression{
+					Operand:    model.NewLiteral("12", types.String),
+					Expression: model.ResultType(types.Boolean),
+				},
+			},
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToInteger invalid String",
+			cql:        "ConvertsToInteger('1.2')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "ConvertsToInteger null",
+			cql:        "ConvertsToInteger(null as String)",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name:       "ConvertsToLong Integer",
+			cql:        "ConvertsToLong(5)",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToDecimal invalid String",
+			cql:        "ConvertsToDecimal('abc')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "ConvertsToBoolean String",
+			cql:        "ConvertsToBoolean('y')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToBoolean Integer out of range",
+			cql:        "ConvertsToBoolean(2)",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "ConvertsToDate String",
+			cql:        "ConvertsToDate('2020-01-01')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToDateTime invalid String",
+			cql:        "ConvertsToDateTime('bad')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "ConvertsToTime String",
+			cql:        "ConvertsToTime('12:00:00')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToQuantity String",
+			cql:        "ConvertsToQuantity('5 \\'mg\\'')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToQuantity unit outside the conversion table",
+			cql:        "ConvertsToQuantity('5 \\'[iU]\\'')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToQuantity malformed unit",
+			cql:        "ConvertsToQuantity('5 \\'mg//dL\\'')",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "ConvertsToRatio String",
+			cql:        "ConvertsToRatio('1:2')",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "ConvertsToString Integer",
+			cql:        "ConvertsToString(5)",
+			wantResult: newOrFatal(t, true),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantModel, getTESTRESULTModel(t, parsedLibs)); tc.wantModel != nil && diff != "" {
+				t.Errorf("Parse diff (-want +got):\n%s", diff)
+			}
+
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+// TestConvertsTo_MatchesTo checks that ConvertsTo returns true exactly when the corresponding To
+// conversion returns a non null result.
+func TestConvertsTo_MatchesTo(t *testing.T) {
+	tests := []struct {
+		name string
+		cql  string
+	}{
+		{
+			name: "Integer 12",
+			cql:  "ConvertsToInteger('12') = (ToInteger('12') is not null)",
+		},
+		{
+			name: "Integer 1.2",
+			cql:  "ConvertsToInteger('1.2') = (ToInteger('1.2') is not null)",
+		},
+		{
+			name: "Integer 2147483648",
+			cql:  "ConvertsToInteger('2147483648') = (ToInteger('2147483648') is not null)",
+		},
+		{
+			name: "Long 12",
+			cql:  "ConvertsToLong('12') = (ToLong('12') is not null)",
+		},
+		{
+			name: "Long abc",
+			cql:  "ConvertsToLong('abc') = (ToLong('abc') is not null)",
+		},
+		{
+			name: "Decimal 1.5",
+			cql:  "ConvertsToDecimal('1.5') = (ToDecimal('1.5') is not null)",
+		},
+		{
+			name: "Decimal -.4",
+			cql:  "ConvertsToDecimal('-.4') = (ToDecimal('-.4') is not null)",
+		},
+		{
+			name: "Boolean FALSE",
+			cql:  "ConvertsToBoolean('FALSE') = (ToBoolean('FALSE') is not null)",
+		},
+		{
+			name: "Boolean maybe",
+			cql:  "ConvertsToBoolean('maybe') = (ToBoolean('maybe') is not null)",
+		},
+		{
+			name: "Boolean 0.0",
+			cql:  "ConvertsToBoolean(0.0) = (ToBoolean(0.0) is not null)",
+		},
+		{
+			name: "Date 2020-01-01",
+			cql:  "ConvertsToDate('2020-01-01') = (ToDate('2020-01-01') is not null)",
+		},
+		{
+			name: "Date 2020-13-01",
+			cql:  "ConvertsToDate('2020-13-01') = (ToDate('2020-13-01') is not null)",
+		},
+		{
+			name: "DateTime 2020-01-01T10:00:00",
+			cql:  "ConvertsToDateTime('2020-01-01T10:00:00') = (ToDateTime('2020-01-01T10:00:00') is not null)",
+		},
+		{
+			name: "DateTime bad",
+			cql:  "ConvertsToDateTime('bad') = (ToDateTime('bad') is not null)",
+		},
+		{
+			name: "Time 12:30",
+			cql:  "ConvertsToTime('12:30') = (ToTime('12:30') is not null)",
+		},
+		{
+			name: "Time 25:00",
+			cql:  "ConvertsToTime('25:00') = (ToTime('25:00') is not null)",
+		},
+		{
+			name: "Quantity 1.5 mm[Hg]",
+			cql:  "ConvertsToQuantity('1.5 \\'mm[Hg]\\'') = (ToQuantity('1.5 \\'mm[Hg]\\'') is not null)",
+		},
+		{
+			name: "Quantity 1.5 cm",
+			cql:  "ConvertsToQuantity('1.5 cm') = (ToQuantity('1.5 cm') is not null)",
+		},
+		{
+			name: "Ratio 5 mg:10 mL",
+			cql:  "ConvertsToRatio('5 \\'mg\\':10 \\'mL\\'') = (ToRatio('5 \\'mg\\':10 \\'mL\\'') is not null)",
+		},
+		{
+			name: "Ratio 5 mg",
+			cql:  "ConvertsToRatio('5 \\'mg\\'') = (ToRatio('5 \\'mg\\'') is not null)",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), wrapInLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(newOrFatal(t, true), getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}
+
 func TestToConcept(t *testing.T) {
 	tests := []struct {
 		cql        string
@@ -762,6 +1550,41 @@ func TestIs(t *testing.T) {
 			cql:        "1 is Any",
 			wantResult: newOrFatal(t, true),
 		},
+		{
+			name:       "Code is Code",
+			cql:        "Code{code: 'a', system: 'b'} is Code",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "1 is Decimal",
+			cql:        "1 is Decimal",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Patient is DomainResource supertype",
+			cql:        "First([Patient]) is FHIR.DomainResource",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "Patient is Observation unrelated type",
+			cql:        "First([Patient]) is FHIR.Observation",
+			wantResult: newOrFatal(t, false),
+		},
+		{
+			name:       "Choice is member type",
+			cql:        "(4 as Choice<Integer, String>) is Integer",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "List is List<Integer>",
+			cql:        "{1, 2} is List<Integer>",
+			wantResult: newOrFatal(t, true),
+		},
+		{
+			name:       "null is Integer",
+			cql:        "null is Integer",
+			wantResult: newOrFatal(t, false),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -149,6 +149,36 @@ func TestPropertySystemLiterals(t *testing.T) {
 			define TESTRESULT: Tuple { apple : C }.apple`),
 			wantResult: newOrFatal(t, 4),
 		},
+		{
+			name:       "nested Tuple path",
+			cql:        "define TESTRESULT: Tuple { a: Tuple { b: Tuple { c: 5 } } }.a.b.c",
+			wantResult: newOrFatal(t, 5),
+		},
+		{
+			name:       "path through null intermediate returns null",
+			cql:        "define TESTRESULT: Tuple { a: Tuple { b: null as Tuple { c Integer } } }.a.b.c",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "path into list flattens and skips nulls",
+			cql: dedent.Dedent(`
+			define T: { Tuple { a: Tuple { b: { 1, 2 } } }, Tuple { a: null as Tuple { b List<Integer> } }, Tuple { a: Tuple { b: { 3 } } } }
+			define TESTRESULT: T.a.b`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{newOrFatal(t, 1), newOrFatal(t, 2), newOrFatal(t, 3)},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name:       "Ratio.numerator",
+			cql:        "define TESTRESULT: (1 'mg' : 2 'mL').numerator",
+			wantResult: newOrFatal(t, result.Quantity{Value: 1, Unit: "mg"}),
+		},
+		{
+			name:       "Ratio.denominator.unit",
+			cql:        "define TESTRESULT: (1 'mg' : 2 'mL').denominator.unit",
+			wantResult: newOrFatal(t, "mL"),
+		},
 	}
 
 	for _, tc := range tests {
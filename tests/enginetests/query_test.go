@@ -121,6 +121,40 @@ func TestQuery(t *testing.T) {
 				StaticType: &types.List{ElementType: types.Integer},
 			}),
 		},
+		{
+			name: "Let referencing alias used in where and return",
+			cql:  "define TESTRESULT: ({1, 2, 3}) A let B: A * 2 where B > 2 return B",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 4),
+					newOrFatal(t, 6),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Let referencing earlier let",
+			cql:  "define TESTRESULT: ({1, 2, 3}) A let B: A * 2, C: B + 1 return C",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 3),
+					newOrFatal(t, 5),
+					newOrFatal(t, 7),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Let without return clause returns source",
+			cql:  "define TESTRESULT: ({1, 2, 3}) A let B: A * 2 where B > 2",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 2),
+					newOrFatal(t, 3),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
 		{
 			name: "With where",
 			cql: dedent.Dedent(`
@@ -260,6 +294,153 @@ func TestQuery(t *testing.T) {
 			},
 				StaticType: &types.List{ElementType: types.String}}),
 		},
+		{
+			name: "Sort ascending with nulls first",
+			cql:  "define TESTRESULT: ({3, null, 1, 2}) l sort asc",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, nil),
+				newOrFatal(t, 1),
+				newOrFatal(t, 2),
+				newOrFatal(t, 3),
+			},
+				StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name: "Sort descending with nulls last",
+			cql:  "define TESTRESULT: ({3, null, 1, 2}) l sort desc",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, 3),
+				newOrFatal(t, 2),
+				newOrFatal(t, 1),
+				newOrFatal(t, nil),
+			},
+				StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name: "Sort ascending time",
+			cql:  "define TESTRESULT: ({@T12:00, @T08:30, @T10:15}) l sort asc",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 8, 30, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+				newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 10, 15, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+				newOrFatal(t, result.Time{Date: time.Date(0, time.January, 1, 12, 0, 0, 0, defaultEvalTimestamp.Location()), Precision: model.MINUTE}),
+			},
+				StaticType: &types.List{ElementType: types.Time}}),
+		},
+		{
+			name: "Sort by column ascending",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 1, b: 'x'}, Tuple{a: null, b: 'w'}, Tuple{a: 2, b: 'y'}, Tuple{a: 1, b: 'z'}}) T sort by a asc
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "w"),
+				newOrFatal(t, "x"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "y"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by column descending",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 1, b: 'x'}, Tuple{a: null, b: 'w'}, Tuple{a: 2, b: 'y'}, Tuple{a: 1, b: 'z'}}) T sort by a desc
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "y"),
+				newOrFatal(t, "x"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "w"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by column defaults to ascending and keeps ties in order",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 1, b: 'x'}, Tuple{a: null, b: 'w'}, Tuple{a: 2, b: 'y'}, Tuple{a: 1, b: 'z'}}) T sort by a
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "w"),
+				newOrFatal(t, "x"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "y"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by multiple columns",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 1, b: 'x'}, Tuple{a: null, b: 'w'}, Tuple{a: 2, b: 'y'}, Tuple{a: 1, b: 'z'}}) T sort by a desc, b desc
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "y"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "x"),
+				newOrFatal(t, "w"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by nested column",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: Tuple{c: 2}, b: 'x'}, Tuple{a: Tuple{c: 1}, b: 'y'}}) T sort by a.c
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "y"),
+				newOrFatal(t, "x"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name:       "Sort by expression on $this",
+			cql:        "define TESTRESULT: ({3, 1, 2}) L sort by $this * -1",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 3), newOrFatal(t, 2), newOrFatal(t, 1)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name: "Sort by expression on property descending",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: -3, b: 'x'}, Tuple{a: 1, b: 'y'}, Tuple{a: 2, b: 'z'}}) T sort by Abs(a) desc
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "x"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "y"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by column and expression",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 1, b: 2, c: 'x'}, Tuple{a: 1, b: 1, c: 'y'}, Tuple{a: 0, b: 5, c: 'z'}}) T sort by a, b + 1
+			define TESTRESULT: Sorted S return all S.c`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "z"),
+				newOrFatal(t, "y"),
+				newOrFatal(t, "x"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by expression with keywords",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{i: Interval[5, 6], b: 'x'}, Tuple{i: Interval[1, 9], b: 'y'}}) T sort by start of i
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "y"),
+				newOrFatal(t, "x"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
+		{
+			name: "Sort by expression with null values",
+			cql: dedent.Dedent(`
+			define Sorted: ({Tuple{a: 2, b: 'x'}, Tuple{a: null, b: 'y'}, Tuple{a: 1, b: 'z'}}) T sort by a * 2
+			define TESTRESULT: Sorted S return all S.b`),
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{
+				newOrFatal(t, "y"),
+				newOrFatal(t, "z"),
+				newOrFatal(t, "x"),
+			},
+				StaticType: &types.List{ElementType: types.String}}),
+		},
 		{
 			name:       "Aggregate",
 			cql:        "define TESTRESULT: ({1, 2, 3, 3, 4}) L aggregate A starting 1: A * L",
@@ -275,6 +456,41 @@ func TestQuery(t *testing.T) {
 			cql:        "define TESTRESULT: ({1, 2, 3, 3, 4}) L aggregate distinct A starting 1: A * L",
 			wantResult: newOrFatal(t, 24),
 		},
+		{
+			name:       "Aggregate distinct running sum",
+			cql:        "define TESTRESULT: ({1, 2, 2, 3}) L aggregate distinct S starting 0: S + L",
+			wantResult: newOrFatal(t, 6),
+		},
+		{
+			name:       "Aggregate distinct uses CQL equality",
+			cql:        "define TESTRESULT: ({1 'g', 1000 'mg', 2 'g'}) Q aggregate distinct S starting (0 'g'): S + Q",
+			wantResult: newOrFatal(t, result.Quantity{Value: 3, Unit: "g"}),
+		},
+		{
+			name:       "Aggregate distinct counts null once",
+			cql:        "define TESTRESULT: ({1, null, 2, null}) L aggregate distinct C starting 0: C + 1",
+			wantResult: newOrFatal(t, 3),
+		},
+		{
+			name:       "Aggregate distinct over multiple sources",
+			cql:        "define TESTRESULT: from ({1, 1, 2}) A, ({3}) B aggregate distinct S starting 0: S + A + B",
+			wantResult: newOrFatal(t, 9),
+		},
+		{
+			name:       "Aggregate running sum",
+			cql:        "define TESTRESULT: ({1, 2, 3, 4}) L aggregate S starting 0: S + L",
+			wantResult: newOrFatal(t, 10),
+		},
+		{
+			name:       "Aggregate empty source returns starting value",
+			cql:        "define TESTRESULT: ({} as List<Integer>) L aggregate S starting 5: S + L",
+			wantResult: newOrFatal(t, 5),
+		},
+		{
+			name:       "Aggregate after where filters all rows returns starting value",
+			cql:        "define TESTRESULT: ({1, 2, 3}) L where L > 5 aggregate S starting 5: S + L",
+			wantResult: newOrFatal(t, 5),
+		},
 		{
 			name:       "Aggregate no starting expression",
 			cql:        "define TESTRESULT: ({1, 2, 3}) L aggregate A : A * L",
@@ -460,6 +676,16 @@ func TestQuery(t *testing.T) {
 		{
 			name: "Relationship clause without",
 			cql:  "define TESTRESULT: ({1, 2, 3, 4}) A without ({2, 3}) B such that A + B >= 5",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 1),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Relationship clause without null such that",
+			cql:  "define TESTRESULT: ({1, 2}) A without ({null as Integer}) B such that A = B",
 			wantResult: newOrFatal(t, result.List{
 				Value: []result.Value{
 					newOrFatal(t, 1),
@@ -513,12 +739,88 @@ func TestQuery(t *testing.T) {
 				StaticType: &types.List{ElementType: &types.Tuple{ElementTypes: map[string]types.IType{"A": types.Integer, "B": types.Integer}}},
 			}),
 		},
+		{
+			name: "Multi-source query with relationship, where, distinct return and sort",
+			cql: dedent.Dedent(`
+			define TESTRESULT: from ({1, 2, 3}) A, ({10, 20}) B
+			with ({11, 22, 23}) C such that A + B = C
+			where B > 5
+			return distinct B
+			sort desc`),
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 20),
+					newOrFatal(t, 10),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Distinct return compares quantities with unit conversion",
+			cql:  "define TESTRESULT: ({1 'g', 1000 'mg', 2 'g'}) Q return distinct Q",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Quantity{Value: 1, Unit: "g"}),
+					newOrFatal(t, result.Quantity{Value: 2, Unit: "g"}),
+				},
+				StaticType: &types.List{ElementType: types.Quantity},
+			}),
+		},
 		{
 			// This ensures that properties on null values inside queries are handled correctly.
 			name:       "Property on null alias in query",
 			cql:        "define TESTRESULT: (null as Code) l return l.code",
 			wantResult: newOrFatal(t, nil),
 		},
+		{
+			name: "Map list through arithmetic expression",
+			cql:  "define TESTRESULT: ({1, 2, 3}) X return X * 2 + 1",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 3),
+					newOrFatal(t, 5),
+					newOrFatal(t, 7),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Map list with duplicates keeps all results",
+			cql:  "define TESTRESULT: ({2, 1, 2}) X return all X * 2",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 4),
+					newOrFatal(t, 2),
+					newOrFatal(t, 4),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Map list containing nulls propagates nulls",
+			cql:  "define TESTRESULT: ({1, null, 3}) X return X * 2",
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, 2),
+					newOrFatal(t, nil),
+					newOrFatal(t, 6),
+				},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name:       "Map null list",
+			cql:        "define TESTRESULT: (null as List<Integer>) X return X * 2",
+			wantResult: newOrFatal(t, nil),
+		},
+		{
+			name: "Multi-source query with null list source",
+			cql:  "define TESTRESULT: from (null as List<Integer>) X, ({1, 2}) Y return X + Y",
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -548,3 +850,96 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		name       string
+		cql        string
+		wantResult result.Value
+	}{
+		{
+			name: "Linear chain",
+			cql: dedent.Dedent(`
+			define Edges: { Tuple { source: 1, target: 2 }, Tuple { source: 2, target: 3 } }
+			define TESTRESULT: {1}.repeat(Edges E where E.source = $this return E.target)`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{newOrFatal(t, 2), newOrFatal(t, 3)},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Branching graph",
+			cql: dedent.Dedent(`
+			define Edges: {
+				Tuple { source: 1, target: 2 },
+				Tuple { source: 1, target: 3 },
+				Tuple { source: 2, target: 4 },
+				Tuple { source: 3, target: 4 }
+			}
+			define TESTRESULT: {1}.repeat(Edges E where E.source = $this return E.target)`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{newOrFatal(t, 2), newOrFatal(t, 3), newOrFatal(t, 4)},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Cyclic graph terminates",
+			cql: dedent.Dedent(`
+			define Edges: {
+				Tuple { source: 1, target: 2 },
+				Tuple { source: 2, target: 3 },
+				Tuple { source: 3, target: 1 }
+			}
+			define TESTRESULT: {1}.repeat(Edges E where E.source = $this return E.target)`),
+			wantResult: newOrFatal(t, result.List{
+				Value:      []result.Value{newOrFatal(t, 2), newOrFatal(t, 3), newOrFatal(t, 1)},
+				StaticType: &types.List{ElementType: types.Integer},
+			}),
+		},
+		{
+			name: "Cyclic graph of tuples terminates",
+			cql: dedent.Dedent(`
+			define Nodes: { Tuple { id: 1, next: 2 }, Tuple { id: 2, next: 1 } }
+			define TESTRESULT: (Nodes N where N.id = 1).repeat(Nodes N where N.id = $this.next)`),
+			wantResult: newOrFatal(t, result.List{
+				Value: []result.Value{
+					newOrFatal(t, result.Tuple{
+						Value:       map[string]result.Value{"id": newOrFatal(t, 2), "next": newOrFatal(t, 1)},
+						RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{"id": types.Integer, "next": types.Integer}},
+					}),
+					newOrFatal(t, result.Tuple{
+						Value:       map[string]result.Value{"id": newOrFatal(t, 1), "next": newOrFatal(t, 2)},
+						RuntimeType: &types.Tuple{ElementTypes: map[string]types.IType{"id": types.Integer, "next": types.Integer}},
+					}),
+				},
+				StaticType: &types.List{ElementType: &types.Tuple{ElementTypes: map[string]types.IType{"id": types.Integer, "next": types.Integer}}},
+			}),
+		},
+		{
+			name:       "Element returning a single value",
+			cql:        "define TESTRESULT: {1}.repeat(if $this < 3 then $this + 1 else null)",
+			wantResult: newOrFatal(t, result.List{Value: []result.Value{newOrFatal(t, 2), newOrFatal(t, 3)}, StaticType: &types.List{ElementType: types.Integer}}),
+		},
+		{
+			name:       "Null source",
+			cql:        "define TESTRESULT: (null as List<Integer>).repeat($this + 1)",
+			wantResult: newOrFatal(t, nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newFHIRParser(t)
+			parsedLibs, err := p.Libraries(context.Background(), addFHIRHelpersLib(t, tc.cql), parser.Config{})
+			if err != nil {
+				t.Fatalf("Parse returned unexpected error: %v", err)
+			}
+			results, err := interpreter.Eval(context.Background(), parsedLibs, defaultInterpreterConfig(t, p))
+			if err != nil {
+				t.Fatalf("Eval returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, getTESTRESULT(t, results), protocmp.Transform()); diff != "" {
+				t.Errorf("Eval diff (-want +got)\n%v", diff)
+			}
+		})
+	}
+}